@@ -19,14 +19,80 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	Control_DeviceList_FullMethodName            = "/control.Control/DeviceList"
-	Control_DeviceInfo_FullMethodName            = "/control.Control/DeviceInfo"
-	Control_DeviceStatus_FullMethodName          = "/control.Control/DeviceStatus"
-	Control_DeviceFeatures_FullMethodName        = "/control.Control/DeviceFeatures"
-	Control_DeviceInfoList_FullMethodName        = "/control.Control/DeviceInfoList"
-	Control_DeviceStatusList_FullMethodName      = "/control.Control/DeviceStatusList"
-	Control_DeviceFeaturesList_FullMethodName    = "/control.Control/DeviceFeaturesList"
-	Control_SetDeviceFeatureState_FullMethodName = "/control.Control/SetDeviceFeatureState"
+	Control_DeviceList_FullMethodName              = "/control.Control/DeviceList"
+	Control_DeviceInfo_FullMethodName              = "/control.Control/DeviceInfo"
+	Control_DeviceStatus_FullMethodName            = "/control.Control/DeviceStatus"
+	Control_DeviceStatusHistory_FullMethodName     = "/control.Control/DeviceStatusHistory"
+	Control_DeviceFeatures_FullMethodName          = "/control.Control/DeviceFeatures"
+	Control_DeviceInventory_FullMethodName         = "/control.Control/DeviceInventory"
+	Control_DeviceInfoList_FullMethodName          = "/control.Control/DeviceInfoList"
+	Control_DeviceStatusList_FullMethodName        = "/control.Control/DeviceStatusList"
+	Control_DeviceFeaturesList_FullMethodName      = "/control.Control/DeviceFeaturesList"
+	Control_SetDeviceFeatureState_FullMethodName   = "/control.Control/SetDeviceFeatureState"
+	Control_BulkSetFeatureState_FullMethodName     = "/control.Control/BulkSetFeatureState"
+	Control_UpdateDeviceLabels_FullMethodName      = "/control.Control/UpdateDeviceLabels"
+	Control_SetDeviceLifecycle_FullMethodName      = "/control.Control/SetDeviceLifecycle"
+	Control_DeviceDriftList_FullMethodName         = "/control.Control/DeviceDriftList"
+	Control_CreateFeature_FullMethodName           = "/control.Control/CreateFeature"
+	Control_FeatureInfo_FullMethodName             = "/control.Control/FeatureInfo"
+	Control_FeatureList_FullMethodName             = "/control.Control/FeatureList"
+	Control_DeprecateFeature_FullMethodName        = "/control.Control/DeprecateFeature"
+	Control_DeleteFeature_FullMethodName           = "/control.Control/DeleteFeature"
+	Control_CreateGroup_FullMethodName             = "/control.Control/CreateGroup"
+	Control_DeleteGroup_FullMethodName             = "/control.Control/DeleteGroup"
+	Control_GroupInfo_FullMethodName               = "/control.Control/GroupInfo"
+	Control_GroupList_FullMethodName               = "/control.Control/GroupList"
+	Control_AddGroupDevice_FullMethodName          = "/control.Control/AddGroupDevice"
+	Control_RemoveGroupDevice_FullMethodName       = "/control.Control/RemoveGroupDevice"
+	Control_SetGroupFeatureState_FullMethodName    = "/control.Control/SetGroupFeatureState"
+	Control_ClearGroupFeatureState_FullMethodName  = "/control.Control/ClearGroupFeatureState"
+	Control_ResetDeviceFeatureState_FullMethodName = "/control.Control/ResetDeviceFeatureState"
+	Control_CreateProfile_FullMethodName           = "/control.Control/CreateProfile"
+	Control_UpdateProfile_FullMethodName           = "/control.Control/UpdateProfile"
+	Control_DeleteProfile_FullMethodName           = "/control.Control/DeleteProfile"
+	Control_ProfileInfo_FullMethodName             = "/control.Control/ProfileInfo"
+	Control_ProfileList_FullMethodName             = "/control.Control/ProfileList"
+	Control_AssignProfile_FullMethodName           = "/control.Control/AssignProfile"
+	Control_UnassignProfile_FullMethodName         = "/control.Control/UnassignProfile"
+	Control_CreateApp_FullMethodName               = "/control.Control/CreateApp"
+	Control_DeleteApp_FullMethodName               = "/control.Control/DeleteApp"
+	Control_AppList_FullMethodName                 = "/control.Control/AppList"
+	Control_SetAppRule_FullMethodName              = "/control.Control/SetAppRule"
+	Control_ClearAppRule_FullMethodName            = "/control.Control/ClearAppRule"
+	Control_DeviceAppCompliance_FullMethodName     = "/control.Control/DeviceAppCompliance"
+	Control_AppViolationList_FullMethodName        = "/control.Control/AppViolationList"
+	Control_CreateAlertRule_FullMethodName         = "/control.Control/CreateAlertRule"
+	Control_DeleteAlertRule_FullMethodName         = "/control.Control/DeleteAlertRule"
+	Control_AlertRuleList_FullMethodName           = "/control.Control/AlertRuleList"
+	Control_AlertList_FullMethodName               = "/control.Control/AlertList"
+	Control_AcknowledgeAlert_FullMethodName        = "/control.Control/AcknowledgeAlert"
+	Control_CreateComplianceRule_FullMethodName    = "/control.Control/CreateComplianceRule"
+	Control_DeleteComplianceRule_FullMethodName    = "/control.Control/DeleteComplianceRule"
+	Control_ComplianceRuleList_FullMethodName      = "/control.Control/ComplianceRuleList"
+	Control_DeviceCompliance_FullMethodName        = "/control.Control/DeviceCompliance"
+	Control_ComplianceSummary_FullMethodName       = "/control.Control/ComplianceSummary"
+	Control_CreateWebhook_FullMethodName           = "/control.Control/CreateWebhook"
+	Control_DeleteWebhook_FullMethodName           = "/control.Control/DeleteWebhook"
+	Control_WebhookList_FullMethodName             = "/control.Control/WebhookList"
+	Control_CreateCommand_FullMethodName           = "/control.Control/CreateCommand"
+	Control_CommandInfo_FullMethodName             = "/control.Control/CommandInfo"
+	Control_CommandList_FullMethodName             = "/control.Control/CommandList"
+	Control_CreateEnrollmentToken_FullMethodName   = "/control.Control/CreateEnrollmentToken"
+	Control_EnrollmentTokenList_FullMethodName     = "/control.Control/EnrollmentTokenList"
+	Control_RevokeEnrollmentToken_FullMethodName   = "/control.Control/RevokeEnrollmentToken"
+	Control_CertificateList_FullMethodName         = "/control.Control/CertificateList"
+	Control_RevokeCertificate_FullMethodName       = "/control.Control/RevokeCertificate"
+	Control_CreateOperator_FullMethodName          = "/control.Control/CreateOperator"
+	Control_DeleteOperator_FullMethodName          = "/control.Control/DeleteOperator"
+	Control_OperatorList_FullMethodName            = "/control.Control/OperatorList"
+	Control_SetOperatorRoles_FullMethodName        = "/control.Control/SetOperatorRoles"
+	Control_ResetOperatorToken_FullMethodName      = "/control.Control/ResetOperatorToken"
+	Control_CreateRole_FullMethodName              = "/control.Control/CreateRole"
+	Control_DeleteRole_FullMethodName              = "/control.Control/DeleteRole"
+	Control_RoleList_FullMethodName                = "/control.Control/RoleList"
+	Control_AuditLog_FullMethodName                = "/control.Control/AuditLog"
+	Control_VerifyAuditLog_FullMethodName          = "/control.Control/VerifyAuditLog"
+	Control_WatchEvents_FullMethodName             = "/control.Control/WatchEvents"
 )
 
 // ControlClient is the client API for Control service.
@@ -36,11 +102,80 @@ type ControlClient interface {
 	DeviceList(ctx context.Context, in *DeviceListRequest, opts ...grpc.CallOption) (*DeviceListResponse, error)
 	DeviceInfo(ctx context.Context, in *DeviceInfoRequest, opts ...grpc.CallOption) (*DeviceInfoResponse, error)
 	DeviceStatus(ctx context.Context, in *DeviceStatusRequest, opts ...grpc.CallOption) (*DeviceStatusResponse, error)
+	DeviceStatusHistory(ctx context.Context, in *DeviceStatusHistoryRequest, opts ...grpc.CallOption) (*DeviceStatusHistoryResponse, error)
 	DeviceFeatures(ctx context.Context, in *DeviceFeaturesRequest, opts ...grpc.CallOption) (*DeviceFeaturesResponse, error)
+	DeviceInventory(ctx context.Context, in *DeviceInventoryRequest, opts ...grpc.CallOption) (*DeviceInventoryResponse, error)
 	DeviceInfoList(ctx context.Context, in *DeviceInfoListRequest, opts ...grpc.CallOption) (*DeviceInfoListResponse, error)
 	DeviceStatusList(ctx context.Context, in *DeviceStatusListRequest, opts ...grpc.CallOption) (*DeviceStatusListResponse, error)
 	DeviceFeaturesList(ctx context.Context, in *DeviceFeaturesListRequest, opts ...grpc.CallOption) (*DeviceFeaturesListResponse, error)
 	SetDeviceFeatureState(ctx context.Context, in *SetDeviceFeatureStateRequest, opts ...grpc.CallOption) (*SetDeviceFeatureStateResponse, error)
+	BulkSetFeatureState(ctx context.Context, in *BulkSetFeatureStateRequest, opts ...grpc.CallOption) (*BulkSetFeatureStateResponse, error)
+	UpdateDeviceLabels(ctx context.Context, in *UpdateDeviceLabelsRequest, opts ...grpc.CallOption) (*UpdateDeviceLabelsResponse, error)
+	SetDeviceLifecycle(ctx context.Context, in *SetDeviceLifecycleRequest, opts ...grpc.CallOption) (*SetDeviceLifecycleResponse, error)
+	DeviceDriftList(ctx context.Context, in *DeviceDriftListRequest, opts ...grpc.CallOption) (*DeviceDriftListResponse, error)
+	CreateFeature(ctx context.Context, in *CreateFeatureRequest, opts ...grpc.CallOption) (*CreateFeatureResponse, error)
+	FeatureInfo(ctx context.Context, in *FeatureInfoRequest, opts ...grpc.CallOption) (*FeatureInfoResponse, error)
+	FeatureList(ctx context.Context, in *FeatureListRequest, opts ...grpc.CallOption) (*FeatureListResponse, error)
+	DeprecateFeature(ctx context.Context, in *DeprecateFeatureRequest, opts ...grpc.CallOption) (*DeprecateFeatureResponse, error)
+	DeleteFeature(ctx context.Context, in *DeleteFeatureRequest, opts ...grpc.CallOption) (*DeleteFeatureResponse, error)
+	CreateGroup(ctx context.Context, in *CreateGroupRequest, opts ...grpc.CallOption) (*CreateGroupResponse, error)
+	DeleteGroup(ctx context.Context, in *DeleteGroupRequest, opts ...grpc.CallOption) (*DeleteGroupResponse, error)
+	GroupInfo(ctx context.Context, in *GroupInfoRequest, opts ...grpc.CallOption) (*GroupInfoResponse, error)
+	GroupList(ctx context.Context, in *GroupListRequest, opts ...grpc.CallOption) (*GroupListResponse, error)
+	AddGroupDevice(ctx context.Context, in *AddGroupDeviceRequest, opts ...grpc.CallOption) (*AddGroupDeviceResponse, error)
+	RemoveGroupDevice(ctx context.Context, in *RemoveGroupDeviceRequest, opts ...grpc.CallOption) (*RemoveGroupDeviceResponse, error)
+	SetGroupFeatureState(ctx context.Context, in *SetGroupFeatureStateRequest, opts ...grpc.CallOption) (*SetGroupFeatureStateResponse, error)
+	ClearGroupFeatureState(ctx context.Context, in *ClearGroupFeatureStateRequest, opts ...grpc.CallOption) (*ClearGroupFeatureStateResponse, error)
+	ResetDeviceFeatureState(ctx context.Context, in *ResetDeviceFeatureStateRequest, opts ...grpc.CallOption) (*ResetDeviceFeatureStateResponse, error)
+	CreateProfile(ctx context.Context, in *CreateProfileRequest, opts ...grpc.CallOption) (*CreateProfileResponse, error)
+	UpdateProfile(ctx context.Context, in *UpdateProfileRequest, opts ...grpc.CallOption) (*UpdateProfileResponse, error)
+	DeleteProfile(ctx context.Context, in *DeleteProfileRequest, opts ...grpc.CallOption) (*DeleteProfileResponse, error)
+	ProfileInfo(ctx context.Context, in *ProfileInfoRequest, opts ...grpc.CallOption) (*ProfileInfoResponse, error)
+	ProfileList(ctx context.Context, in *ProfileListRequest, opts ...grpc.CallOption) (*ProfileListResponse, error)
+	AssignProfile(ctx context.Context, in *AssignProfileRequest, opts ...grpc.CallOption) (*AssignProfileResponse, error)
+	UnassignProfile(ctx context.Context, in *UnassignProfileRequest, opts ...grpc.CallOption) (*UnassignProfileResponse, error)
+	CreateApp(ctx context.Context, in *CreateAppRequest, opts ...grpc.CallOption) (*CreateAppResponse, error)
+	DeleteApp(ctx context.Context, in *DeleteAppRequest, opts ...grpc.CallOption) (*DeleteAppResponse, error)
+	AppList(ctx context.Context, in *AppListRequest, opts ...grpc.CallOption) (*AppListResponse, error)
+	SetAppRule(ctx context.Context, in *SetAppRuleRequest, opts ...grpc.CallOption) (*SetAppRuleResponse, error)
+	ClearAppRule(ctx context.Context, in *ClearAppRuleRequest, opts ...grpc.CallOption) (*ClearAppRuleResponse, error)
+	DeviceAppCompliance(ctx context.Context, in *DeviceAppComplianceRequest, opts ...grpc.CallOption) (*DeviceAppComplianceResponse, error)
+	AppViolationList(ctx context.Context, in *AppViolationListRequest, opts ...grpc.CallOption) (*AppViolationListResponse, error)
+	CreateAlertRule(ctx context.Context, in *CreateAlertRuleRequest, opts ...grpc.CallOption) (*CreateAlertRuleResponse, error)
+	DeleteAlertRule(ctx context.Context, in *DeleteAlertRuleRequest, opts ...grpc.CallOption) (*DeleteAlertRuleResponse, error)
+	AlertRuleList(ctx context.Context, in *AlertRuleListRequest, opts ...grpc.CallOption) (*AlertRuleListResponse, error)
+	AlertList(ctx context.Context, in *AlertListRequest, opts ...grpc.CallOption) (*AlertListResponse, error)
+	AcknowledgeAlert(ctx context.Context, in *AcknowledgeAlertRequest, opts ...grpc.CallOption) (*AcknowledgeAlertResponse, error)
+	CreateComplianceRule(ctx context.Context, in *CreateComplianceRuleRequest, opts ...grpc.CallOption) (*CreateComplianceRuleResponse, error)
+	DeleteComplianceRule(ctx context.Context, in *DeleteComplianceRuleRequest, opts ...grpc.CallOption) (*DeleteComplianceRuleResponse, error)
+	ComplianceRuleList(ctx context.Context, in *ComplianceRuleListRequest, opts ...grpc.CallOption) (*ComplianceRuleListResponse, error)
+	DeviceCompliance(ctx context.Context, in *DeviceComplianceRequest, opts ...grpc.CallOption) (*DeviceComplianceResponse, error)
+	ComplianceSummary(ctx context.Context, in *ComplianceSummaryRequest, opts ...grpc.CallOption) (*ComplianceSummaryResponse, error)
+	CreateWebhook(ctx context.Context, in *CreateWebhookRequest, opts ...grpc.CallOption) (*CreateWebhookResponse, error)
+	DeleteWebhook(ctx context.Context, in *DeleteWebhookRequest, opts ...grpc.CallOption) (*DeleteWebhookResponse, error)
+	WebhookList(ctx context.Context, in *WebhookListRequest, opts ...grpc.CallOption) (*WebhookListResponse, error)
+	CreateCommand(ctx context.Context, in *CreateCommandRequest, opts ...grpc.CallOption) (*CreateCommandResponse, error)
+	CommandInfo(ctx context.Context, in *CommandInfoRequest, opts ...grpc.CallOption) (*CommandInfoResponse, error)
+	CommandList(ctx context.Context, in *CommandListRequest, opts ...grpc.CallOption) (*CommandListResponse, error)
+	CreateEnrollmentToken(ctx context.Context, in *CreateEnrollmentTokenRequest, opts ...grpc.CallOption) (*CreateEnrollmentTokenResponse, error)
+	EnrollmentTokenList(ctx context.Context, in *EnrollmentTokenListRequest, opts ...grpc.CallOption) (*EnrollmentTokenListResponse, error)
+	RevokeEnrollmentToken(ctx context.Context, in *RevokeEnrollmentTokenRequest, opts ...grpc.CallOption) (*RevokeEnrollmentTokenResponse, error)
+	CertificateList(ctx context.Context, in *CertificateListRequest, opts ...grpc.CallOption) (*CertificateListResponse, error)
+	RevokeCertificate(ctx context.Context, in *RevokeCertificateRequest, opts ...grpc.CallOption) (*RevokeCertificateResponse, error)
+	CreateOperator(ctx context.Context, in *CreateOperatorRequest, opts ...grpc.CallOption) (*CreateOperatorResponse, error)
+	DeleteOperator(ctx context.Context, in *DeleteOperatorRequest, opts ...grpc.CallOption) (*DeleteOperatorResponse, error)
+	OperatorList(ctx context.Context, in *OperatorListRequest, opts ...grpc.CallOption) (*OperatorListResponse, error)
+	SetOperatorRoles(ctx context.Context, in *SetOperatorRolesRequest, opts ...grpc.CallOption) (*SetOperatorRolesResponse, error)
+	ResetOperatorToken(ctx context.Context, in *ResetOperatorTokenRequest, opts ...grpc.CallOption) (*ResetOperatorTokenResponse, error)
+	CreateRole(ctx context.Context, in *CreateRoleRequest, opts ...grpc.CallOption) (*CreateRoleResponse, error)
+	DeleteRole(ctx context.Context, in *DeleteRoleRequest, opts ...grpc.CallOption) (*DeleteRoleResponse, error)
+	RoleList(ctx context.Context, in *RoleListRequest, opts ...grpc.CallOption) (*RoleListResponse, error)
+	AuditLog(ctx context.Context, in *AuditLogRequest, opts ...grpc.CallOption) (*AuditLogResponse, error)
+	VerifyAuditLog(ctx context.Context, in *VerifyAuditLogRequest, opts ...grpc.CallOption) (*VerifyAuditLogResponse, error)
+	// WatchEvents streams the device events as they happen. A client that
+	// reconnects passes the seq of the last received event in after_seq to
+	// receive the events it missed, if the server still keeps them.
+	WatchEvents(ctx context.Context, in *WatchEventsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Event], error)
 }
 
 type controlClient struct {
@@ -81,6 +216,16 @@ func (c *controlClient) DeviceStatus(ctx context.Context, in *DeviceStatusReques
 	return out, nil
 }
 
+func (c *controlClient) DeviceStatusHistory(ctx context.Context, in *DeviceStatusHistoryRequest, opts ...grpc.CallOption) (*DeviceStatusHistoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeviceStatusHistoryResponse)
+	err := c.cc.Invoke(ctx, Control_DeviceStatusHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *controlClient) DeviceFeatures(ctx context.Context, in *DeviceFeaturesRequest, opts ...grpc.CallOption) (*DeviceFeaturesResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(DeviceFeaturesResponse)
@@ -91,6 +236,16 @@ func (c *controlClient) DeviceFeatures(ctx context.Context, in *DeviceFeaturesRe
 	return out, nil
 }
 
+func (c *controlClient) DeviceInventory(ctx context.Context, in *DeviceInventoryRequest, opts ...grpc.CallOption) (*DeviceInventoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeviceInventoryResponse)
+	err := c.cc.Invoke(ctx, Control_DeviceInventory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *controlClient) DeviceInfoList(ctx context.Context, in *DeviceInfoListRequest, opts ...grpc.CallOption) (*DeviceInfoListResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(DeviceInfoListResponse)
@@ -131,217 +286,2314 @@ func (c *controlClient) SetDeviceFeatureState(ctx context.Context, in *SetDevice
 	return out, nil
 }
 
-// ControlServer is the server API for Control service.
-// All implementations must embed UnimplementedControlServer
-// for forward compatibility.
-type ControlServer interface {
-	DeviceList(context.Context, *DeviceListRequest) (*DeviceListResponse, error)
-	DeviceInfo(context.Context, *DeviceInfoRequest) (*DeviceInfoResponse, error)
-	DeviceStatus(context.Context, *DeviceStatusRequest) (*DeviceStatusResponse, error)
-	DeviceFeatures(context.Context, *DeviceFeaturesRequest) (*DeviceFeaturesResponse, error)
-	DeviceInfoList(context.Context, *DeviceInfoListRequest) (*DeviceInfoListResponse, error)
-	DeviceStatusList(context.Context, *DeviceStatusListRequest) (*DeviceStatusListResponse, error)
-	DeviceFeaturesList(context.Context, *DeviceFeaturesListRequest) (*DeviceFeaturesListResponse, error)
-	SetDeviceFeatureState(context.Context, *SetDeviceFeatureStateRequest) (*SetDeviceFeatureStateResponse, error)
-	mustEmbedUnimplementedControlServer()
+func (c *controlClient) BulkSetFeatureState(ctx context.Context, in *BulkSetFeatureStateRequest, opts ...grpc.CallOption) (*BulkSetFeatureStateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BulkSetFeatureStateResponse)
+	err := c.cc.Invoke(ctx, Control_BulkSetFeatureState_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-// UnimplementedControlServer must be embedded to have
-// forward compatible implementations.
-//
-// NOTE: this should be embedded by value instead of pointer to avoid a nil
-// pointer dereference when methods are called.
-type UnimplementedControlServer struct{}
+func (c *controlClient) UpdateDeviceLabels(ctx context.Context, in *UpdateDeviceLabelsRequest, opts ...grpc.CallOption) (*UpdateDeviceLabelsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateDeviceLabelsResponse)
+	err := c.cc.Invoke(ctx, Control_UpdateDeviceLabels_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
 
-func (UnimplementedControlServer) DeviceList(context.Context, *DeviceListRequest) (*DeviceListResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeviceList not implemented")
+func (c *controlClient) SetDeviceLifecycle(ctx context.Context, in *SetDeviceLifecycleRequest, opts ...grpc.CallOption) (*SetDeviceLifecycleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetDeviceLifecycleResponse)
+	err := c.cc.Invoke(ctx, Control_SetDeviceLifecycle_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedControlServer) DeviceInfo(context.Context, *DeviceInfoRequest) (*DeviceInfoResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeviceInfo not implemented")
+
+func (c *controlClient) DeviceDriftList(ctx context.Context, in *DeviceDriftListRequest, opts ...grpc.CallOption) (*DeviceDriftListResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeviceDriftListResponse)
+	err := c.cc.Invoke(ctx, Control_DeviceDriftList_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedControlServer) DeviceStatus(context.Context, *DeviceStatusRequest) (*DeviceStatusResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeviceStatus not implemented")
+
+func (c *controlClient) CreateFeature(ctx context.Context, in *CreateFeatureRequest, opts ...grpc.CallOption) (*CreateFeatureResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateFeatureResponse)
+	err := c.cc.Invoke(ctx, Control_CreateFeature_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedControlServer) DeviceFeatures(context.Context, *DeviceFeaturesRequest) (*DeviceFeaturesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeviceFeatures not implemented")
+
+func (c *controlClient) FeatureInfo(ctx context.Context, in *FeatureInfoRequest, opts ...grpc.CallOption) (*FeatureInfoResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FeatureInfoResponse)
+	err := c.cc.Invoke(ctx, Control_FeatureInfo_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedControlServer) DeviceInfoList(context.Context, *DeviceInfoListRequest) (*DeviceInfoListResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeviceInfoList not implemented")
+
+func (c *controlClient) FeatureList(ctx context.Context, in *FeatureListRequest, opts ...grpc.CallOption) (*FeatureListResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FeatureListResponse)
+	err := c.cc.Invoke(ctx, Control_FeatureList_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controlClient) DeprecateFeature(ctx context.Context, in *DeprecateFeatureRequest, opts ...grpc.CallOption) (*DeprecateFeatureResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeprecateFeatureResponse)
+	err := c.cc.Invoke(ctx, Control_DeprecateFeature_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controlClient) DeleteFeature(ctx context.Context, in *DeleteFeatureRequest, opts ...grpc.CallOption) (*DeleteFeatureResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteFeatureResponse)
+	err := c.cc.Invoke(ctx, Control_DeleteFeature_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controlClient) CreateGroup(ctx context.Context, in *CreateGroupRequest, opts ...grpc.CallOption) (*CreateGroupResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateGroupResponse)
+	err := c.cc.Invoke(ctx, Control_CreateGroup_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controlClient) DeleteGroup(ctx context.Context, in *DeleteGroupRequest, opts ...grpc.CallOption) (*DeleteGroupResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteGroupResponse)
+	err := c.cc.Invoke(ctx, Control_DeleteGroup_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controlClient) GroupInfo(ctx context.Context, in *GroupInfoRequest, opts ...grpc.CallOption) (*GroupInfoResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GroupInfoResponse)
+	err := c.cc.Invoke(ctx, Control_GroupInfo_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controlClient) GroupList(ctx context.Context, in *GroupListRequest, opts ...grpc.CallOption) (*GroupListResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GroupListResponse)
+	err := c.cc.Invoke(ctx, Control_GroupList_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controlClient) AddGroupDevice(ctx context.Context, in *AddGroupDeviceRequest, opts ...grpc.CallOption) (*AddGroupDeviceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AddGroupDeviceResponse)
+	err := c.cc.Invoke(ctx, Control_AddGroupDevice_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controlClient) RemoveGroupDevice(ctx context.Context, in *RemoveGroupDeviceRequest, opts ...grpc.CallOption) (*RemoveGroupDeviceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RemoveGroupDeviceResponse)
+	err := c.cc.Invoke(ctx, Control_RemoveGroupDevice_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controlClient) SetGroupFeatureState(ctx context.Context, in *SetGroupFeatureStateRequest, opts ...grpc.CallOption) (*SetGroupFeatureStateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetGroupFeatureStateResponse)
+	err := c.cc.Invoke(ctx, Control_SetGroupFeatureState_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controlClient) ClearGroupFeatureState(ctx context.Context, in *ClearGroupFeatureStateRequest, opts ...grpc.CallOption) (*ClearGroupFeatureStateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ClearGroupFeatureStateResponse)
+	err := c.cc.Invoke(ctx, Control_ClearGroupFeatureState_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controlClient) ResetDeviceFeatureState(ctx context.Context, in *ResetDeviceFeatureStateRequest, opts ...grpc.CallOption) (*ResetDeviceFeatureStateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ResetDeviceFeatureStateResponse)
+	err := c.cc.Invoke(ctx, Control_ResetDeviceFeatureState_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controlClient) CreateProfile(ctx context.Context, in *CreateProfileRequest, opts ...grpc.CallOption) (*CreateProfileResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateProfileResponse)
+	err := c.cc.Invoke(ctx, Control_CreateProfile_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controlClient) UpdateProfile(ctx context.Context, in *UpdateProfileRequest, opts ...grpc.CallOption) (*UpdateProfileResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateProfileResponse)
+	err := c.cc.Invoke(ctx, Control_UpdateProfile_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controlClient) DeleteProfile(ctx context.Context, in *DeleteProfileRequest, opts ...grpc.CallOption) (*DeleteProfileResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteProfileResponse)
+	err := c.cc.Invoke(ctx, Control_DeleteProfile_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controlClient) ProfileInfo(ctx context.Context, in *ProfileInfoRequest, opts ...grpc.CallOption) (*ProfileInfoResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ProfileInfoResponse)
+	err := c.cc.Invoke(ctx, Control_ProfileInfo_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controlClient) ProfileList(ctx context.Context, in *ProfileListRequest, opts ...grpc.CallOption) (*ProfileListResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ProfileListResponse)
+	err := c.cc.Invoke(ctx, Control_ProfileList_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controlClient) AssignProfile(ctx context.Context, in *AssignProfileRequest, opts ...grpc.CallOption) (*AssignProfileResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AssignProfileResponse)
+	err := c.cc.Invoke(ctx, Control_AssignProfile_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controlClient) UnassignProfile(ctx context.Context, in *UnassignProfileRequest, opts ...grpc.CallOption) (*UnassignProfileResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UnassignProfileResponse)
+	err := c.cc.Invoke(ctx, Control_UnassignProfile_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controlClient) CreateApp(ctx context.Context, in *CreateAppRequest, opts ...grpc.CallOption) (*CreateAppResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateAppResponse)
+	err := c.cc.Invoke(ctx, Control_CreateApp_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controlClient) DeleteApp(ctx context.Context, in *DeleteAppRequest, opts ...grpc.CallOption) (*DeleteAppResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteAppResponse)
+	err := c.cc.Invoke(ctx, Control_DeleteApp_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controlClient) AppList(ctx context.Context, in *AppListRequest, opts ...grpc.CallOption) (*AppListResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AppListResponse)
+	err := c.cc.Invoke(ctx, Control_AppList_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controlClient) SetAppRule(ctx context.Context, in *SetAppRuleRequest, opts ...grpc.CallOption) (*SetAppRuleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetAppRuleResponse)
+	err := c.cc.Invoke(ctx, Control_SetAppRule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controlClient) ClearAppRule(ctx context.Context, in *ClearAppRuleRequest, opts ...grpc.CallOption) (*ClearAppRuleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ClearAppRuleResponse)
+	err := c.cc.Invoke(ctx, Control_ClearAppRule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controlClient) DeviceAppCompliance(ctx context.Context, in *DeviceAppComplianceRequest, opts ...grpc.CallOption) (*DeviceAppComplianceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeviceAppComplianceResponse)
+	err := c.cc.Invoke(ctx, Control_DeviceAppCompliance_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controlClient) AppViolationList(ctx context.Context, in *AppViolationListRequest, opts ...grpc.CallOption) (*AppViolationListResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AppViolationListResponse)
+	err := c.cc.Invoke(ctx, Control_AppViolationList_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controlClient) CreateAlertRule(ctx context.Context, in *CreateAlertRuleRequest, opts ...grpc.CallOption) (*CreateAlertRuleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateAlertRuleResponse)
+	err := c.cc.Invoke(ctx, Control_CreateAlertRule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controlClient) DeleteAlertRule(ctx context.Context, in *DeleteAlertRuleRequest, opts ...grpc.CallOption) (*DeleteAlertRuleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteAlertRuleResponse)
+	err := c.cc.Invoke(ctx, Control_DeleteAlertRule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controlClient) AlertRuleList(ctx context.Context, in *AlertRuleListRequest, opts ...grpc.CallOption) (*AlertRuleListResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AlertRuleListResponse)
+	err := c.cc.Invoke(ctx, Control_AlertRuleList_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controlClient) AlertList(ctx context.Context, in *AlertListRequest, opts ...grpc.CallOption) (*AlertListResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AlertListResponse)
+	err := c.cc.Invoke(ctx, Control_AlertList_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controlClient) AcknowledgeAlert(ctx context.Context, in *AcknowledgeAlertRequest, opts ...grpc.CallOption) (*AcknowledgeAlertResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AcknowledgeAlertResponse)
+	err := c.cc.Invoke(ctx, Control_AcknowledgeAlert_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controlClient) CreateComplianceRule(ctx context.Context, in *CreateComplianceRuleRequest, opts ...grpc.CallOption) (*CreateComplianceRuleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateComplianceRuleResponse)
+	err := c.cc.Invoke(ctx, Control_CreateComplianceRule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controlClient) DeleteComplianceRule(ctx context.Context, in *DeleteComplianceRuleRequest, opts ...grpc.CallOption) (*DeleteComplianceRuleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteComplianceRuleResponse)
+	err := c.cc.Invoke(ctx, Control_DeleteComplianceRule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controlClient) ComplianceRuleList(ctx context.Context, in *ComplianceRuleListRequest, opts ...grpc.CallOption) (*ComplianceRuleListResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ComplianceRuleListResponse)
+	err := c.cc.Invoke(ctx, Control_ComplianceRuleList_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controlClient) DeviceCompliance(ctx context.Context, in *DeviceComplianceRequest, opts ...grpc.CallOption) (*DeviceComplianceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeviceComplianceResponse)
+	err := c.cc.Invoke(ctx, Control_DeviceCompliance_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controlClient) ComplianceSummary(ctx context.Context, in *ComplianceSummaryRequest, opts ...grpc.CallOption) (*ComplianceSummaryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ComplianceSummaryResponse)
+	err := c.cc.Invoke(ctx, Control_ComplianceSummary_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controlClient) CreateWebhook(ctx context.Context, in *CreateWebhookRequest, opts ...grpc.CallOption) (*CreateWebhookResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateWebhookResponse)
+	err := c.cc.Invoke(ctx, Control_CreateWebhook_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controlClient) DeleteWebhook(ctx context.Context, in *DeleteWebhookRequest, opts ...grpc.CallOption) (*DeleteWebhookResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteWebhookResponse)
+	err := c.cc.Invoke(ctx, Control_DeleteWebhook_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controlClient) WebhookList(ctx context.Context, in *WebhookListRequest, opts ...grpc.CallOption) (*WebhookListResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(WebhookListResponse)
+	err := c.cc.Invoke(ctx, Control_WebhookList_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controlClient) CreateCommand(ctx context.Context, in *CreateCommandRequest, opts ...grpc.CallOption) (*CreateCommandResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateCommandResponse)
+	err := c.cc.Invoke(ctx, Control_CreateCommand_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controlClient) CommandInfo(ctx context.Context, in *CommandInfoRequest, opts ...grpc.CallOption) (*CommandInfoResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CommandInfoResponse)
+	err := c.cc.Invoke(ctx, Control_CommandInfo_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controlClient) CommandList(ctx context.Context, in *CommandListRequest, opts ...grpc.CallOption) (*CommandListResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CommandListResponse)
+	err := c.cc.Invoke(ctx, Control_CommandList_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controlClient) CreateEnrollmentToken(ctx context.Context, in *CreateEnrollmentTokenRequest, opts ...grpc.CallOption) (*CreateEnrollmentTokenResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateEnrollmentTokenResponse)
+	err := c.cc.Invoke(ctx, Control_CreateEnrollmentToken_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controlClient) EnrollmentTokenList(ctx context.Context, in *EnrollmentTokenListRequest, opts ...grpc.CallOption) (*EnrollmentTokenListResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EnrollmentTokenListResponse)
+	err := c.cc.Invoke(ctx, Control_EnrollmentTokenList_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controlClient) RevokeEnrollmentToken(ctx context.Context, in *RevokeEnrollmentTokenRequest, opts ...grpc.CallOption) (*RevokeEnrollmentTokenResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RevokeEnrollmentTokenResponse)
+	err := c.cc.Invoke(ctx, Control_RevokeEnrollmentToken_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controlClient) CertificateList(ctx context.Context, in *CertificateListRequest, opts ...grpc.CallOption) (*CertificateListResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CertificateListResponse)
+	err := c.cc.Invoke(ctx, Control_CertificateList_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controlClient) RevokeCertificate(ctx context.Context, in *RevokeCertificateRequest, opts ...grpc.CallOption) (*RevokeCertificateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RevokeCertificateResponse)
+	err := c.cc.Invoke(ctx, Control_RevokeCertificate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controlClient) CreateOperator(ctx context.Context, in *CreateOperatorRequest, opts ...grpc.CallOption) (*CreateOperatorResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateOperatorResponse)
+	err := c.cc.Invoke(ctx, Control_CreateOperator_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controlClient) DeleteOperator(ctx context.Context, in *DeleteOperatorRequest, opts ...grpc.CallOption) (*DeleteOperatorResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteOperatorResponse)
+	err := c.cc.Invoke(ctx, Control_DeleteOperator_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controlClient) OperatorList(ctx context.Context, in *OperatorListRequest, opts ...grpc.CallOption) (*OperatorListResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(OperatorListResponse)
+	err := c.cc.Invoke(ctx, Control_OperatorList_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controlClient) SetOperatorRoles(ctx context.Context, in *SetOperatorRolesRequest, opts ...grpc.CallOption) (*SetOperatorRolesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetOperatorRolesResponse)
+	err := c.cc.Invoke(ctx, Control_SetOperatorRoles_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controlClient) ResetOperatorToken(ctx context.Context, in *ResetOperatorTokenRequest, opts ...grpc.CallOption) (*ResetOperatorTokenResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ResetOperatorTokenResponse)
+	err := c.cc.Invoke(ctx, Control_ResetOperatorToken_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controlClient) CreateRole(ctx context.Context, in *CreateRoleRequest, opts ...grpc.CallOption) (*CreateRoleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateRoleResponse)
+	err := c.cc.Invoke(ctx, Control_CreateRole_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controlClient) DeleteRole(ctx context.Context, in *DeleteRoleRequest, opts ...grpc.CallOption) (*DeleteRoleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteRoleResponse)
+	err := c.cc.Invoke(ctx, Control_DeleteRole_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controlClient) RoleList(ctx context.Context, in *RoleListRequest, opts ...grpc.CallOption) (*RoleListResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RoleListResponse)
+	err := c.cc.Invoke(ctx, Control_RoleList_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controlClient) AuditLog(ctx context.Context, in *AuditLogRequest, opts ...grpc.CallOption) (*AuditLogResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AuditLogResponse)
+	err := c.cc.Invoke(ctx, Control_AuditLog_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controlClient) VerifyAuditLog(ctx context.Context, in *VerifyAuditLogRequest, opts ...grpc.CallOption) (*VerifyAuditLogResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(VerifyAuditLogResponse)
+	err := c.cc.Invoke(ctx, Control_VerifyAuditLog_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controlClient) WatchEvents(ctx context.Context, in *WatchEventsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Event], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &Control_ServiceDesc.Streams[0], Control_WatchEvents_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[WatchEventsRequest, Event]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Control_WatchEventsClient = grpc.ServerStreamingClient[Event]
+
+// ControlServer is the server API for Control service.
+// All implementations must embed UnimplementedControlServer
+// for forward compatibility.
+type ControlServer interface {
+	DeviceList(context.Context, *DeviceListRequest) (*DeviceListResponse, error)
+	DeviceInfo(context.Context, *DeviceInfoRequest) (*DeviceInfoResponse, error)
+	DeviceStatus(context.Context, *DeviceStatusRequest) (*DeviceStatusResponse, error)
+	DeviceStatusHistory(context.Context, *DeviceStatusHistoryRequest) (*DeviceStatusHistoryResponse, error)
+	DeviceFeatures(context.Context, *DeviceFeaturesRequest) (*DeviceFeaturesResponse, error)
+	DeviceInventory(context.Context, *DeviceInventoryRequest) (*DeviceInventoryResponse, error)
+	DeviceInfoList(context.Context, *DeviceInfoListRequest) (*DeviceInfoListResponse, error)
+	DeviceStatusList(context.Context, *DeviceStatusListRequest) (*DeviceStatusListResponse, error)
+	DeviceFeaturesList(context.Context, *DeviceFeaturesListRequest) (*DeviceFeaturesListResponse, error)
+	SetDeviceFeatureState(context.Context, *SetDeviceFeatureStateRequest) (*SetDeviceFeatureStateResponse, error)
+	BulkSetFeatureState(context.Context, *BulkSetFeatureStateRequest) (*BulkSetFeatureStateResponse, error)
+	UpdateDeviceLabels(context.Context, *UpdateDeviceLabelsRequest) (*UpdateDeviceLabelsResponse, error)
+	SetDeviceLifecycle(context.Context, *SetDeviceLifecycleRequest) (*SetDeviceLifecycleResponse, error)
+	DeviceDriftList(context.Context, *DeviceDriftListRequest) (*DeviceDriftListResponse, error)
+	CreateFeature(context.Context, *CreateFeatureRequest) (*CreateFeatureResponse, error)
+	FeatureInfo(context.Context, *FeatureInfoRequest) (*FeatureInfoResponse, error)
+	FeatureList(context.Context, *FeatureListRequest) (*FeatureListResponse, error)
+	DeprecateFeature(context.Context, *DeprecateFeatureRequest) (*DeprecateFeatureResponse, error)
+	DeleteFeature(context.Context, *DeleteFeatureRequest) (*DeleteFeatureResponse, error)
+	CreateGroup(context.Context, *CreateGroupRequest) (*CreateGroupResponse, error)
+	DeleteGroup(context.Context, *DeleteGroupRequest) (*DeleteGroupResponse, error)
+	GroupInfo(context.Context, *GroupInfoRequest) (*GroupInfoResponse, error)
+	GroupList(context.Context, *GroupListRequest) (*GroupListResponse, error)
+	AddGroupDevice(context.Context, *AddGroupDeviceRequest) (*AddGroupDeviceResponse, error)
+	RemoveGroupDevice(context.Context, *RemoveGroupDeviceRequest) (*RemoveGroupDeviceResponse, error)
+	SetGroupFeatureState(context.Context, *SetGroupFeatureStateRequest) (*SetGroupFeatureStateResponse, error)
+	ClearGroupFeatureState(context.Context, *ClearGroupFeatureStateRequest) (*ClearGroupFeatureStateResponse, error)
+	ResetDeviceFeatureState(context.Context, *ResetDeviceFeatureStateRequest) (*ResetDeviceFeatureStateResponse, error)
+	CreateProfile(context.Context, *CreateProfileRequest) (*CreateProfileResponse, error)
+	UpdateProfile(context.Context, *UpdateProfileRequest) (*UpdateProfileResponse, error)
+	DeleteProfile(context.Context, *DeleteProfileRequest) (*DeleteProfileResponse, error)
+	ProfileInfo(context.Context, *ProfileInfoRequest) (*ProfileInfoResponse, error)
+	ProfileList(context.Context, *ProfileListRequest) (*ProfileListResponse, error)
+	AssignProfile(context.Context, *AssignProfileRequest) (*AssignProfileResponse, error)
+	UnassignProfile(context.Context, *UnassignProfileRequest) (*UnassignProfileResponse, error)
+	CreateApp(context.Context, *CreateAppRequest) (*CreateAppResponse, error)
+	DeleteApp(context.Context, *DeleteAppRequest) (*DeleteAppResponse, error)
+	AppList(context.Context, *AppListRequest) (*AppListResponse, error)
+	SetAppRule(context.Context, *SetAppRuleRequest) (*SetAppRuleResponse, error)
+	ClearAppRule(context.Context, *ClearAppRuleRequest) (*ClearAppRuleResponse, error)
+	DeviceAppCompliance(context.Context, *DeviceAppComplianceRequest) (*DeviceAppComplianceResponse, error)
+	AppViolationList(context.Context, *AppViolationListRequest) (*AppViolationListResponse, error)
+	CreateAlertRule(context.Context, *CreateAlertRuleRequest) (*CreateAlertRuleResponse, error)
+	DeleteAlertRule(context.Context, *DeleteAlertRuleRequest) (*DeleteAlertRuleResponse, error)
+	AlertRuleList(context.Context, *AlertRuleListRequest) (*AlertRuleListResponse, error)
+	AlertList(context.Context, *AlertListRequest) (*AlertListResponse, error)
+	AcknowledgeAlert(context.Context, *AcknowledgeAlertRequest) (*AcknowledgeAlertResponse, error)
+	CreateComplianceRule(context.Context, *CreateComplianceRuleRequest) (*CreateComplianceRuleResponse, error)
+	DeleteComplianceRule(context.Context, *DeleteComplianceRuleRequest) (*DeleteComplianceRuleResponse, error)
+	ComplianceRuleList(context.Context, *ComplianceRuleListRequest) (*ComplianceRuleListResponse, error)
+	DeviceCompliance(context.Context, *DeviceComplianceRequest) (*DeviceComplianceResponse, error)
+	ComplianceSummary(context.Context, *ComplianceSummaryRequest) (*ComplianceSummaryResponse, error)
+	CreateWebhook(context.Context, *CreateWebhookRequest) (*CreateWebhookResponse, error)
+	DeleteWebhook(context.Context, *DeleteWebhookRequest) (*DeleteWebhookResponse, error)
+	WebhookList(context.Context, *WebhookListRequest) (*WebhookListResponse, error)
+	CreateCommand(context.Context, *CreateCommandRequest) (*CreateCommandResponse, error)
+	CommandInfo(context.Context, *CommandInfoRequest) (*CommandInfoResponse, error)
+	CommandList(context.Context, *CommandListRequest) (*CommandListResponse, error)
+	CreateEnrollmentToken(context.Context, *CreateEnrollmentTokenRequest) (*CreateEnrollmentTokenResponse, error)
+	EnrollmentTokenList(context.Context, *EnrollmentTokenListRequest) (*EnrollmentTokenListResponse, error)
+	RevokeEnrollmentToken(context.Context, *RevokeEnrollmentTokenRequest) (*RevokeEnrollmentTokenResponse, error)
+	CertificateList(context.Context, *CertificateListRequest) (*CertificateListResponse, error)
+	RevokeCertificate(context.Context, *RevokeCertificateRequest) (*RevokeCertificateResponse, error)
+	CreateOperator(context.Context, *CreateOperatorRequest) (*CreateOperatorResponse, error)
+	DeleteOperator(context.Context, *DeleteOperatorRequest) (*DeleteOperatorResponse, error)
+	OperatorList(context.Context, *OperatorListRequest) (*OperatorListResponse, error)
+	SetOperatorRoles(context.Context, *SetOperatorRolesRequest) (*SetOperatorRolesResponse, error)
+	ResetOperatorToken(context.Context, *ResetOperatorTokenRequest) (*ResetOperatorTokenResponse, error)
+	CreateRole(context.Context, *CreateRoleRequest) (*CreateRoleResponse, error)
+	DeleteRole(context.Context, *DeleteRoleRequest) (*DeleteRoleResponse, error)
+	RoleList(context.Context, *RoleListRequest) (*RoleListResponse, error)
+	AuditLog(context.Context, *AuditLogRequest) (*AuditLogResponse, error)
+	VerifyAuditLog(context.Context, *VerifyAuditLogRequest) (*VerifyAuditLogResponse, error)
+	// WatchEvents streams the device events as they happen. A client that
+	// reconnects passes the seq of the last received event in after_seq to
+	// receive the events it missed, if the server still keeps them.
+	WatchEvents(*WatchEventsRequest, grpc.ServerStreamingServer[Event]) error
+	mustEmbedUnimplementedControlServer()
+}
+
+// UnimplementedControlServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedControlServer struct{}
+
+func (UnimplementedControlServer) DeviceList(context.Context, *DeviceListRequest) (*DeviceListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeviceList not implemented")
+}
+func (UnimplementedControlServer) DeviceInfo(context.Context, *DeviceInfoRequest) (*DeviceInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeviceInfo not implemented")
+}
+func (UnimplementedControlServer) DeviceStatus(context.Context, *DeviceStatusRequest) (*DeviceStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeviceStatus not implemented")
+}
+func (UnimplementedControlServer) DeviceStatusHistory(context.Context, *DeviceStatusHistoryRequest) (*DeviceStatusHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeviceStatusHistory not implemented")
+}
+func (UnimplementedControlServer) DeviceFeatures(context.Context, *DeviceFeaturesRequest) (*DeviceFeaturesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeviceFeatures not implemented")
+}
+func (UnimplementedControlServer) DeviceInventory(context.Context, *DeviceInventoryRequest) (*DeviceInventoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeviceInventory not implemented")
+}
+func (UnimplementedControlServer) DeviceInfoList(context.Context, *DeviceInfoListRequest) (*DeviceInfoListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeviceInfoList not implemented")
+}
+func (UnimplementedControlServer) DeviceStatusList(context.Context, *DeviceStatusListRequest) (*DeviceStatusListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeviceStatusList not implemented")
+}
+func (UnimplementedControlServer) DeviceFeaturesList(context.Context, *DeviceFeaturesListRequest) (*DeviceFeaturesListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeviceFeaturesList not implemented")
+}
+func (UnimplementedControlServer) SetDeviceFeatureState(context.Context, *SetDeviceFeatureStateRequest) (*SetDeviceFeatureStateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetDeviceFeatureState not implemented")
+}
+func (UnimplementedControlServer) BulkSetFeatureState(context.Context, *BulkSetFeatureStateRequest) (*BulkSetFeatureStateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BulkSetFeatureState not implemented")
+}
+func (UnimplementedControlServer) UpdateDeviceLabels(context.Context, *UpdateDeviceLabelsRequest) (*UpdateDeviceLabelsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateDeviceLabels not implemented")
+}
+func (UnimplementedControlServer) SetDeviceLifecycle(context.Context, *SetDeviceLifecycleRequest) (*SetDeviceLifecycleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetDeviceLifecycle not implemented")
+}
+func (UnimplementedControlServer) DeviceDriftList(context.Context, *DeviceDriftListRequest) (*DeviceDriftListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeviceDriftList not implemented")
+}
+func (UnimplementedControlServer) CreateFeature(context.Context, *CreateFeatureRequest) (*CreateFeatureResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateFeature not implemented")
+}
+func (UnimplementedControlServer) FeatureInfo(context.Context, *FeatureInfoRequest) (*FeatureInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FeatureInfo not implemented")
+}
+func (UnimplementedControlServer) FeatureList(context.Context, *FeatureListRequest) (*FeatureListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FeatureList not implemented")
+}
+func (UnimplementedControlServer) DeprecateFeature(context.Context, *DeprecateFeatureRequest) (*DeprecateFeatureResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeprecateFeature not implemented")
+}
+func (UnimplementedControlServer) DeleteFeature(context.Context, *DeleteFeatureRequest) (*DeleteFeatureResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteFeature not implemented")
+}
+func (UnimplementedControlServer) CreateGroup(context.Context, *CreateGroupRequest) (*CreateGroupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateGroup not implemented")
+}
+func (UnimplementedControlServer) DeleteGroup(context.Context, *DeleteGroupRequest) (*DeleteGroupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteGroup not implemented")
+}
+func (UnimplementedControlServer) GroupInfo(context.Context, *GroupInfoRequest) (*GroupInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GroupInfo not implemented")
+}
+func (UnimplementedControlServer) GroupList(context.Context, *GroupListRequest) (*GroupListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GroupList not implemented")
+}
+func (UnimplementedControlServer) AddGroupDevice(context.Context, *AddGroupDeviceRequest) (*AddGroupDeviceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddGroupDevice not implemented")
+}
+func (UnimplementedControlServer) RemoveGroupDevice(context.Context, *RemoveGroupDeviceRequest) (*RemoveGroupDeviceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveGroupDevice not implemented")
+}
+func (UnimplementedControlServer) SetGroupFeatureState(context.Context, *SetGroupFeatureStateRequest) (*SetGroupFeatureStateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetGroupFeatureState not implemented")
+}
+func (UnimplementedControlServer) ClearGroupFeatureState(context.Context, *ClearGroupFeatureStateRequest) (*ClearGroupFeatureStateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClearGroupFeatureState not implemented")
+}
+func (UnimplementedControlServer) ResetDeviceFeatureState(context.Context, *ResetDeviceFeatureStateRequest) (*ResetDeviceFeatureStateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResetDeviceFeatureState not implemented")
+}
+func (UnimplementedControlServer) CreateProfile(context.Context, *CreateProfileRequest) (*CreateProfileResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateProfile not implemented")
+}
+func (UnimplementedControlServer) UpdateProfile(context.Context, *UpdateProfileRequest) (*UpdateProfileResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateProfile not implemented")
+}
+func (UnimplementedControlServer) DeleteProfile(context.Context, *DeleteProfileRequest) (*DeleteProfileResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteProfile not implemented")
+}
+func (UnimplementedControlServer) ProfileInfo(context.Context, *ProfileInfoRequest) (*ProfileInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProfileInfo not implemented")
+}
+func (UnimplementedControlServer) ProfileList(context.Context, *ProfileListRequest) (*ProfileListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProfileList not implemented")
+}
+func (UnimplementedControlServer) AssignProfile(context.Context, *AssignProfileRequest) (*AssignProfileResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AssignProfile not implemented")
+}
+func (UnimplementedControlServer) UnassignProfile(context.Context, *UnassignProfileRequest) (*UnassignProfileResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnassignProfile not implemented")
+}
+func (UnimplementedControlServer) CreateApp(context.Context, *CreateAppRequest) (*CreateAppResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateApp not implemented")
+}
+func (UnimplementedControlServer) DeleteApp(context.Context, *DeleteAppRequest) (*DeleteAppResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteApp not implemented")
+}
+func (UnimplementedControlServer) AppList(context.Context, *AppListRequest) (*AppListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AppList not implemented")
+}
+func (UnimplementedControlServer) SetAppRule(context.Context, *SetAppRuleRequest) (*SetAppRuleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetAppRule not implemented")
+}
+func (UnimplementedControlServer) ClearAppRule(context.Context, *ClearAppRuleRequest) (*ClearAppRuleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClearAppRule not implemented")
+}
+func (UnimplementedControlServer) DeviceAppCompliance(context.Context, *DeviceAppComplianceRequest) (*DeviceAppComplianceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeviceAppCompliance not implemented")
+}
+func (UnimplementedControlServer) AppViolationList(context.Context, *AppViolationListRequest) (*AppViolationListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AppViolationList not implemented")
+}
+func (UnimplementedControlServer) CreateAlertRule(context.Context, *CreateAlertRuleRequest) (*CreateAlertRuleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateAlertRule not implemented")
+}
+func (UnimplementedControlServer) DeleteAlertRule(context.Context, *DeleteAlertRuleRequest) (*DeleteAlertRuleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteAlertRule not implemented")
+}
+func (UnimplementedControlServer) AlertRuleList(context.Context, *AlertRuleListRequest) (*AlertRuleListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AlertRuleList not implemented")
+}
+func (UnimplementedControlServer) AlertList(context.Context, *AlertListRequest) (*AlertListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AlertList not implemented")
+}
+func (UnimplementedControlServer) AcknowledgeAlert(context.Context, *AcknowledgeAlertRequest) (*AcknowledgeAlertResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcknowledgeAlert not implemented")
+}
+func (UnimplementedControlServer) CreateComplianceRule(context.Context, *CreateComplianceRuleRequest) (*CreateComplianceRuleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateComplianceRule not implemented")
+}
+func (UnimplementedControlServer) DeleteComplianceRule(context.Context, *DeleteComplianceRuleRequest) (*DeleteComplianceRuleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteComplianceRule not implemented")
+}
+func (UnimplementedControlServer) ComplianceRuleList(context.Context, *ComplianceRuleListRequest) (*ComplianceRuleListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ComplianceRuleList not implemented")
+}
+func (UnimplementedControlServer) DeviceCompliance(context.Context, *DeviceComplianceRequest) (*DeviceComplianceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeviceCompliance not implemented")
+}
+func (UnimplementedControlServer) ComplianceSummary(context.Context, *ComplianceSummaryRequest) (*ComplianceSummaryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ComplianceSummary not implemented")
+}
+func (UnimplementedControlServer) CreateWebhook(context.Context, *CreateWebhookRequest) (*CreateWebhookResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateWebhook not implemented")
+}
+func (UnimplementedControlServer) DeleteWebhook(context.Context, *DeleteWebhookRequest) (*DeleteWebhookResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteWebhook not implemented")
+}
+func (UnimplementedControlServer) WebhookList(context.Context, *WebhookListRequest) (*WebhookListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WebhookList not implemented")
+}
+func (UnimplementedControlServer) CreateCommand(context.Context, *CreateCommandRequest) (*CreateCommandResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateCommand not implemented")
+}
+func (UnimplementedControlServer) CommandInfo(context.Context, *CommandInfoRequest) (*CommandInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CommandInfo not implemented")
+}
+func (UnimplementedControlServer) CommandList(context.Context, *CommandListRequest) (*CommandListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CommandList not implemented")
+}
+func (UnimplementedControlServer) CreateEnrollmentToken(context.Context, *CreateEnrollmentTokenRequest) (*CreateEnrollmentTokenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateEnrollmentToken not implemented")
+}
+func (UnimplementedControlServer) EnrollmentTokenList(context.Context, *EnrollmentTokenListRequest) (*EnrollmentTokenListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EnrollmentTokenList not implemented")
+}
+func (UnimplementedControlServer) RevokeEnrollmentToken(context.Context, *RevokeEnrollmentTokenRequest) (*RevokeEnrollmentTokenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeEnrollmentToken not implemented")
+}
+func (UnimplementedControlServer) CertificateList(context.Context, *CertificateListRequest) (*CertificateListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CertificateList not implemented")
+}
+func (UnimplementedControlServer) RevokeCertificate(context.Context, *RevokeCertificateRequest) (*RevokeCertificateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeCertificate not implemented")
+}
+func (UnimplementedControlServer) CreateOperator(context.Context, *CreateOperatorRequest) (*CreateOperatorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateOperator not implemented")
+}
+func (UnimplementedControlServer) DeleteOperator(context.Context, *DeleteOperatorRequest) (*DeleteOperatorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteOperator not implemented")
+}
+func (UnimplementedControlServer) OperatorList(context.Context, *OperatorListRequest) (*OperatorListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OperatorList not implemented")
+}
+func (UnimplementedControlServer) SetOperatorRoles(context.Context, *SetOperatorRolesRequest) (*SetOperatorRolesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetOperatorRoles not implemented")
+}
+func (UnimplementedControlServer) ResetOperatorToken(context.Context, *ResetOperatorTokenRequest) (*ResetOperatorTokenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResetOperatorToken not implemented")
+}
+func (UnimplementedControlServer) CreateRole(context.Context, *CreateRoleRequest) (*CreateRoleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateRole not implemented")
+}
+func (UnimplementedControlServer) DeleteRole(context.Context, *DeleteRoleRequest) (*DeleteRoleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteRole not implemented")
+}
+func (UnimplementedControlServer) RoleList(context.Context, *RoleListRequest) (*RoleListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RoleList not implemented")
+}
+func (UnimplementedControlServer) AuditLog(context.Context, *AuditLogRequest) (*AuditLogResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AuditLog not implemented")
+}
+func (UnimplementedControlServer) VerifyAuditLog(context.Context, *VerifyAuditLogRequest) (*VerifyAuditLogResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifyAuditLog not implemented")
+}
+func (UnimplementedControlServer) WatchEvents(*WatchEventsRequest, grpc.ServerStreamingServer[Event]) error {
+	return status.Errorf(codes.Unimplemented, "method WatchEvents not implemented")
+}
+func (UnimplementedControlServer) mustEmbedUnimplementedControlServer() {}
+func (UnimplementedControlServer) testEmbeddedByValue()                 {}
+
+// UnsafeControlServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ControlServer will
+// result in compilation errors.
+type UnsafeControlServer interface {
+	mustEmbedUnimplementedControlServer()
+}
+
+func RegisterControlServer(s grpc.ServiceRegistrar, srv ControlServer) {
+	// If the following call pancis, it indicates UnimplementedControlServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&Control_ServiceDesc, srv)
+}
+
+func _Control_DeviceList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeviceListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControlServer).DeviceList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Control_DeviceList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControlServer).DeviceList(ctx, req.(*DeviceListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Control_DeviceInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeviceInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControlServer).DeviceInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Control_DeviceInfo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControlServer).DeviceInfo(ctx, req.(*DeviceInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Control_DeviceStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeviceStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControlServer).DeviceStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Control_DeviceStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControlServer).DeviceStatus(ctx, req.(*DeviceStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Control_DeviceStatusHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeviceStatusHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControlServer).DeviceStatusHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Control_DeviceStatusHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControlServer).DeviceStatusHistory(ctx, req.(*DeviceStatusHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Control_DeviceFeatures_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeviceFeaturesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControlServer).DeviceFeatures(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Control_DeviceFeatures_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControlServer).DeviceFeatures(ctx, req.(*DeviceFeaturesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Control_DeviceInventory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeviceInventoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControlServer).DeviceInventory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Control_DeviceInventory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControlServer).DeviceInventory(ctx, req.(*DeviceInventoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Control_DeviceInfoList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeviceInfoListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControlServer).DeviceInfoList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Control_DeviceInfoList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControlServer).DeviceInfoList(ctx, req.(*DeviceInfoListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Control_DeviceStatusList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeviceStatusListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControlServer).DeviceStatusList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Control_DeviceStatusList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControlServer).DeviceStatusList(ctx, req.(*DeviceStatusListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Control_DeviceFeaturesList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeviceFeaturesListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControlServer).DeviceFeaturesList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Control_DeviceFeaturesList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControlServer).DeviceFeaturesList(ctx, req.(*DeviceFeaturesListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Control_SetDeviceFeatureState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetDeviceFeatureStateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControlServer).SetDeviceFeatureState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Control_SetDeviceFeatureState_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControlServer).SetDeviceFeatureState(ctx, req.(*SetDeviceFeatureStateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Control_BulkSetFeatureState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BulkSetFeatureStateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControlServer).BulkSetFeatureState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Control_BulkSetFeatureState_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControlServer).BulkSetFeatureState(ctx, req.(*BulkSetFeatureStateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Control_UpdateDeviceLabels_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateDeviceLabelsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControlServer).UpdateDeviceLabels(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Control_UpdateDeviceLabels_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControlServer).UpdateDeviceLabels(ctx, req.(*UpdateDeviceLabelsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Control_SetDeviceLifecycle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetDeviceLifecycleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControlServer).SetDeviceLifecycle(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Control_SetDeviceLifecycle_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControlServer).SetDeviceLifecycle(ctx, req.(*SetDeviceLifecycleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Control_DeviceDriftList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeviceDriftListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControlServer).DeviceDriftList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Control_DeviceDriftList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControlServer).DeviceDriftList(ctx, req.(*DeviceDriftListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Control_CreateFeature_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateFeatureRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControlServer).CreateFeature(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Control_CreateFeature_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControlServer).CreateFeature(ctx, req.(*CreateFeatureRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Control_FeatureInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FeatureInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControlServer).FeatureInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Control_FeatureInfo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControlServer).FeatureInfo(ctx, req.(*FeatureInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Control_FeatureList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FeatureListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControlServer).FeatureList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Control_FeatureList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControlServer).FeatureList(ctx, req.(*FeatureListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Control_DeprecateFeature_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeprecateFeatureRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControlServer).DeprecateFeature(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Control_DeprecateFeature_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControlServer).DeprecateFeature(ctx, req.(*DeprecateFeatureRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Control_DeleteFeature_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteFeatureRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControlServer).DeleteFeature(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Control_DeleteFeature_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControlServer).DeleteFeature(ctx, req.(*DeleteFeatureRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Control_CreateGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControlServer).CreateGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Control_CreateGroup_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControlServer).CreateGroup(ctx, req.(*CreateGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Control_DeleteGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControlServer).DeleteGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Control_DeleteGroup_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControlServer).DeleteGroup(ctx, req.(*DeleteGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Control_GroupInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GroupInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControlServer).GroupInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Control_GroupInfo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControlServer).GroupInfo(ctx, req.(*GroupInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Control_GroupList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GroupListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControlServer).GroupList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Control_GroupList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControlServer).GroupList(ctx, req.(*GroupListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Control_AddGroupDevice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddGroupDeviceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControlServer).AddGroupDevice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Control_AddGroupDevice_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControlServer).AddGroupDevice(ctx, req.(*AddGroupDeviceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Control_RemoveGroupDevice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveGroupDeviceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControlServer).RemoveGroupDevice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Control_RemoveGroupDevice_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControlServer).RemoveGroupDevice(ctx, req.(*RemoveGroupDeviceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Control_SetGroupFeatureState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetGroupFeatureStateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControlServer).SetGroupFeatureState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Control_SetGroupFeatureState_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControlServer).SetGroupFeatureState(ctx, req.(*SetGroupFeatureStateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Control_ClearGroupFeatureState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClearGroupFeatureStateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControlServer).ClearGroupFeatureState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Control_ClearGroupFeatureState_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControlServer).ClearGroupFeatureState(ctx, req.(*ClearGroupFeatureStateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Control_ResetDeviceFeatureState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResetDeviceFeatureStateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControlServer).ResetDeviceFeatureState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Control_ResetDeviceFeatureState_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControlServer).ResetDeviceFeatureState(ctx, req.(*ResetDeviceFeatureStateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Control_CreateProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateProfileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControlServer).CreateProfile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Control_CreateProfile_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControlServer).CreateProfile(ctx, req.(*CreateProfileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Control_UpdateProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateProfileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControlServer).UpdateProfile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Control_UpdateProfile_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControlServer).UpdateProfile(ctx, req.(*UpdateProfileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Control_DeleteProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteProfileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControlServer).DeleteProfile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Control_DeleteProfile_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControlServer).DeleteProfile(ctx, req.(*DeleteProfileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Control_ProfileInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProfileInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControlServer).ProfileInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Control_ProfileInfo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControlServer).ProfileInfo(ctx, req.(*ProfileInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Control_ProfileList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProfileListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControlServer).ProfileList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Control_ProfileList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControlServer).ProfileList(ctx, req.(*ProfileListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Control_AssignProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AssignProfileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControlServer).AssignProfile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Control_AssignProfile_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControlServer).AssignProfile(ctx, req.(*AssignProfileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Control_UnassignProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnassignProfileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControlServer).UnassignProfile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Control_UnassignProfile_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControlServer).UnassignProfile(ctx, req.(*UnassignProfileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Control_CreateApp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateAppRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControlServer).CreateApp(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Control_CreateApp_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControlServer).CreateApp(ctx, req.(*CreateAppRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Control_DeleteApp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteAppRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControlServer).DeleteApp(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Control_DeleteApp_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControlServer).DeleteApp(ctx, req.(*DeleteAppRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Control_AppList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AppListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControlServer).AppList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Control_AppList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControlServer).AppList(ctx, req.(*AppListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Control_SetAppRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetAppRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControlServer).SetAppRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Control_SetAppRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControlServer).SetAppRule(ctx, req.(*SetAppRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Control_ClearAppRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClearAppRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControlServer).ClearAppRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Control_ClearAppRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControlServer).ClearAppRule(ctx, req.(*ClearAppRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Control_DeviceAppCompliance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeviceAppComplianceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControlServer).DeviceAppCompliance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Control_DeviceAppCompliance_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControlServer).DeviceAppCompliance(ctx, req.(*DeviceAppComplianceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Control_AppViolationList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AppViolationListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControlServer).AppViolationList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Control_AppViolationList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControlServer).AppViolationList(ctx, req.(*AppViolationListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Control_CreateAlertRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateAlertRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControlServer).CreateAlertRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Control_CreateAlertRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControlServer).CreateAlertRule(ctx, req.(*CreateAlertRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Control_DeleteAlertRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteAlertRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControlServer).DeleteAlertRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Control_DeleteAlertRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControlServer).DeleteAlertRule(ctx, req.(*DeleteAlertRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Control_AlertRuleList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AlertRuleListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControlServer).AlertRuleList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Control_AlertRuleList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControlServer).AlertRuleList(ctx, req.(*AlertRuleListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Control_AlertList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AlertListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControlServer).AlertList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Control_AlertList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControlServer).AlertList(ctx, req.(*AlertListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Control_AcknowledgeAlert_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AcknowledgeAlertRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControlServer).AcknowledgeAlert(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Control_AcknowledgeAlert_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControlServer).AcknowledgeAlert(ctx, req.(*AcknowledgeAlertRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Control_CreateComplianceRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateComplianceRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControlServer).CreateComplianceRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Control_CreateComplianceRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControlServer).CreateComplianceRule(ctx, req.(*CreateComplianceRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Control_DeleteComplianceRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteComplianceRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControlServer).DeleteComplianceRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Control_DeleteComplianceRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControlServer).DeleteComplianceRule(ctx, req.(*DeleteComplianceRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Control_ComplianceRuleList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ComplianceRuleListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControlServer).ComplianceRuleList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Control_ComplianceRuleList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControlServer).ComplianceRuleList(ctx, req.(*ComplianceRuleListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Control_DeviceCompliance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeviceComplianceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControlServer).DeviceCompliance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Control_DeviceCompliance_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControlServer).DeviceCompliance(ctx, req.(*DeviceComplianceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Control_ComplianceSummary_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ComplianceSummaryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControlServer).ComplianceSummary(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Control_ComplianceSummary_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControlServer).ComplianceSummary(ctx, req.(*ComplianceSummaryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Control_CreateWebhook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateWebhookRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControlServer).CreateWebhook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Control_CreateWebhook_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControlServer).CreateWebhook(ctx, req.(*CreateWebhookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Control_DeleteWebhook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteWebhookRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControlServer).DeleteWebhook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Control_DeleteWebhook_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControlServer).DeleteWebhook(ctx, req.(*DeleteWebhookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Control_WebhookList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WebhookListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControlServer).WebhookList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Control_WebhookList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControlServer).WebhookList(ctx, req.(*WebhookListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Control_CreateCommand_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateCommandRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControlServer).CreateCommand(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Control_CreateCommand_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControlServer).CreateCommand(ctx, req.(*CreateCommandRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Control_CommandInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CommandInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControlServer).CommandInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Control_CommandInfo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControlServer).CommandInfo(ctx, req.(*CommandInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Control_CommandList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CommandListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControlServer).CommandList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Control_CommandList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControlServer).CommandList(ctx, req.(*CommandListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Control_CreateEnrollmentToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateEnrollmentTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControlServer).CreateEnrollmentToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Control_CreateEnrollmentToken_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControlServer).CreateEnrollmentToken(ctx, req.(*CreateEnrollmentTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Control_EnrollmentTokenList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EnrollmentTokenListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControlServer).EnrollmentTokenList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Control_EnrollmentTokenList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControlServer).EnrollmentTokenList(ctx, req.(*EnrollmentTokenListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedControlServer) DeviceStatusList(context.Context, *DeviceStatusListRequest) (*DeviceStatusListResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeviceStatusList not implemented")
+
+func _Control_RevokeEnrollmentToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeEnrollmentTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControlServer).RevokeEnrollmentToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Control_RevokeEnrollmentToken_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControlServer).RevokeEnrollmentToken(ctx, req.(*RevokeEnrollmentTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedControlServer) DeviceFeaturesList(context.Context, *DeviceFeaturesListRequest) (*DeviceFeaturesListResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeviceFeaturesList not implemented")
+
+func _Control_CertificateList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CertificateListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControlServer).CertificateList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Control_CertificateList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControlServer).CertificateList(ctx, req.(*CertificateListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedControlServer) SetDeviceFeatureState(context.Context, *SetDeviceFeatureStateRequest) (*SetDeviceFeatureStateResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SetDeviceFeatureState not implemented")
+
+func _Control_RevokeCertificate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeCertificateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControlServer).RevokeCertificate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Control_RevokeCertificate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControlServer).RevokeCertificate(ctx, req.(*RevokeCertificateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedControlServer) mustEmbedUnimplementedControlServer() {}
-func (UnimplementedControlServer) testEmbeddedByValue()                 {}
 
-// UnsafeControlServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to ControlServer will
-// result in compilation errors.
-type UnsafeControlServer interface {
-	mustEmbedUnimplementedControlServer()
+func _Control_CreateOperator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateOperatorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControlServer).CreateOperator(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Control_CreateOperator_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControlServer).CreateOperator(ctx, req.(*CreateOperatorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func RegisterControlServer(s grpc.ServiceRegistrar, srv ControlServer) {
-	// If the following call pancis, it indicates UnimplementedControlServer was
-	// embedded by pointer and is nil.  This will cause panics if an
-	// unimplemented method is ever invoked, so we test this at initialization
-	// time to prevent it from happening at runtime later due to I/O.
-	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
-		t.testEmbeddedByValue()
+func _Control_DeleteOperator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteOperatorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	s.RegisterService(&Control_ServiceDesc, srv)
+	if interceptor == nil {
+		return srv.(ControlServer).DeleteOperator(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Control_DeleteOperator_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControlServer).DeleteOperator(ctx, req.(*DeleteOperatorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _Control_DeviceList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeviceListRequest)
+func _Control_OperatorList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OperatorListRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ControlServer).DeviceList(ctx, in)
+		return srv.(ControlServer).OperatorList(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Control_DeviceList_FullMethodName,
+		FullMethod: Control_OperatorList_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ControlServer).DeviceList(ctx, req.(*DeviceListRequest))
+		return srv.(ControlServer).OperatorList(ctx, req.(*OperatorListRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Control_DeviceInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeviceInfoRequest)
+func _Control_SetOperatorRoles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetOperatorRolesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ControlServer).DeviceInfo(ctx, in)
+		return srv.(ControlServer).SetOperatorRoles(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Control_DeviceInfo_FullMethodName,
+		FullMethod: Control_SetOperatorRoles_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ControlServer).DeviceInfo(ctx, req.(*DeviceInfoRequest))
+		return srv.(ControlServer).SetOperatorRoles(ctx, req.(*SetOperatorRolesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Control_DeviceStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeviceStatusRequest)
+func _Control_ResetOperatorToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResetOperatorTokenRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ControlServer).DeviceStatus(ctx, in)
+		return srv.(ControlServer).ResetOperatorToken(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Control_DeviceStatus_FullMethodName,
+		FullMethod: Control_ResetOperatorToken_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ControlServer).DeviceStatus(ctx, req.(*DeviceStatusRequest))
+		return srv.(ControlServer).ResetOperatorToken(ctx, req.(*ResetOperatorTokenRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Control_DeviceFeatures_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeviceFeaturesRequest)
+func _Control_CreateRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateRoleRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ControlServer).DeviceFeatures(ctx, in)
+		return srv.(ControlServer).CreateRole(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Control_DeviceFeatures_FullMethodName,
+		FullMethod: Control_CreateRole_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ControlServer).DeviceFeatures(ctx, req.(*DeviceFeaturesRequest))
+		return srv.(ControlServer).CreateRole(ctx, req.(*CreateRoleRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Control_DeviceInfoList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeviceInfoListRequest)
+func _Control_DeleteRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteRoleRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ControlServer).DeviceInfoList(ctx, in)
+		return srv.(ControlServer).DeleteRole(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Control_DeviceInfoList_FullMethodName,
+		FullMethod: Control_DeleteRole_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ControlServer).DeviceInfoList(ctx, req.(*DeviceInfoListRequest))
+		return srv.(ControlServer).DeleteRole(ctx, req.(*DeleteRoleRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Control_DeviceStatusList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeviceStatusListRequest)
+func _Control_RoleList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RoleListRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ControlServer).DeviceStatusList(ctx, in)
+		return srv.(ControlServer).RoleList(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Control_DeviceStatusList_FullMethodName,
+		FullMethod: Control_RoleList_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ControlServer).DeviceStatusList(ctx, req.(*DeviceStatusListRequest))
+		return srv.(ControlServer).RoleList(ctx, req.(*RoleListRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Control_DeviceFeaturesList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeviceFeaturesListRequest)
+func _Control_AuditLog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AuditLogRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ControlServer).DeviceFeaturesList(ctx, in)
+		return srv.(ControlServer).AuditLog(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Control_DeviceFeaturesList_FullMethodName,
+		FullMethod: Control_AuditLog_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ControlServer).DeviceFeaturesList(ctx, req.(*DeviceFeaturesListRequest))
+		return srv.(ControlServer).AuditLog(ctx, req.(*AuditLogRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Control_SetDeviceFeatureState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SetDeviceFeatureStateRequest)
+func _Control_VerifyAuditLog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifyAuditLogRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ControlServer).SetDeviceFeatureState(ctx, in)
+		return srv.(ControlServer).VerifyAuditLog(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Control_SetDeviceFeatureState_FullMethodName,
+		FullMethod: Control_VerifyAuditLog_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ControlServer).SetDeviceFeatureState(ctx, req.(*SetDeviceFeatureStateRequest))
+		return srv.(ControlServer).VerifyAuditLog(ctx, req.(*VerifyAuditLogRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Control_WatchEvents_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchEventsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ControlServer).WatchEvents(m, &grpc.GenericServerStream[WatchEventsRequest, Event]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Control_WatchEventsServer = grpc.ServerStreamingServer[Event]
+
 // Control_ServiceDesc is the grpc.ServiceDesc for Control service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -361,10 +2613,18 @@ var Control_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeviceStatus",
 			Handler:    _Control_DeviceStatus_Handler,
 		},
+		{
+			MethodName: "DeviceStatusHistory",
+			Handler:    _Control_DeviceStatusHistory_Handler,
+		},
 		{
 			MethodName: "DeviceFeatures",
 			Handler:    _Control_DeviceFeatures_Handler,
 		},
+		{
+			MethodName: "DeviceInventory",
+			Handler:    _Control_DeviceInventory_Handler,
+		},
 		{
 			MethodName: "DeviceInfoList",
 			Handler:    _Control_DeviceInfoList_Handler,
@@ -381,7 +2641,265 @@ var Control_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SetDeviceFeatureState",
 			Handler:    _Control_SetDeviceFeatureState_Handler,
 		},
+		{
+			MethodName: "BulkSetFeatureState",
+			Handler:    _Control_BulkSetFeatureState_Handler,
+		},
+		{
+			MethodName: "UpdateDeviceLabels",
+			Handler:    _Control_UpdateDeviceLabels_Handler,
+		},
+		{
+			MethodName: "SetDeviceLifecycle",
+			Handler:    _Control_SetDeviceLifecycle_Handler,
+		},
+		{
+			MethodName: "DeviceDriftList",
+			Handler:    _Control_DeviceDriftList_Handler,
+		},
+		{
+			MethodName: "CreateFeature",
+			Handler:    _Control_CreateFeature_Handler,
+		},
+		{
+			MethodName: "FeatureInfo",
+			Handler:    _Control_FeatureInfo_Handler,
+		},
+		{
+			MethodName: "FeatureList",
+			Handler:    _Control_FeatureList_Handler,
+		},
+		{
+			MethodName: "DeprecateFeature",
+			Handler:    _Control_DeprecateFeature_Handler,
+		},
+		{
+			MethodName: "DeleteFeature",
+			Handler:    _Control_DeleteFeature_Handler,
+		},
+		{
+			MethodName: "CreateGroup",
+			Handler:    _Control_CreateGroup_Handler,
+		},
+		{
+			MethodName: "DeleteGroup",
+			Handler:    _Control_DeleteGroup_Handler,
+		},
+		{
+			MethodName: "GroupInfo",
+			Handler:    _Control_GroupInfo_Handler,
+		},
+		{
+			MethodName: "GroupList",
+			Handler:    _Control_GroupList_Handler,
+		},
+		{
+			MethodName: "AddGroupDevice",
+			Handler:    _Control_AddGroupDevice_Handler,
+		},
+		{
+			MethodName: "RemoveGroupDevice",
+			Handler:    _Control_RemoveGroupDevice_Handler,
+		},
+		{
+			MethodName: "SetGroupFeatureState",
+			Handler:    _Control_SetGroupFeatureState_Handler,
+		},
+		{
+			MethodName: "ClearGroupFeatureState",
+			Handler:    _Control_ClearGroupFeatureState_Handler,
+		},
+		{
+			MethodName: "ResetDeviceFeatureState",
+			Handler:    _Control_ResetDeviceFeatureState_Handler,
+		},
+		{
+			MethodName: "CreateProfile",
+			Handler:    _Control_CreateProfile_Handler,
+		},
+		{
+			MethodName: "UpdateProfile",
+			Handler:    _Control_UpdateProfile_Handler,
+		},
+		{
+			MethodName: "DeleteProfile",
+			Handler:    _Control_DeleteProfile_Handler,
+		},
+		{
+			MethodName: "ProfileInfo",
+			Handler:    _Control_ProfileInfo_Handler,
+		},
+		{
+			MethodName: "ProfileList",
+			Handler:    _Control_ProfileList_Handler,
+		},
+		{
+			MethodName: "AssignProfile",
+			Handler:    _Control_AssignProfile_Handler,
+		},
+		{
+			MethodName: "UnassignProfile",
+			Handler:    _Control_UnassignProfile_Handler,
+		},
+		{
+			MethodName: "CreateApp",
+			Handler:    _Control_CreateApp_Handler,
+		},
+		{
+			MethodName: "DeleteApp",
+			Handler:    _Control_DeleteApp_Handler,
+		},
+		{
+			MethodName: "AppList",
+			Handler:    _Control_AppList_Handler,
+		},
+		{
+			MethodName: "SetAppRule",
+			Handler:    _Control_SetAppRule_Handler,
+		},
+		{
+			MethodName: "ClearAppRule",
+			Handler:    _Control_ClearAppRule_Handler,
+		},
+		{
+			MethodName: "DeviceAppCompliance",
+			Handler:    _Control_DeviceAppCompliance_Handler,
+		},
+		{
+			MethodName: "AppViolationList",
+			Handler:    _Control_AppViolationList_Handler,
+		},
+		{
+			MethodName: "CreateAlertRule",
+			Handler:    _Control_CreateAlertRule_Handler,
+		},
+		{
+			MethodName: "DeleteAlertRule",
+			Handler:    _Control_DeleteAlertRule_Handler,
+		},
+		{
+			MethodName: "AlertRuleList",
+			Handler:    _Control_AlertRuleList_Handler,
+		},
+		{
+			MethodName: "AlertList",
+			Handler:    _Control_AlertList_Handler,
+		},
+		{
+			MethodName: "AcknowledgeAlert",
+			Handler:    _Control_AcknowledgeAlert_Handler,
+		},
+		{
+			MethodName: "CreateComplianceRule",
+			Handler:    _Control_CreateComplianceRule_Handler,
+		},
+		{
+			MethodName: "DeleteComplianceRule",
+			Handler:    _Control_DeleteComplianceRule_Handler,
+		},
+		{
+			MethodName: "ComplianceRuleList",
+			Handler:    _Control_ComplianceRuleList_Handler,
+		},
+		{
+			MethodName: "DeviceCompliance",
+			Handler:    _Control_DeviceCompliance_Handler,
+		},
+		{
+			MethodName: "ComplianceSummary",
+			Handler:    _Control_ComplianceSummary_Handler,
+		},
+		{
+			MethodName: "CreateWebhook",
+			Handler:    _Control_CreateWebhook_Handler,
+		},
+		{
+			MethodName: "DeleteWebhook",
+			Handler:    _Control_DeleteWebhook_Handler,
+		},
+		{
+			MethodName: "WebhookList",
+			Handler:    _Control_WebhookList_Handler,
+		},
+		{
+			MethodName: "CreateCommand",
+			Handler:    _Control_CreateCommand_Handler,
+		},
+		{
+			MethodName: "CommandInfo",
+			Handler:    _Control_CommandInfo_Handler,
+		},
+		{
+			MethodName: "CommandList",
+			Handler:    _Control_CommandList_Handler,
+		},
+		{
+			MethodName: "CreateEnrollmentToken",
+			Handler:    _Control_CreateEnrollmentToken_Handler,
+		},
+		{
+			MethodName: "EnrollmentTokenList",
+			Handler:    _Control_EnrollmentTokenList_Handler,
+		},
+		{
+			MethodName: "RevokeEnrollmentToken",
+			Handler:    _Control_RevokeEnrollmentToken_Handler,
+		},
+		{
+			MethodName: "CertificateList",
+			Handler:    _Control_CertificateList_Handler,
+		},
+		{
+			MethodName: "RevokeCertificate",
+			Handler:    _Control_RevokeCertificate_Handler,
+		},
+		{
+			MethodName: "CreateOperator",
+			Handler:    _Control_CreateOperator_Handler,
+		},
+		{
+			MethodName: "DeleteOperator",
+			Handler:    _Control_DeleteOperator_Handler,
+		},
+		{
+			MethodName: "OperatorList",
+			Handler:    _Control_OperatorList_Handler,
+		},
+		{
+			MethodName: "SetOperatorRoles",
+			Handler:    _Control_SetOperatorRoles_Handler,
+		},
+		{
+			MethodName: "ResetOperatorToken",
+			Handler:    _Control_ResetOperatorToken_Handler,
+		},
+		{
+			MethodName: "CreateRole",
+			Handler:    _Control_CreateRole_Handler,
+		},
+		{
+			MethodName: "DeleteRole",
+			Handler:    _Control_DeleteRole_Handler,
+		},
+		{
+			MethodName: "RoleList",
+			Handler:    _Control_RoleList_Handler,
+		},
+		{
+			MethodName: "AuditLog",
+			Handler:    _Control_AuditLog_Handler,
+		},
+		{
+			MethodName: "VerifyAuditLog",
+			Handler:    _Control_VerifyAuditLog_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchEvents",
+			Handler:       _Control_WatchEvents_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "control.proto",
 }
@@ -19,9 +19,15 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	DeviceManagement_DeviceRegister_FullMethodName = "/management.DeviceManagement/DeviceRegister"
-	DeviceManagement_DevicePing_FullMethodName     = "/management.DeviceManagement/DevicePing"
-	DeviceManagement_DeviceState_FullMethodName    = "/management.DeviceManagement/DeviceState"
+	DeviceManagement_DeviceRegister_FullMethodName        = "/management.DeviceManagement/DeviceRegister"
+	DeviceManagement_DevicePing_FullMethodName            = "/management.DeviceManagement/DevicePing"
+	DeviceManagement_DeviceState_FullMethodName           = "/management.DeviceManagement/DeviceState"
+	DeviceManagement_DeviceReportState_FullMethodName     = "/management.DeviceManagement/DeviceReportState"
+	DeviceManagement_Connect_FullMethodName               = "/management.DeviceManagement/Connect"
+	DeviceManagement_DeviceCommands_FullMethodName        = "/management.DeviceManagement/DeviceCommands"
+	DeviceManagement_DeviceReportCommand_FullMethodName   = "/management.DeviceManagement/DeviceReportCommand"
+	DeviceManagement_RenewCertificate_FullMethodName      = "/management.DeviceManagement/RenewCertificate"
+	DeviceManagement_DeviceReportInventory_FullMethodName = "/management.DeviceManagement/DeviceReportInventory"
 )
 
 // DeviceManagementClient is the client API for DeviceManagement service.
@@ -31,6 +37,22 @@ type DeviceManagementClient interface {
 	DeviceRegister(ctx context.Context, in *DeviceRegisterRequest, opts ...grpc.CallOption) (*DeviceRegisterResponse, error)
 	DevicePing(ctx context.Context, in *DevicePingRequest, opts ...grpc.CallOption) (*DevicePingResponse, error)
 	DeviceState(ctx context.Context, in *DeviceStateRequest, opts ...grpc.CallOption) (*DeviceStateResponse, error)
+	DeviceReportState(ctx context.Context, in *DeviceReportStateRequest, opts ...grpc.CallOption) (*DeviceReportStateResponse, error)
+	// Connect keeps a session open per device: the device streams its status,
+	// the server pushes feature changes as soon as they are committed.
+	Connect(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[ConnectRequest, ConnectResponse], error)
+	// DeviceCommands returns the commands queued for the device that it has
+	// not acknowledged yet, the device reports their progress and results
+	// with DeviceReportCommand.
+	DeviceCommands(ctx context.Context, in *DeviceCommandsRequest, opts ...grpc.CallOption) (*DeviceCommandsResponse, error)
+	DeviceReportCommand(ctx context.Context, in *DeviceReportCommandRequest, opts ...grpc.CallOption) (*DeviceReportCommandResponse, error)
+	// RenewCertificate issues a new certificate to a device that
+	// authenticates with its current one, so that it is rotated before it
+	// expires.
+	RenewCertificate(ctx context.Context, in *RenewCertificateRequest, opts ...grpc.CallOption) (*RenewCertificateResponse, error)
+	// DeviceReportInventory stores the hardware and software inventory of the
+	// device, a new version is kept only when it differs from the previous one.
+	DeviceReportInventory(ctx context.Context, in *DeviceReportInventoryRequest, opts ...grpc.CallOption) (*DeviceReportInventoryResponse, error)
 }
 
 type deviceManagementClient struct {
@@ -71,6 +93,69 @@ func (c *deviceManagementClient) DeviceState(ctx context.Context, in *DeviceStat
 	return out, nil
 }
 
+func (c *deviceManagementClient) DeviceReportState(ctx context.Context, in *DeviceReportStateRequest, opts ...grpc.CallOption) (*DeviceReportStateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeviceReportStateResponse)
+	err := c.cc.Invoke(ctx, DeviceManagement_DeviceReportState_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *deviceManagementClient) Connect(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[ConnectRequest, ConnectResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &DeviceManagement_ServiceDesc.Streams[0], DeviceManagement_Connect_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ConnectRequest, ConnectResponse]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type DeviceManagement_ConnectClient = grpc.BidiStreamingClient[ConnectRequest, ConnectResponse]
+
+func (c *deviceManagementClient) DeviceCommands(ctx context.Context, in *DeviceCommandsRequest, opts ...grpc.CallOption) (*DeviceCommandsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeviceCommandsResponse)
+	err := c.cc.Invoke(ctx, DeviceManagement_DeviceCommands_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *deviceManagementClient) DeviceReportCommand(ctx context.Context, in *DeviceReportCommandRequest, opts ...grpc.CallOption) (*DeviceReportCommandResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeviceReportCommandResponse)
+	err := c.cc.Invoke(ctx, DeviceManagement_DeviceReportCommand_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *deviceManagementClient) RenewCertificate(ctx context.Context, in *RenewCertificateRequest, opts ...grpc.CallOption) (*RenewCertificateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RenewCertificateResponse)
+	err := c.cc.Invoke(ctx, DeviceManagement_RenewCertificate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *deviceManagementClient) DeviceReportInventory(ctx context.Context, in *DeviceReportInventoryRequest, opts ...grpc.CallOption) (*DeviceReportInventoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeviceReportInventoryResponse)
+	err := c.cc.Invoke(ctx, DeviceManagement_DeviceReportInventory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // DeviceManagementServer is the server API for DeviceManagement service.
 // All implementations must embed UnimplementedDeviceManagementServer
 // for forward compatibility.
@@ -78,6 +163,22 @@ type DeviceManagementServer interface {
 	DeviceRegister(context.Context, *DeviceRegisterRequest) (*DeviceRegisterResponse, error)
 	DevicePing(context.Context, *DevicePingRequest) (*DevicePingResponse, error)
 	DeviceState(context.Context, *DeviceStateRequest) (*DeviceStateResponse, error)
+	DeviceReportState(context.Context, *DeviceReportStateRequest) (*DeviceReportStateResponse, error)
+	// Connect keeps a session open per device: the device streams its status,
+	// the server pushes feature changes as soon as they are committed.
+	Connect(grpc.BidiStreamingServer[ConnectRequest, ConnectResponse]) error
+	// DeviceCommands returns the commands queued for the device that it has
+	// not acknowledged yet, the device reports their progress and results
+	// with DeviceReportCommand.
+	DeviceCommands(context.Context, *DeviceCommandsRequest) (*DeviceCommandsResponse, error)
+	DeviceReportCommand(context.Context, *DeviceReportCommandRequest) (*DeviceReportCommandResponse, error)
+	// RenewCertificate issues a new certificate to a device that
+	// authenticates with its current one, so that it is rotated before it
+	// expires.
+	RenewCertificate(context.Context, *RenewCertificateRequest) (*RenewCertificateResponse, error)
+	// DeviceReportInventory stores the hardware and software inventory of the
+	// device, a new version is kept only when it differs from the previous one.
+	DeviceReportInventory(context.Context, *DeviceReportInventoryRequest) (*DeviceReportInventoryResponse, error)
 	mustEmbedUnimplementedDeviceManagementServer()
 }
 
@@ -97,6 +198,24 @@ func (UnimplementedDeviceManagementServer) DevicePing(context.Context, *DevicePi
 func (UnimplementedDeviceManagementServer) DeviceState(context.Context, *DeviceStateRequest) (*DeviceStateResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeviceState not implemented")
 }
+func (UnimplementedDeviceManagementServer) DeviceReportState(context.Context, *DeviceReportStateRequest) (*DeviceReportStateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeviceReportState not implemented")
+}
+func (UnimplementedDeviceManagementServer) Connect(grpc.BidiStreamingServer[ConnectRequest, ConnectResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method Connect not implemented")
+}
+func (UnimplementedDeviceManagementServer) DeviceCommands(context.Context, *DeviceCommandsRequest) (*DeviceCommandsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeviceCommands not implemented")
+}
+func (UnimplementedDeviceManagementServer) DeviceReportCommand(context.Context, *DeviceReportCommandRequest) (*DeviceReportCommandResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeviceReportCommand not implemented")
+}
+func (UnimplementedDeviceManagementServer) RenewCertificate(context.Context, *RenewCertificateRequest) (*RenewCertificateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RenewCertificate not implemented")
+}
+func (UnimplementedDeviceManagementServer) DeviceReportInventory(context.Context, *DeviceReportInventoryRequest) (*DeviceReportInventoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeviceReportInventory not implemented")
+}
 func (UnimplementedDeviceManagementServer) mustEmbedUnimplementedDeviceManagementServer() {}
 func (UnimplementedDeviceManagementServer) testEmbeddedByValue()                          {}
 
@@ -172,6 +291,103 @@ func _DeviceManagement_DeviceState_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _DeviceManagement_DeviceReportState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeviceReportStateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeviceManagementServer).DeviceReportState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DeviceManagement_DeviceReportState_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeviceManagementServer).DeviceReportState(ctx, req.(*DeviceReportStateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DeviceManagement_Connect_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(DeviceManagementServer).Connect(&grpc.GenericServerStream[ConnectRequest, ConnectResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type DeviceManagement_ConnectServer = grpc.BidiStreamingServer[ConnectRequest, ConnectResponse]
+
+func _DeviceManagement_DeviceCommands_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeviceCommandsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeviceManagementServer).DeviceCommands(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DeviceManagement_DeviceCommands_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeviceManagementServer).DeviceCommands(ctx, req.(*DeviceCommandsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DeviceManagement_DeviceReportCommand_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeviceReportCommandRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeviceManagementServer).DeviceReportCommand(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DeviceManagement_DeviceReportCommand_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeviceManagementServer).DeviceReportCommand(ctx, req.(*DeviceReportCommandRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DeviceManagement_RenewCertificate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RenewCertificateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeviceManagementServer).RenewCertificate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DeviceManagement_RenewCertificate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeviceManagementServer).RenewCertificate(ctx, req.(*RenewCertificateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DeviceManagement_DeviceReportInventory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeviceReportInventoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeviceManagementServer).DeviceReportInventory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DeviceManagement_DeviceReportInventory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeviceManagementServer).DeviceReportInventory(ctx, req.(*DeviceReportInventoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // DeviceManagement_ServiceDesc is the grpc.ServiceDesc for DeviceManagement service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -191,7 +407,34 @@ var DeviceManagement_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeviceState",
 			Handler:    _DeviceManagement_DeviceState_Handler,
 		},
+		{
+			MethodName: "DeviceReportState",
+			Handler:    _DeviceManagement_DeviceReportState_Handler,
+		},
+		{
+			MethodName: "DeviceCommands",
+			Handler:    _DeviceManagement_DeviceCommands_Handler,
+		},
+		{
+			MethodName: "DeviceReportCommand",
+			Handler:    _DeviceManagement_DeviceReportCommand_Handler,
+		},
+		{
+			MethodName: "RenewCertificate",
+			Handler:    _DeviceManagement_RenewCertificate_Handler,
+		},
+		{
+			MethodName: "DeviceReportInventory",
+			Handler:    _DeviceManagement_DeviceReportInventory_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Connect",
+			Handler:       _DeviceManagement_Connect_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "management.proto",
 }
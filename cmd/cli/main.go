@@ -8,26 +8,43 @@ import (
 	"net"
 	"os"
 	"os/signal"
+	"slices"
+	"sort"
 	"strconv"
 	"strings"
 	"syscall"
+	"time"
 
 	controlv1 "github.com/dvaxert/mdm/api/gen/go/control"
 	"github.com/dvaxert/mdm/internal/cli"
 	"github.com/dvaxert/mdm/internal/domain/models"
 	"google.golang.org/grpc"
-	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
+// pageSize ограничивает число устройств, получаемых одним запросом списка
+const pageSize = 50
+
 func main() {
 	conf := cli.MustLoadConfig()
 
 	fmt.Println("starting cli")
 
-	cc, err := grpc.NewClient(
-		net.JoinHostPort(conf.Grpc.Address, conf.Grpc.Port),
-		grpc.WithTransportCredentials(insecure.NewCredentials()),
-	)
+	creds, err := conf.Grpc.TLS.Credentials()
+	if err != nil {
+		panic("incorrect tls config: " + err.Error())
+	}
+
+	opts := []grpc.DialOption{grpc.WithTransportCredentials(creds)}
+	if token := conf.Grpc.Credentials(); token != nil {
+		opts = append(opts, grpc.WithPerRPCCredentials(token))
+	}
+
+	cc, err := grpc.NewClient(net.JoinHostPort(conf.Grpc.Address, conf.Grpc.Port), opts...)
 	if err != nil {
 		panic(err)
 	}
@@ -37,14 +54,101 @@ func main() {
 	showCommandsList := func() {
 		fmt.Println(
 			`Commands:
-			dlist - show list of devices
+			dlist [$filter] - show list of devices
+				filter is [order:$field[,desc]] [type:$device_types] [battery:$min..$max] [lifecycle:$states] [$selector],
+				field is registered, uuid or type, states are comma separated or 'all', retired and wiped devices are hidden by default
+				selector is comma separated key=value, key!=value, key in ($v1,$v2), key notin ($v1,$v2), key or !key
 			dinfo $device_uuid - show info about device
 			dstatus $device_uuid - show device status
 			dfeature $device_uuid - show device features status
-			ilist - show list of device info
-			slist - show list of device status
-			flist - show list of device features
-			feature $device_id $feature_name $feature_state - change device feature state
+			dhistory $device_uuid [$hours] - show battery trend and location changes, for the last 24 hours by default
+			dinventory $device_uuid [$version] - show device hardware and software inventory with the changes from the previous version,
+				the latest version by default
+			ilist [$filter] - show list of device info
+			slist [$filter] - show list of device status
+			flist [$filter] - show list of device features
+			feature $device_id $feature_name $value - change device feature value
+			fbulk $feature_name $value device:$device_ids|group:$group_name|$selector - change feature value of many devices
+				in one transaction, device ids are comma separated, the changes are shown for confirmation first
+				and only the shown devices are changed
+			labels $device_id $key=$value|-$key ... - set or remove device labels
+			dlifecycle $device_id $state - move device to pending, enrolled, suspended, retired or wiped,
+				pending, retired and wiped revoke device certificates, pending device enrolls again with enrollment token
+			drift [$filter] - show devices whose applied features differ from desired
+			features - show feature catalog
+			fdescribe $feature_name - show feature description
+			fcreate $feature_name $type $default_value $device_types $description - add feature to catalog, device types are comma separated or 'all'
+				type is bool, int, int:$min..$max, string, list or enum:$value1,$value2,...
+			fdeprecate $feature_name $deprecated - change feature deprecation
+			fdelete $feature_name - remove feature from catalog and devices
+			freset $device_id $feature_name - drop device feature value, inherit it from groups again
+			glist - show list of groups
+			ginfo $group_name - show group members and features
+			gcreate $group_name [$parent_group] - create group
+			gdelete $group_name - delete group without subgroups
+			gadd $group_name $device_id - add device to group
+			gremove $group_name $device_id - remove device from group
+			gfeature $group_name $feature_name $value - change feature value of group devices
+			gclear $group_name $feature_name - drop group feature value
+			plist - show list of profiles
+			pinfo $profile_name - show profile features and assignments
+			pcreate $profile_name $description - create empty profile
+			pdelete $profile_name - delete profile
+			pset $profile_name $feature_name $value - change feature value of profile
+			punset $profile_name $feature_name - remove feature from profile
+			passign $profile_name device|group $target - assign profile to device or group
+			punassign $profile_name device|group $target - remove profile from device or group
+			applist - show app catalog with the rules of the apps
+			appcreate $app_id $version [$name] - add app to catalog, version is installed by devices that require the app
+			appdelete $app_id - remove app and its rules from catalog
+			apprule $app_id $rule profile|group $target - set app rule of profile or group, rule is allow, block or require,
+				any allow rule makes the device policy an allowlist
+			appclear $app_id profile|group $target - drop app rule of profile or group
+			dapps $device_id - show device app policy and the installed apps that break it
+			appviolations [$filter] - show devices whose installed apps break their app policy
+			crlist - show list of compliance rules
+			crcreate $rule_name $condition [$value] - create compliance rule and evaluate all devices, condition is
+				min_os_version $version [$os_name], feature_state $duration [$feature_name], battery_health $percent,
+				check_in $duration or labels $selector
+			crdelete $rule_name - delete compliance rule and evaluate all devices
+			dcompliance $device_id [$limit] - show device compliance with the last changes, all changes by default
+			compliance [$filter] - show compliance summary with the number of devices failing every rule
+			arlist - show list of alert rules
+			arcreate $rule_name $condition [$value] [$feature_name] - create alert rule
+				condition is battery_below $percent, offline $duration, location_changed or feature_drift $duration [$feature_name]
+			ardelete $rule_name - delete alert rule and its alerts
+			alerts [all] [$device_id] - show firing alerts, all shows resolved alerts too
+			ack $alert_id - acknowledge alert
+			whlist - show list of webhooks
+			whcreate $webhook_name $url $secret [$events] - create webhook, events are comma separated, all by default
+			whdelete $webhook_name - delete webhook and its pending deliveries
+			cmdsend $device_id $type [ttl:$duration] [$payload] - queue command for device
+				type is lock, wipe, reboot, locate or custom, custom requires payload
+			cmdinfo $command_id - show command status and result
+			cmdlist [pending] [$device_id] - show commands, pending hides completed ones
+			etcreate [ttl:$duration] [uses:$n] [types:$types] [group:$group_name] [profile:$profile_name] [label:$key=$value] [desc:$text]
+				- create enrollment token, single use by default, label can be repeated,
+				types are comma separated, group, profile and labels are given to new devices
+			etlist - show enrollment tokens
+			etrevoke $token_id - revoke enrollment token
+			certlist [$device_id] - show issued device certificates
+			certrevoke serial|device $value - revoke certificate by serial or all certificates of device
+			oplist - show operators
+			opcreate $operator_name [$roles] - create operator and show its api token, roles are comma separated
+			opdelete $operator_name - delete operator
+			oproles $operator_name [$roles] - replace operator roles, roles are comma separated
+			optoken $operator_name - replace operator api token and show the new one
+			rlist - show roles
+			rcreate $role_name $permissions [group:$groups] [$selector] - create role, permissions and groups are comma separated,
+				permission is read, features, commands or admin, role without groups and selector applies to all devices
+			rdelete $role_name - delete role not granted to operators
+			audit [device:$device_id] [actor:$operator_name] [from:$time] [to:$time] [limit:$n] - show audit log, newest first,
+				time is a duration ago like 24h, a date like 2006-01-02 or a local time like 2006-01-02T15:04
+			auditverify - check the audit log hash chain
+			watch [after:$seq] [device:$device_ids] [group:$group_name] [type:$event_types] - tail device events until Enter is pressed
+				device ids and event types are comma separated, event type is registered, pinged, status_changed,
+				battery_band_changed, presence_changed, offline, feature_desired, state_applied, lifecycle_changed,
+				inventory_changed or compliance_changed
 			stop - exit program
 		`,
 		)
@@ -56,25 +160,54 @@ func main() {
 
 	go func() {
 		stopped := false
-		scanner := bufio.NewScanner(os.Stdin)
+
+		// строки читаются отдельно, чтобы watch мог одновременно ждать Enter и конца потока
+		lines := make(chan string)
+		go func() {
+			defer close(lines)
+
+			scanner := bufio.NewScanner(os.Stdin)
+			for scanner.Scan() {
+				lines <- scanner.Text()
+			}
+		}()
 
 		for !stopped {
 			fmt.Println("Enter command:")
 
-			scanner.Scan()
-			commandData := strings.Split(scanner.Text(), " ")
+			line, ok := <-lines
+			if !ok {
+				stop <- os.Interrupt
+				return
+			}
+			commandData := strings.Split(line, " ")
 
 			switch commandData[0] {
 			case "dlist":
-				res, err := client.DeviceList(context.Background(), &controlv1.DeviceListRequest{})
+				filter, order, err := parseListArgs(commandData[1:])
 				if err != nil {
-					fmt.Printf("failed to get the device list: %s\n", err)
+					fmt.Println(err)
 					continue
 				}
 
+				req := &controlv1.DeviceListRequest{Filter: filter, OrderBy: order, PageSize: pageSize}
+
 				fmt.Println("Device list:")
-				for _, device_uuid := range res.GetDeviceId() {
-					fmt.Println(device_uuid)
+				for {
+					res, err := client.DeviceList(context.Background(), req)
+					if err != nil {
+						fmt.Printf("failed to get the device list: %s\n", err)
+						break
+					}
+
+					for _, device_uuid := range res.GetDeviceId() {
+						fmt.Println(device_uuid)
+					}
+
+					if res.GetNextPageToken() == "" {
+						break
+					}
+					req.PageToken = res.GetNextPageToken()
 				}
 
 			case "dinfo":
@@ -92,7 +225,16 @@ func main() {
 					continue
 				}
 
-				fmt.Printf("Device info: %s\n", models.DeviceType(res.GetDeviceType()).String())
+				fmt.Printf(
+					"Device info: %s, %s, labels: %s\n",
+					models.DeviceType(res.GetDeviceType()).String(),
+					formatLifecycle(res.GetLifecycle()),
+					formatLabels(res.GetLabels()),
+				)
+				if res.GetLifecycleChangedAt() != nil {
+					fmt.Printf("lifecycle changed %s\n", formatTime(res.GetLifecycleChangedAt()))
+				}
+				printCompliance(res.GetCompliance())
 
 			case "dstatus":
 				if len(commandData) != 2 {
@@ -110,6 +252,63 @@ func main() {
 				}
 
 				fmt.Printf("Device status: location = '%s' battery = %d\n", res.GetLocation(), res.GetBattery())
+				fmt.Printf(
+					"presence: %s, last seen %s, registered %s, last state fetch %s\n",
+					formatPresence(res.GetPresence()),
+					formatTime(res.GetLastSeen()),
+					formatTime(res.GetRegisteredAt()),
+					formatTime(res.GetLastStateFetch()),
+				)
+
+			case "dhistory":
+				if len(commandData) != 2 && len(commandData) != 3 {
+					fmt.Println("the wrong arguments were passed to command")
+					continue
+				}
+
+				req := &controlv1.DeviceStatusHistoryRequest{DeviceId: commandData[1]}
+				if len(commandData) == 3 {
+					hours, err := strconv.Atoi(commandData[2])
+					if err != nil || hours <= 0 {
+						fmt.Println("the number of hours must be a positive integer")
+						continue
+					}
+
+					req.From = timestamppb.New(time.Now().Add(-time.Duration(hours) * time.Hour))
+				}
+
+				res, err := client.DeviceStatusHistory(context.Background(), req)
+				if err != nil {
+					fmt.Printf("failed to get the device status history: %s\n", err)
+					continue
+				}
+
+				printStatusHistory(res.GetPoints())
+
+			case "dinventory":
+				if len(commandData) != 2 && len(commandData) != 3 {
+					fmt.Println("the wrong arguments were passed to command")
+					continue
+				}
+
+				req := &controlv1.DeviceInventoryRequest{DeviceId: commandData[1]}
+				if len(commandData) == 3 {
+					version, err := strconv.ParseInt(commandData[2], 10, 64)
+					if err != nil || version <= 0 {
+						fmt.Println("the inventory version must be a positive integer")
+						continue
+					}
+
+					req.Version = version
+				}
+
+				res, err := client.DeviceInventory(context.Background(), req)
+				if err != nil {
+					fmt.Printf("failed to get the device inventory: %s\n", err)
+					continue
+				}
+
+				printInventory(res)
 
 			case "dfeature":
 				if len(commandData) != 2 {
@@ -127,70 +326,135 @@ func main() {
 				}
 
 				fmt.Println("Device features:")
-				for k, v := range res.GetFeatures() {
-					fmt.Printf("%s: %t\n", k, v)
+				for k, v := range res.GetValues() {
+					reported, ok := res.GetReportedValues()[k]
+					if !ok {
+						fmt.Printf("%s: %s (not reported)\n", k, formatValue(v))
+						continue
+					}
+
+					fmt.Printf("%s: %s (reported %s)\n", k, formatValue(v), formatValue(reported))
 				}
 
 			case "ilist":
-				res, err := client.DeviceInfoList(context.Background(), &controlv1.DeviceInfoListRequest{})
+				filter, order, err := parseListArgs(commandData[1:])
 				if err != nil {
-					fmt.Printf("failed to get the list of device info: %s\n", err)
+					fmt.Println(err)
 					continue
 				}
 
+				req := &controlv1.DeviceInfoListRequest{Filter: filter, OrderBy: order, PageSize: pageSize}
+
 				fmt.Println("Devices info:")
-				for _, item := range res.Items {
-					fmt.Printf("%s: %s\n", item.GetDeviceId(), models.DeviceType(item.GetDeviceType()).String())
+				for {
+					res, err := client.DeviceInfoList(context.Background(), req)
+					if err != nil {
+						fmt.Printf("failed to get the list of device info: %s\n", err)
+						break
+					}
+
+					for _, item := range res.Items {
+						fmt.Printf(
+							"%s: %s, %s, labels: %s\n",
+							item.GetDeviceId(),
+							models.DeviceType(item.GetDeviceType()).String(),
+							formatLifecycle(item.GetLifecycle()),
+							formatLabels(item.GetLabels()),
+						)
+					}
+
+					if res.GetNextPageToken() == "" {
+						break
+					}
+					req.PageToken = res.GetNextPageToken()
 				}
 
 			case "slist":
-				res, err := client.DeviceStatusList(context.Background(), &controlv1.DeviceStatusListRequest{})
+				filter, order, err := parseListArgs(commandData[1:])
 				if err != nil {
-					fmt.Printf("failed to get the list of device status: %s\n", err)
+					fmt.Println(err)
 					continue
 				}
 
+				req := &controlv1.DeviceStatusListRequest{Filter: filter, OrderBy: order, PageSize: pageSize}
+
 				fmt.Println("Devices status:")
-				for _, item := range res.Items {
-					fmt.Printf("%s: location='%s', battery=%d\n", item.GetDeviceId(), item.GetLocation(), item.GetBattery())
+				for {
+					res, err := client.DeviceStatusList(context.Background(), req)
+					if err != nil {
+						fmt.Printf("failed to get the list of device status: %s\n", err)
+						break
+					}
+
+					for _, item := range res.Items {
+						fmt.Printf(
+							"%s: %s, last seen %s, location='%s', battery=%d\n",
+							item.GetDeviceId(),
+							formatPresence(item.GetPresence()),
+							formatTime(item.GetLastSeen()),
+							item.GetLocation(),
+							item.GetBattery(),
+						)
+					}
+
+					if res.GetNextPageToken() == "" {
+						break
+					}
+					req.PageToken = res.GetNextPageToken()
 				}
 
 			case "flist":
-				res, err := client.DeviceFeaturesList(context.Background(), &controlv1.DeviceFeaturesListRequest{})
+				filter, order, err := parseListArgs(commandData[1:])
 				if err != nil {
-					fmt.Printf("failed to get the list of device features: %s\n", err)
+					fmt.Println(err)
 					continue
 				}
 
+				req := &controlv1.DeviceFeaturesListRequest{Filter: filter, OrderBy: order, PageSize: pageSize}
+
 				fmt.Println("Devices features:")
-				for _, item := range res.Items {
-					fmt.Printf("device: %s\n", item.GetDeviceId())
+				for {
+					res, err := client.DeviceFeaturesList(context.Background(), req)
+					if err != nil {
+						fmt.Printf("failed to get the list of device features: %s\n", err)
+						break
+					}
+
+					for _, item := range res.Items {
+						fmt.Printf("device: %s\n", item.GetDeviceId())
 
-					for k, v := range item.GetFeatures() {
-						fmt.Printf("\t%s=%t\n", k, v)
+						for k, v := range item.GetValues() {
+							fmt.Printf("\t%s=%s\n", k, formatValue(v))
+						}
 					}
+
+					if res.GetNextPageToken() == "" {
+						break
+					}
+					req.PageToken = res.GetNextPageToken()
 				}
 
 			case "feature":
-				if len(commandData) != 4 {
+				if len(commandData) < 4 {
 					fmt.Println("the wrong arguments were passed to command")
 					continue
 				}
 
-				state, err := strconv.ParseBool(commandData[3])
+				// feature $device_id $feature_name $value - change device feature value
+
+				value, err := featureValue(client, commandData[2], strings.Join(commandData[3:], " "))
 				if err != nil {
-					fmt.Println("the wrong arguments were passed to command")
+					fmt.Println(err)
 					continue
 				}
 
-				// feature $device_id $feature_name $feature_state - change device feature state
-
 				res, err := client.SetDeviceFeatureState(
 					context.Background(),
 					&controlv1.SetDeviceFeatureStateRequest{
 						DeviceId: commandData[1],
 						Feature:  commandData[2],
-						State:    state,
+						State:    value.GetBoolValue(),
+						Value:    value,
 					},
 				)
 				if err != nil {
@@ -200,19 +464,2401 @@ func main() {
 
 				fmt.Printf("result: %t\n", res.GetSuccess())
 
-			case "stop":
-				stop <- os.Interrupt
-				stopped = true
+			case "fbulk":
+				if len(commandData) < 4 {
+					fmt.Println("the wrong arguments were passed to command")
+					continue
+				}
 
-			default:
-				fmt.Println("unknown command")
-				showCommandsList()
-			}
-		}
-	}()
+				value, err := featureValue(client, commandData[1], commandData[2])
+				if err != nil {
+					fmt.Println(err)
+					continue
+				}
 
-	sig := <-stop
-	fmt.Println("stopping cli", slog.String("signal", sig.String()))
+				req := &controlv1.BulkSetFeatureStateRequest{
+					Feature: commandData[1],
+					Value:   value,
+					DryRun:  true,
+				}
+				if ids, ok := strings.CutPrefix(commandData[3], "device:"); ok && len(commandData) == 4 {
+					req.DeviceIds = strings.Split(ids, ",")
+				} else if group, ok := strings.CutPrefix(commandData[3], "group:"); ok && len(commandData) == 4 {
+					req.Group = group
+				} else {
+					req.Selector = strings.Join(commandData[3:], " ")
+				}
 
-	fmt.Println("cli stopped")
+				res, err := client.BulkSetFeatureState(context.Background(), req)
+				if err != nil {
+					fmt.Printf("failed to prepare the feature change: %s\n", err)
+					continue
+				}
+
+				printBulkFeatureChanges(res, value)
+				if len(res.GetChanges()) == 0 {
+					fmt.Println("nothing to change")
+					continue
+				}
+
+				fmt.Printf("apply the change to %d devices? [y/N]\n", len(res.GetChanges()))
+				answer, ok := <-lines
+				if !ok {
+					stop <- os.Interrupt
+					return
+				}
+
+				if answer := strings.ToLower(strings.TrimSpace(answer)); answer != "y" && answer != "yes" {
+					fmt.Println("cancelled")
+					continue
+				}
+
+				// изменяются ровно показанные устройства, группа или селектор
+				// к этому времени могут выбирать уже другие
+				confirmed := len(res.GetChanges())
+				apply := &controlv1.BulkSetFeatureStateRequest{Feature: req.GetFeature(), Value: value}
+				for _, c := range res.GetChanges() {
+					apply.DeviceIds = append(apply.DeviceIds, c.GetDeviceId())
+				}
+
+				res, err = client.BulkSetFeatureState(context.Background(), apply)
+				if err != nil {
+					fmt.Printf("failed to change the feature of devices: %s\n", err)
+					continue
+				}
+
+				fmt.Printf("result: %t, changed %d devices\n", res.GetApplied(), len(res.GetChanges()))
+				if len(res.GetChanges()) != confirmed {
+					printBulkFeatureChanges(res, value)
+				}
+
+			case "labels":
+				if len(commandData) < 3 {
+					fmt.Println("the wrong arguments were passed to command")
+					continue
+				}
+
+				req := &controlv1.UpdateDeviceLabelsRequest{
+					DeviceId: commandData[1],
+					Set:      make(map[string]string),
+				}
+				for _, arg := range commandData[2:] {
+					if key, ok := strings.CutPrefix(arg, "-"); ok {
+						req.Remove = append(req.Remove, key)
+						continue
+					}
+
+					key, value, _ := strings.Cut(arg, "=")
+					req.Set[key] = value
+				}
+
+				res, err := client.UpdateDeviceLabels(context.Background(), req)
+				if err != nil {
+					fmt.Printf("failed to update the device labels: %s\n", err)
+					continue
+				}
+
+				fmt.Printf("result: %t\n", res.GetSuccess())
+
+			case "dlifecycle":
+				if len(commandData) != 3 {
+					fmt.Println("the wrong arguments were passed to command")
+					continue
+				}
+
+				lifecycle, err := models.ParseLifecycle(commandData[2])
+				if err != nil {
+					fmt.Println(err)
+					continue
+				}
+
+				res, err := client.SetDeviceLifecycle(
+					context.Background(),
+					&controlv1.SetDeviceLifecycleRequest{
+						DeviceId:  commandData[1],
+						Lifecycle: controlv1.Lifecycle(lifecycle),
+					},
+				)
+				if err != nil {
+					fmt.Printf("failed to change the device lifecycle: %s\n", err)
+					continue
+				}
+
+				fmt.Printf(
+					"%s -> %s, revoked certificates: %d\n",
+					formatLifecycle(res.GetPreviousLifecycle()), lifecycle, res.GetRevokedCertificates(),
+				)
+
+			case "drift":
+				filter, order, err := parseListArgs(commandData[1:])
+				if err != nil {
+					fmt.Println(err)
+					continue
+				}
+
+				req := &controlv1.DeviceDriftListRequest{Filter: filter, OrderBy: order, PageSize: pageSize}
+
+				fmt.Println("Drifted devices:")
+				for {
+					res, err := client.DeviceDriftList(context.Background(), req)
+					if err != nil {
+						fmt.Printf("failed to get the list of drifted devices: %s\n", err)
+						break
+					}
+
+					for _, item := range res.Items {
+						fmt.Printf("device: %s, out of sync for %s\n", item.GetDeviceId(), item.GetOutOfSync().AsDuration())
+
+						for _, f := range item.GetFeatures() {
+							reported := "not reported"
+							if f.ReportedValue != nil {
+								reported = formatValue(f.GetReportedValue())
+							}
+
+							fmt.Printf("\t%s: desired=%s, reported=%s\n", f.GetFeature(), formatValue(f.GetDesiredValue()), reported)
+						}
+					}
+
+					if res.GetNextPageToken() == "" {
+						break
+					}
+					req.PageToken = res.GetNextPageToken()
+				}
+
+			case "features":
+				res, err := client.FeatureList(context.Background(), &controlv1.FeatureListRequest{})
+				if err != nil {
+					fmt.Printf("failed to get the feature catalog: %s\n", err)
+					continue
+				}
+
+				fmt.Println("Feature catalog:")
+				for _, f := range res.GetItems() {
+					printFeature(f)
+				}
+
+			case "fdescribe":
+				if len(commandData) != 2 {
+					fmt.Println("the wrong arguments were passed to command")
+					continue
+				}
+
+				res, err := client.FeatureInfo(
+					context.Background(),
+					&controlv1.FeatureInfoRequest{Name: commandData[1]},
+				)
+				if err != nil {
+					fmt.Printf("failed to get the feature: %s\n", err)
+					continue
+				}
+
+				printFeature(res.GetFeature())
+
+			case "fcreate":
+				if len(commandData) < 5 {
+					fmt.Println("the wrong arguments were passed to command")
+					continue
+				}
+
+				req, err := parseFeatureType(commandData[2])
+				if err != nil {
+					fmt.Println(err)
+					continue
+				}
+
+				if req.DefaultValue, err = parseValue(req.GetType(), commandData[3]); err != nil {
+					fmt.Println(err)
+					continue
+				}
+
+				if req.DeviceTypes, err = parseDeviceTypes(commandData[4]); err != nil {
+					fmt.Println(err)
+					continue
+				}
+
+				req.Name = commandData[1]
+				req.Description = strings.Join(commandData[5:], " ")
+				req.DefaultState = req.GetDefaultValue().GetBoolValue()
+
+				res, err := client.CreateFeature(context.Background(), req)
+				if err != nil {
+					fmt.Printf("failed to create the feature: %s\n", err)
+					continue
+				}
+
+				fmt.Printf("result: %t\n", res.GetSuccess())
+
+			case "fdeprecate":
+				if len(commandData) != 3 {
+					fmt.Println("the wrong arguments were passed to command")
+					continue
+				}
+
+				deprecated, err := strconv.ParseBool(commandData[2])
+				if err != nil {
+					fmt.Println("the wrong arguments were passed to command")
+					continue
+				}
+
+				res, err := client.DeprecateFeature(
+					context.Background(),
+					&controlv1.DeprecateFeatureRequest{Name: commandData[1], Deprecated: deprecated},
+				)
+				if err != nil {
+					fmt.Printf("failed to change the feature deprecation: %s\n", err)
+					continue
+				}
+
+				fmt.Printf("result: %t\n", res.GetSuccess())
+
+			case "fdelete":
+				if len(commandData) != 2 {
+					fmt.Println("the wrong arguments were passed to command")
+					continue
+				}
+
+				res, err := client.DeleteFeature(
+					context.Background(),
+					&controlv1.DeleteFeatureRequest{Name: commandData[1]},
+				)
+				if err != nil {
+					fmt.Printf("failed to delete the feature: %s\n", err)
+					continue
+				}
+
+				fmt.Printf("result: %t\n", res.GetSuccess())
+
+			case "freset":
+				if len(commandData) != 3 {
+					fmt.Println("the wrong arguments were passed to command")
+					continue
+				}
+
+				res, err := client.ResetDeviceFeatureState(
+					context.Background(),
+					&controlv1.ResetDeviceFeatureStateRequest{DeviceId: commandData[1], Feature: commandData[2]},
+				)
+				if err != nil {
+					fmt.Printf("failed to reset the device feature: %s\n", err)
+					continue
+				}
+
+				fmt.Printf("result: %t\n", res.GetSuccess())
+
+			case "glist":
+				res, err := client.GroupList(context.Background(), &controlv1.GroupListRequest{})
+				if err != nil {
+					fmt.Printf("failed to get the group list: %s\n", err)
+					continue
+				}
+
+				fmt.Println("Groups:")
+				for _, g := range res.GetItems() {
+					parent := "-"
+					if g.GetParent() != "" {
+						parent = g.GetParent()
+					}
+
+					fmt.Printf("%s: parent=%s, devices=%d, features=%d\n", g.GetName(), parent, len(g.GetDeviceIds()), len(g.GetFeatures()))
+				}
+
+			case "ginfo":
+				if len(commandData) != 2 {
+					fmt.Println("the wrong arguments were passed to command")
+					continue
+				}
+
+				res, err := client.GroupInfo(
+					context.Background(),
+					&controlv1.GroupInfoRequest{Name: commandData[1]},
+				)
+				if err != nil {
+					fmt.Printf("failed to get the group: %s\n", err)
+					continue
+				}
+
+				g := res.GetGroup()
+				fmt.Printf("Group %s, parent: '%s'\n", g.GetName(), g.GetParent())
+				fmt.Println("Devices:")
+				for _, device_uuid := range g.GetDeviceIds() {
+					fmt.Printf("\t%s\n", device_uuid)
+				}
+
+				fmt.Println("Features:")
+				for k, v := range g.GetFeatures() {
+					fmt.Printf("\t%s=%s\n", k, formatValue(v))
+				}
+
+				fmt.Printf("Profiles: %s\n", strings.Join(g.GetProfiles(), ", "))
+
+			case "gcreate":
+				if len(commandData) != 2 && len(commandData) != 3 {
+					fmt.Println("the wrong arguments were passed to command")
+					continue
+				}
+
+				req := &controlv1.CreateGroupRequest{Name: commandData[1]}
+				if len(commandData) == 3 {
+					req.Parent = commandData[2]
+				}
+
+				res, err := client.CreateGroup(context.Background(), req)
+				if err != nil {
+					fmt.Printf("failed to create the group: %s\n", err)
+					continue
+				}
+
+				fmt.Printf("result: %t\n", res.GetSuccess())
+
+			case "gdelete":
+				if len(commandData) != 2 {
+					fmt.Println("the wrong arguments were passed to command")
+					continue
+				}
+
+				res, err := client.DeleteGroup(
+					context.Background(),
+					&controlv1.DeleteGroupRequest{Name: commandData[1]},
+				)
+				if err != nil {
+					fmt.Printf("failed to delete the group: %s\n", err)
+					continue
+				}
+
+				fmt.Printf("result: %t\n", res.GetSuccess())
+
+			case "gadd":
+				if len(commandData) != 3 {
+					fmt.Println("the wrong arguments were passed to command")
+					continue
+				}
+
+				res, err := client.AddGroupDevice(
+					context.Background(),
+					&controlv1.AddGroupDeviceRequest{Group: commandData[1], DeviceId: commandData[2]},
+				)
+				if err != nil {
+					fmt.Printf("failed to add the device to the group: %s\n", err)
+					continue
+				}
+
+				fmt.Printf("result: %t\n", res.GetSuccess())
+
+			case "gremove":
+				if len(commandData) != 3 {
+					fmt.Println("the wrong arguments were passed to command")
+					continue
+				}
+
+				res, err := client.RemoveGroupDevice(
+					context.Background(),
+					&controlv1.RemoveGroupDeviceRequest{Group: commandData[1], DeviceId: commandData[2]},
+				)
+				if err != nil {
+					fmt.Printf("failed to remove the device from the group: %s\n", err)
+					continue
+				}
+
+				fmt.Printf("result: %t\n", res.GetSuccess())
+
+			case "gfeature":
+				if len(commandData) < 4 {
+					fmt.Println("the wrong arguments were passed to command")
+					continue
+				}
+
+				value, err := featureValue(client, commandData[2], strings.Join(commandData[3:], " "))
+				if err != nil {
+					fmt.Println(err)
+					continue
+				}
+
+				res, err := client.SetGroupFeatureState(
+					context.Background(),
+					&controlv1.SetGroupFeatureStateRequest{
+						Group:   commandData[1],
+						Feature: commandData[2],
+						Value:   value,
+					},
+				)
+				if err != nil {
+					fmt.Printf("failed to change the group feature: %s\n", err)
+					continue
+				}
+
+				fmt.Printf("result: %t\n", res.GetSuccess())
+
+			case "gclear":
+				if len(commandData) != 3 {
+					fmt.Println("the wrong arguments were passed to command")
+					continue
+				}
+
+				res, err := client.ClearGroupFeatureState(
+					context.Background(),
+					&controlv1.ClearGroupFeatureStateRequest{Group: commandData[1], Feature: commandData[2]},
+				)
+				if err != nil {
+					fmt.Printf("failed to clear the group feature: %s\n", err)
+					continue
+				}
+
+				fmt.Printf("result: %t\n", res.GetSuccess())
+
+			case "plist":
+				res, err := client.ProfileList(context.Background(), &controlv1.ProfileListRequest{})
+				if err != nil {
+					fmt.Printf("failed to get the profile list: %s\n", err)
+					continue
+				}
+
+				fmt.Println("Profiles:")
+				for _, p := range res.GetItems() {
+					fmt.Printf(
+						"%s: version=%d, features=%d, devices=%d, groups=%d\n\t%s\n",
+						p.GetName(), p.GetVersion(), len(p.GetFeatures()), len(p.GetDeviceIds()), len(p.GetGroups()), p.GetDescription(),
+					)
+				}
+
+			case "pinfo":
+				if len(commandData) != 2 {
+					fmt.Println("the wrong arguments were passed to command")
+					continue
+				}
+
+				res, err := client.ProfileInfo(
+					context.Background(),
+					&controlv1.ProfileInfoRequest{Name: commandData[1]},
+				)
+				if err != nil {
+					fmt.Printf("failed to get the profile: %s\n", err)
+					continue
+				}
+
+				p := res.GetProfile()
+				fmt.Printf("Profile %s, version %d\n\t%s\n", p.GetName(), p.GetVersion(), p.GetDescription())
+				fmt.Println("Features:")
+				for k, v := range p.GetFeatures() {
+					fmt.Printf("\t%s=%s\n", k, formatValue(v))
+				}
+
+				fmt.Printf("Devices: %s\n", strings.Join(p.GetDeviceIds(), ", "))
+				fmt.Printf("Groups: %s\n", strings.Join(p.GetGroups(), ", "))
+
+			case "pcreate":
+				if len(commandData) < 2 {
+					fmt.Println("the wrong arguments were passed to command")
+					continue
+				}
+
+				res, err := client.CreateProfile(
+					context.Background(),
+					&controlv1.CreateProfileRequest{
+						Name:        commandData[1],
+						Description: strings.Join(commandData[2:], " "),
+					},
+				)
+				if err != nil {
+					fmt.Printf("failed to create the profile: %s\n", err)
+					continue
+				}
+
+				fmt.Printf("result: %t\n", res.GetSuccess())
+
+			case "pdelete":
+				if len(commandData) != 2 {
+					fmt.Println("the wrong arguments were passed to command")
+					continue
+				}
+
+				res, err := client.DeleteProfile(
+					context.Background(),
+					&controlv1.DeleteProfileRequest{Name: commandData[1]},
+				)
+				if err != nil {
+					fmt.Printf("failed to delete the profile: %s\n", err)
+					continue
+				}
+
+				fmt.Printf("result: %t\n", res.GetSuccess())
+
+			case "pset", "punset":
+				if commandData[0] == "pset" && len(commandData) < 4 || commandData[0] == "punset" && len(commandData) != 3 {
+					fmt.Println("the wrong arguments were passed to command")
+					continue
+				}
+
+				// профиль заменяется целиком, поэтому меняем текущее содержимое
+				info, err := client.ProfileInfo(
+					context.Background(),
+					&controlv1.ProfileInfoRequest{Name: commandData[1]},
+				)
+				if err != nil {
+					fmt.Printf("failed to get the profile: %s\n", err)
+					continue
+				}
+
+				features := info.GetProfile().GetFeatures()
+				if features == nil {
+					features = make(map[string]*controlv1.FeatureValue)
+				}
+
+				if commandData[0] == "pset" {
+					value, err := featureValue(client, commandData[2], strings.Join(commandData[3:], " "))
+					if err != nil {
+						fmt.Println(err)
+						continue
+					}
+
+					features[commandData[2]] = value
+				} else {
+					delete(features, commandData[2])
+				}
+
+				res, err := client.UpdateProfile(
+					context.Background(),
+					&controlv1.UpdateProfileRequest{
+						Name:        commandData[1],
+						Description: info.GetProfile().GetDescription(),
+						Features:    features,
+					},
+				)
+				if err != nil {
+					fmt.Printf("failed to update the profile: %s\n", err)
+					continue
+				}
+
+				fmt.Printf("result: %t\n", res.GetSuccess())
+
+			case "passign":
+				if len(commandData) != 4 {
+					fmt.Println("the wrong arguments were passed to command")
+					continue
+				}
+
+				req := &controlv1.AssignProfileRequest{Profile: commandData[1]}
+				switch commandData[2] {
+				case "device":
+					req.Target = &controlv1.AssignProfileRequest_DeviceId{DeviceId: commandData[3]}
+				case "group":
+					req.Target = &controlv1.AssignProfileRequest_Group{Group: commandData[3]}
+				default:
+					fmt.Println("the wrong arguments were passed to command")
+					continue
+				}
+
+				res, err := client.AssignProfile(context.Background(), req)
+				if err != nil {
+					fmt.Printf("failed to assign the profile: %s\n", err)
+					continue
+				}
+
+				fmt.Printf("result: %t\n", res.GetSuccess())
+
+			case "punassign":
+				if len(commandData) != 4 {
+					fmt.Println("the wrong arguments were passed to command")
+					continue
+				}
+
+				req := &controlv1.UnassignProfileRequest{Profile: commandData[1]}
+				switch commandData[2] {
+				case "device":
+					req.Target = &controlv1.UnassignProfileRequest_DeviceId{DeviceId: commandData[3]}
+				case "group":
+					req.Target = &controlv1.UnassignProfileRequest_Group{Group: commandData[3]}
+				default:
+					fmt.Println("the wrong arguments were passed to command")
+					continue
+				}
+
+				res, err := client.UnassignProfile(context.Background(), req)
+				if err != nil {
+					fmt.Printf("failed to unassign the profile: %s\n", err)
+					continue
+				}
+
+				fmt.Printf("result: %t\n", res.GetSuccess())
+
+			case "applist":
+				res, err := client.AppList(context.Background(), &controlv1.AppListRequest{})
+				if err != nil {
+					fmt.Printf("failed to get the app catalog: %s\n", err)
+					continue
+				}
+
+				fmt.Println("App catalog:")
+				for _, a := range res.GetItems() {
+					fmt.Printf("%s: name=%s, version=%s\n", a.GetId(), a.GetName(), a.GetVersion())
+					for _, r := range a.GetRules() {
+						if r.GetGroup() != "" {
+							fmt.Printf("\t%s group %s\n", formatAppRule(r.GetRule()), r.GetGroup())
+						} else {
+							fmt.Printf("\t%s profile %s\n", formatAppRule(r.GetRule()), r.GetProfile())
+						}
+					}
+				}
+
+			case "appcreate":
+				if len(commandData) < 3 {
+					fmt.Println("the wrong arguments were passed to command")
+					continue
+				}
+
+				res, err := client.CreateApp(
+					context.Background(),
+					&controlv1.CreateAppRequest{
+						Id:      commandData[1],
+						Version: commandData[2],
+						Name:    strings.Join(commandData[3:], " "),
+					},
+				)
+				if err != nil {
+					fmt.Printf("failed to create the app: %s\n", err)
+					continue
+				}
+
+				fmt.Printf("result: %t\n", res.GetSuccess())
+
+			case "appdelete":
+				if len(commandData) != 2 {
+					fmt.Println("the wrong arguments were passed to command")
+					continue
+				}
+
+				res, err := client.DeleteApp(context.Background(), &controlv1.DeleteAppRequest{Id: commandData[1]})
+				if err != nil {
+					fmt.Printf("failed to delete the app: %s\n", err)
+					continue
+				}
+
+				fmt.Printf("result: %t\n", res.GetSuccess())
+
+			case "apprule":
+				if len(commandData) != 5 {
+					fmt.Println("the wrong arguments were passed to command")
+					continue
+				}
+
+				rule, err := models.ParseAppRule(commandData[2])
+				if err != nil {
+					fmt.Println(err)
+					continue
+				}
+
+				req := &controlv1.SetAppRuleRequest{AppId: commandData[1], Rule: controlv1.AppRule(rule)}
+				switch commandData[3] {
+				case "profile":
+					req.Target = &controlv1.SetAppRuleRequest_Profile{Profile: commandData[4]}
+				case "group":
+					req.Target = &controlv1.SetAppRuleRequest_Group{Group: commandData[4]}
+				default:
+					fmt.Println("the wrong arguments were passed to command")
+					continue
+				}
+
+				res, err := client.SetAppRule(context.Background(), req)
+				if err != nil {
+					fmt.Printf("failed to set the app rule: %s\n", err)
+					continue
+				}
+
+				fmt.Printf("result: %t\n", res.GetSuccess())
+
+			case "appclear":
+				if len(commandData) != 4 {
+					fmt.Println("the wrong arguments were passed to command")
+					continue
+				}
+
+				req := &controlv1.ClearAppRuleRequest{AppId: commandData[1]}
+				switch commandData[2] {
+				case "profile":
+					req.Target = &controlv1.ClearAppRuleRequest_Profile{Profile: commandData[3]}
+				case "group":
+					req.Target = &controlv1.ClearAppRuleRequest_Group{Group: commandData[3]}
+				default:
+					fmt.Println("the wrong arguments were passed to command")
+					continue
+				}
+
+				res, err := client.ClearAppRule(context.Background(), req)
+				if err != nil {
+					fmt.Printf("failed to clear the app rule: %s\n", err)
+					continue
+				}
+
+				fmt.Printf("result: %t\n", res.GetSuccess())
+
+			case "dapps":
+				if len(commandData) != 2 {
+					fmt.Println("the wrong arguments were passed to command")
+					continue
+				}
+
+				res, err := client.DeviceAppCompliance(
+					context.Background(),
+					&controlv1.DeviceAppComplianceRequest{DeviceId: commandData[1]},
+				)
+				if err != nil {
+					fmt.Printf("failed to get the device app compliance: %s\n", err)
+					continue
+				}
+
+				fmt.Println("App policy:")
+				for _, e := range res.GetPolicy() {
+					fmt.Printf("\t%s %s: name=%s, version=%s\n", formatAppRule(e.GetRule()), e.GetId(), e.GetName(), e.GetVersion())
+				}
+
+				if !res.GetReported() {
+					fmt.Println("The device has not reported its inventory yet")
+					continue
+				}
+
+				fmt.Printf("Violations, inventory version %d:\n", res.GetInventoryVersion())
+				printAppViolations(res.GetViolations())
+
+			case "appviolations":
+				filter, order, err := parseListArgs(commandData[1:])
+				if err != nil {
+					fmt.Println(err)
+					continue
+				}
+
+				req := &controlv1.AppViolationListRequest{Filter: filter, OrderBy: order, PageSize: pageSize}
+
+				fmt.Println("Devices breaking app policy:")
+				for {
+					res, err := client.AppViolationList(context.Background(), req)
+					if err != nil {
+						fmt.Printf("failed to get the list of app violations: %s\n", err)
+						break
+					}
+
+					for _, item := range res.Items {
+						fmt.Printf("device: %s\n", item.GetDeviceId())
+						printAppViolations(item.GetViolations())
+					}
+
+					if res.GetNextPageToken() == "" {
+						break
+					}
+					req.PageToken = res.GetNextPageToken()
+				}
+
+			case "crlist":
+				res, err := client.ComplianceRuleList(context.Background(), &controlv1.ComplianceRuleListRequest{})
+				if err != nil {
+					fmt.Printf("failed to get the compliance rule list: %s\n", err)
+					continue
+				}
+
+				fmt.Println("Compliance rules:")
+				for _, r := range res.GetItems() {
+					fmt.Printf("%s: %s\n", r.GetName(), formatComplianceRule(r))
+				}
+
+			case "crcreate":
+				if len(commandData) < 3 {
+					fmt.Println("the wrong arguments were passed to command")
+					continue
+				}
+
+				rule, err := parseComplianceRule(commandData[1], commandData[2], commandData[3:])
+				if err != nil {
+					fmt.Printf("incorrect compliance rule: %s\n", err)
+					continue
+				}
+
+				res, err := client.CreateComplianceRule(
+					context.Background(),
+					&controlv1.CreateComplianceRuleRequest{Rule: rule},
+				)
+				if err != nil {
+					fmt.Printf("failed to create the compliance rule: %s\n", err)
+					continue
+				}
+
+				fmt.Printf("result: %t\n", res.GetSuccess())
+
+			case "crdelete":
+				if len(commandData) != 2 {
+					fmt.Println("the wrong arguments were passed to command")
+					continue
+				}
+
+				res, err := client.DeleteComplianceRule(
+					context.Background(),
+					&controlv1.DeleteComplianceRuleRequest{Name: commandData[1]},
+				)
+				if err != nil {
+					fmt.Printf("failed to delete the compliance rule: %s\n", err)
+					continue
+				}
+
+				fmt.Printf("result: %t\n", res.GetSuccess())
+
+			case "dcompliance":
+				if len(commandData) != 2 && len(commandData) != 3 {
+					fmt.Println("the wrong arguments were passed to command")
+					continue
+				}
+
+				req := &controlv1.DeviceComplianceRequest{DeviceId: commandData[1]}
+				if len(commandData) == 3 {
+					limit, err := strconv.ParseInt(commandData[2], 10, 32)
+					if err != nil {
+						fmt.Println("incorrect limit")
+						continue
+					}
+
+					req.HistoryLimit = int32(limit)
+				}
+
+				res, err := client.DeviceCompliance(context.Background(), req)
+				if err != nil {
+					fmt.Printf("failed to get the device compliance: %s\n", err)
+					continue
+				}
+
+				printCompliance(res.GetCompliance())
+
+				fmt.Println("History:")
+				for _, r := range res.GetHistory() {
+					fmt.Printf("%s %s\n", formatTime(r.GetRecordedAt()), formatComplianceStatus(r.GetStatus()))
+					printComplianceReasons(r.GetReasons())
+				}
+
+			case "compliance":
+				filter, _, err := parseListArgs(commandData[1:])
+				if err != nil {
+					fmt.Println(err)
+					continue
+				}
+
+				res, err := client.ComplianceSummary(
+					context.Background(),
+					&controlv1.ComplianceSummaryRequest{Filter: filter},
+				)
+				if err != nil {
+					fmt.Printf("failed to get the compliance summary: %s\n", err)
+					continue
+				}
+
+				fmt.Printf(
+					"Compliance: %d devices, %d compliant, %d non compliant, %d not evaluated\n",
+					res.GetTotal(), res.GetCompliant(), res.GetNonCompliant(), res.GetUnknown(),
+				)
+				for _, r := range res.GetRules() {
+					fmt.Printf("\t%s (%s): %d devices\n", r.GetRule(), formatComplianceCondition(r.GetCondition()), r.GetDevices())
+				}
+
+			case "arlist":
+				res, err := client.AlertRuleList(context.Background(), &controlv1.AlertRuleListRequest{})
+				if err != nil {
+					fmt.Printf("failed to get the alert rule list: %s\n", err)
+					continue
+				}
+
+				fmt.Println("Alert rules:")
+				for _, r := range res.GetItems() {
+					fmt.Printf("%s: %s", r.GetName(), formatAlertRule(r))
+					if r.GetFromConfig() {
+						fmt.Print(" (config)")
+					}
+					fmt.Println()
+				}
+
+			case "arcreate":
+				if len(commandData) < 3 {
+					fmt.Println("the wrong arguments were passed to command")
+					continue
+				}
+
+				rule, err := parseAlertRule(commandData[1], commandData[2], commandData[3:])
+				if err != nil {
+					fmt.Printf("incorrect alert rule: %s\n", err)
+					continue
+				}
+
+				res, err := client.CreateAlertRule(
+					context.Background(),
+					&controlv1.CreateAlertRuleRequest{Rule: rule},
+				)
+				if err != nil {
+					fmt.Printf("failed to create the alert rule: %s\n", err)
+					continue
+				}
+
+				fmt.Printf("result: %t\n", res.GetSuccess())
+
+			case "ardelete":
+				if len(commandData) != 2 {
+					fmt.Println("the wrong arguments were passed to command")
+					continue
+				}
+
+				res, err := client.DeleteAlertRule(
+					context.Background(),
+					&controlv1.DeleteAlertRuleRequest{Name: commandData[1]},
+				)
+				if err != nil {
+					fmt.Printf("failed to delete the alert rule: %s\n", err)
+					continue
+				}
+
+				fmt.Printf("result: %t\n", res.GetSuccess())
+
+			case "alerts":
+				req := &controlv1.AlertListRequest{FiringOnly: true}
+
+				args := commandData[1:]
+				if len(args) != 0 && args[0] == "all" {
+					req.FiringOnly = false
+					args = args[1:]
+				}
+
+				if len(args) > 1 {
+					fmt.Println("the wrong arguments were passed to command")
+					continue
+				}
+
+				if len(args) == 1 {
+					req.DeviceId = args[0]
+				}
+
+				res, err := client.AlertList(context.Background(), req)
+				if err != nil {
+					fmt.Printf("failed to get the alert list: %s\n", err)
+					continue
+				}
+
+				fmt.Println("Alerts:")
+				for _, a := range res.GetItems() {
+					state := "firing"
+					if !a.GetFiring() {
+						state = "resolved " + formatTime(a.GetResolvedAt())
+					}
+
+					fmt.Printf(
+						"#%d %s %s: %s\n\t%s, fired %s, last %s, count %d, acknowledged %s\n",
+						a.GetId(), a.GetRule(), a.GetDeviceId(), a.GetMessage(),
+						state, formatTime(a.GetFiredAt()), formatTime(a.GetLastFiredAt()), a.GetCount(),
+						formatTime(a.GetAcknowledgedAt()),
+					)
+				}
+
+			case "ack":
+				if len(commandData) != 2 {
+					fmt.Println("the wrong arguments were passed to command")
+					continue
+				}
+
+				id, err := strconv.ParseInt(commandData[1], 10, 64)
+				if err != nil {
+					fmt.Println("incorrect alert id")
+					continue
+				}
+
+				res, err := client.AcknowledgeAlert(
+					context.Background(),
+					&controlv1.AcknowledgeAlertRequest{Id: id},
+				)
+				if err != nil {
+					fmt.Printf("failed to acknowledge the alert: %s\n", err)
+					continue
+				}
+
+				fmt.Printf("result: %t\n", res.GetSuccess())
+
+			case "whlist":
+				res, err := client.WebhookList(context.Background(), &controlv1.WebhookListRequest{})
+				if err != nil {
+					fmt.Printf("failed to get the webhook list: %s\n", err)
+					continue
+				}
+
+				fmt.Println("Webhooks:")
+				for _, w := range res.GetItems() {
+					events := "all events"
+					if len(w.GetEvents()) != 0 {
+						events = strings.Join(w.GetEvents(), ",")
+					}
+
+					fmt.Printf("%s: %s, %s", w.GetName(), w.GetUrl(), events)
+					if w.GetFromConfig() {
+						fmt.Print(" (config)")
+					}
+					fmt.Println()
+				}
+
+			case "whcreate":
+				if len(commandData) != 4 && len(commandData) != 5 {
+					fmt.Println("the wrong arguments were passed to command")
+					continue
+				}
+
+				webhook := &controlv1.Webhook{
+					Name:   commandData[1],
+					Url:    commandData[2],
+					Secret: commandData[3],
+				}
+				if len(commandData) == 5 {
+					webhook.Events = strings.Split(commandData[4], ",")
+				}
+
+				res, err := client.CreateWebhook(
+					context.Background(),
+					&controlv1.CreateWebhookRequest{Webhook: webhook},
+				)
+				if err != nil {
+					fmt.Printf("failed to create the webhook: %s\n", err)
+					continue
+				}
+
+				fmt.Printf("result: %t\n", res.GetSuccess())
+
+			case "whdelete":
+				if len(commandData) != 2 {
+					fmt.Println("the wrong arguments were passed to command")
+					continue
+				}
+
+				res, err := client.DeleteWebhook(
+					context.Background(),
+					&controlv1.DeleteWebhookRequest{Name: commandData[1]},
+				)
+				if err != nil {
+					fmt.Printf("failed to delete the webhook: %s\n", err)
+					continue
+				}
+
+				fmt.Printf("result: %t\n", res.GetSuccess())
+
+			case "cmdsend":
+				if len(commandData) < 3 {
+					fmt.Println("the wrong arguments were passed to command")
+					continue
+				}
+
+				req, err := parseCommand(commandData[1], commandData[2], commandData[3:])
+				if err != nil {
+					fmt.Printf("incorrect arguments: %s\n", err)
+					continue
+				}
+
+				res, err := client.CreateCommand(context.Background(), req)
+				if err != nil {
+					fmt.Printf("failed to queue the command: %s\n", err)
+					continue
+				}
+
+				fmt.Printf("command queued: #%d\n", res.GetId())
+
+			case "cmdinfo":
+				if len(commandData) != 2 {
+					fmt.Println("the wrong arguments were passed to command")
+					continue
+				}
+
+				id, err := strconv.ParseInt(commandData[1], 10, 64)
+				if err != nil {
+					fmt.Println("incorrect command id")
+					continue
+				}
+
+				res, err := client.CommandInfo(
+					context.Background(),
+					&controlv1.CommandInfoRequest{Id: id},
+				)
+				if err != nil {
+					fmt.Printf("failed to get the command info: %s\n", err)
+					continue
+				}
+
+				c := res.GetCommand()
+				fmt.Printf(
+					"#%d %s %s: %s\n\tpayload: %s\n\tresult: %s\n"+
+						"\tcreated %s, delivered %s, acknowledged %s, completed %s, expires %s\n",
+					c.GetId(), formatCommandType(c.GetType()), c.GetDeviceId(), formatCommandStatus(c.GetStatus()),
+					c.GetPayload(), c.GetResult(),
+					formatTime(c.GetCreatedAt()), formatTime(c.GetDeliveredAt()), formatTime(c.GetAcknowledgedAt()),
+					formatTime(c.GetCompletedAt()), formatTime(c.GetExpiresAt()),
+				)
+
+			case "cmdlist":
+				req := &controlv1.CommandListRequest{}
+
+				args := commandData[1:]
+				if len(args) != 0 && args[0] == "pending" {
+					req.PendingOnly = true
+					args = args[1:]
+				}
+
+				if len(args) > 1 {
+					fmt.Println("the wrong arguments were passed to command")
+					continue
+				}
+
+				if len(args) == 1 {
+					req.DeviceId = args[0]
+				}
+
+				res, err := client.CommandList(context.Background(), req)
+				if err != nil {
+					fmt.Printf("failed to get the command list: %s\n", err)
+					continue
+				}
+
+				fmt.Println("Commands:")
+				for _, c := range res.GetItems() {
+					fmt.Printf(
+						"#%d %s %s: %s, created %s",
+						c.GetId(), formatCommandType(c.GetType()), c.GetDeviceId(),
+						formatCommandStatus(c.GetStatus()), formatTime(c.GetCreatedAt()),
+					)
+					if c.GetResult() != "" {
+						fmt.Printf(", result: %s", c.GetResult())
+					}
+					fmt.Println()
+				}
+
+			case "etcreate":
+				req, err := parseEnrollmentTokenArgs(commandData[1:])
+				if err != nil {
+					fmt.Printf("incorrect arguments: %s\n", err)
+					continue
+				}
+
+				res, err := client.CreateEnrollmentToken(context.Background(), req)
+				if err != nil {
+					fmt.Printf("failed to create the enrollment token: %s\n", err)
+					continue
+				}
+
+				fmt.Printf("token #%d: %s\nexpires: %s\n", res.GetId(), res.GetToken(), formatTime(res.GetExpiresAt()))
+
+			case "etlist":
+				res, err := client.EnrollmentTokenList(context.Background(), &controlv1.EnrollmentTokenListRequest{})
+				if err != nil {
+					fmt.Printf("failed to get the enrollment token list: %s\n", err)
+					continue
+				}
+
+				fmt.Println("Enrollment tokens:")
+				for _, t := range res.GetItems() {
+					fmt.Printf(
+						"#%d %s: used %d of %d, expires %s",
+						t.GetId(), t.GetDescription(), t.GetUses(), t.GetMaxUses(), formatTime(t.GetExpiresAt()),
+					)
+					if len(t.GetDeviceTypes()) != 0 {
+						names := make([]string, 0, len(t.GetDeviceTypes()))
+						for _, dt := range t.GetDeviceTypes() {
+							names = append(names, models.DeviceType(dt).String())
+						}
+						fmt.Printf(", types %s", strings.Join(names, ","))
+					}
+					if t.GetGroup() != "" {
+						fmt.Printf(", group %s", t.GetGroup())
+					}
+					if t.GetProfile() != "" {
+						fmt.Printf(", profile %s", t.GetProfile())
+					}
+					if len(t.GetLabels()) != 0 {
+						fmt.Printf(", labels %s", formatLabels(t.GetLabels()))
+					}
+					if t.GetRevokedAt() != nil {
+						fmt.Printf(", revoked %s", formatTime(t.GetRevokedAt()))
+					}
+					fmt.Println()
+				}
+
+			case "etrevoke":
+				if len(commandData) != 2 {
+					fmt.Println("the wrong arguments were passed to command")
+					continue
+				}
+
+				id, err := strconv.ParseInt(commandData[1], 10, 64)
+				if err != nil {
+					fmt.Println("incorrect token id")
+					continue
+				}
+
+				res, err := client.RevokeEnrollmentToken(
+					context.Background(),
+					&controlv1.RevokeEnrollmentTokenRequest{Id: id},
+				)
+				if err != nil {
+					fmt.Printf("failed to revoke the enrollment token: %s\n", err)
+					continue
+				}
+
+				fmt.Printf("result: %t\n", res.GetSuccess())
+
+			case "certlist":
+				if len(commandData) > 2 {
+					fmt.Println("the wrong arguments were passed to command")
+					continue
+				}
+
+				req := &controlv1.CertificateListRequest{}
+				if len(commandData) == 2 {
+					req.DeviceId = commandData[1]
+				}
+
+				res, err := client.CertificateList(context.Background(), req)
+				if err != nil {
+					fmt.Printf("failed to get the certificate list: %s\n", err)
+					continue
+				}
+
+				fmt.Println("Certificates:")
+				for _, c := range res.GetItems() {
+					fmt.Printf(
+						"%s %s: valid %s - %s, issued %s",
+						c.GetSerial(), c.GetDeviceId(),
+						formatTime(c.GetNotBefore()), formatTime(c.GetNotAfter()), formatTime(c.GetIssuedAt()),
+					)
+					if c.GetRevokedAt() != nil {
+						fmt.Printf(", revoked %s", formatTime(c.GetRevokedAt()))
+					}
+					fmt.Println()
+				}
+
+			case "certrevoke":
+				if len(commandData) != 3 {
+					fmt.Println("the wrong arguments were passed to command")
+					continue
+				}
+
+				req := &controlv1.RevokeCertificateRequest{}
+				switch commandData[1] {
+				case "serial":
+					req.Target = &controlv1.RevokeCertificateRequest_Serial{Serial: commandData[2]}
+				case "device":
+					req.Target = &controlv1.RevokeCertificateRequest_DeviceId{DeviceId: commandData[2]}
+				default:
+					fmt.Println("the wrong arguments were passed to command")
+					continue
+				}
+
+				res, err := client.RevokeCertificate(context.Background(), req)
+				if err != nil {
+					fmt.Printf("failed to revoke the certificate: %s\n", err)
+					continue
+				}
+
+				fmt.Printf("revoked: %d\n", res.GetRevoked())
+
+			case "oplist":
+				res, err := client.OperatorList(context.Background(), &controlv1.OperatorListRequest{})
+				if err != nil {
+					fmt.Printf("failed to get the operator list: %s\n", err)
+					continue
+				}
+
+				fmt.Println("Operators:")
+				for _, o := range res.GetItems() {
+					fmt.Printf("%s: roles %s, created %s", o.GetName(), strings.Join(o.GetRoles(), ","), formatTime(o.GetCreatedAt()))
+					if o.GetFromConfig() {
+						fmt.Print(" (config)")
+					}
+					fmt.Println()
+				}
+
+			case "opcreate":
+				if len(commandData) < 2 || len(commandData) > 3 {
+					fmt.Println("the wrong arguments were passed to command")
+					continue
+				}
+
+				req := &controlv1.CreateOperatorRequest{Name: commandData[1]}
+				if len(commandData) == 3 {
+					req.Roles = strings.Split(commandData[2], ",")
+				}
+
+				res, err := client.CreateOperator(context.Background(), req)
+				if err != nil {
+					fmt.Printf("failed to create the operator: %s\n", err)
+					continue
+				}
+
+				fmt.Printf("token: %s\n", res.GetToken())
+
+			case "opdelete":
+				if len(commandData) != 2 {
+					fmt.Println("the wrong arguments were passed to command")
+					continue
+				}
+
+				res, err := client.DeleteOperator(context.Background(), &controlv1.DeleteOperatorRequest{Name: commandData[1]})
+				if err != nil {
+					fmt.Printf("failed to delete the operator: %s\n", err)
+					continue
+				}
+
+				fmt.Printf("result: %t\n", res.GetSuccess())
+
+			case "oproles":
+				if len(commandData) < 2 || len(commandData) > 3 {
+					fmt.Println("the wrong arguments were passed to command")
+					continue
+				}
+
+				req := &controlv1.SetOperatorRolesRequest{Name: commandData[1]}
+				if len(commandData) == 3 {
+					req.Roles = strings.Split(commandData[2], ",")
+				}
+
+				res, err := client.SetOperatorRoles(context.Background(), req)
+				if err != nil {
+					fmt.Printf("failed to set the operator roles: %s\n", err)
+					continue
+				}
+
+				fmt.Printf("result: %t\n", res.GetSuccess())
+
+			case "optoken":
+				if len(commandData) != 2 {
+					fmt.Println("the wrong arguments were passed to command")
+					continue
+				}
+
+				res, err := client.ResetOperatorToken(context.Background(), &controlv1.ResetOperatorTokenRequest{Name: commandData[1]})
+				if err != nil {
+					fmt.Printf("failed to reset the operator token: %s\n", err)
+					continue
+				}
+
+				fmt.Printf("token: %s\n", res.GetToken())
+
+			case "rlist":
+				res, err := client.RoleList(context.Background(), &controlv1.RoleListRequest{})
+				if err != nil {
+					fmt.Printf("failed to get the role list: %s\n", err)
+					continue
+				}
+
+				fmt.Println("Roles:")
+				for _, r := range res.GetItems() {
+					permissions := make([]string, 0, len(r.GetPermissions()))
+					for _, p := range r.GetPermissions() {
+						permissions = append(permissions, models.Permission(p).String())
+					}
+
+					fmt.Printf("%s: %s", r.GetName(), strings.Join(permissions, ","))
+					if len(r.GetGroups()) != 0 {
+						fmt.Printf(", groups %s", strings.Join(r.GetGroups(), ","))
+					}
+					if r.GetSelector() != "" {
+						fmt.Printf(", selector %s", r.GetSelector())
+					}
+					if r.GetDescription() != "" {
+						fmt.Printf(" - %s", r.GetDescription())
+					}
+					if r.GetBuiltin() {
+						fmt.Print(" (built-in)")
+					}
+					fmt.Println()
+				}
+
+			case "rcreate":
+				role, err := parseRoleArgs(commandData[1:])
+				if err != nil {
+					fmt.Printf("incorrect arguments: %s\n", err)
+					continue
+				}
+
+				res, err := client.CreateRole(context.Background(), &controlv1.CreateRoleRequest{Role: role})
+				if err != nil {
+					fmt.Printf("failed to create the role: %s\n", err)
+					continue
+				}
+
+				fmt.Printf("result: %t\n", res.GetSuccess())
+
+			case "rdelete":
+				if len(commandData) != 2 {
+					fmt.Println("the wrong arguments were passed to command")
+					continue
+				}
+
+				res, err := client.DeleteRole(context.Background(), &controlv1.DeleteRoleRequest{Name: commandData[1]})
+				if err != nil {
+					fmt.Printf("failed to delete the role: %s\n", err)
+					continue
+				}
+
+				fmt.Printf("result: %t\n", res.GetSuccess())
+
+			case "audit":
+				req, err := parseAuditArgs(commandData[1:])
+				if err != nil {
+					fmt.Printf("incorrect arguments: %s\n", err)
+					continue
+				}
+
+				res, err := client.AuditLog(context.Background(), req)
+				if err != nil {
+					fmt.Printf("failed to get the audit log: %s\n", err)
+					continue
+				}
+
+				fmt.Println("Audit log:")
+				for _, r := range res.GetItems() {
+					actor := r.GetActor()
+					if actor == "" {
+						actor = "-"
+					}
+
+					fmt.Printf("#%d %s %s from %s: %s %s", r.GetId(), formatTime(r.GetTime()), actor, r.GetPeer(), r.GetMethod(), r.GetResult())
+					if r.GetError() != "" {
+						fmt.Printf(" (%s)", r.GetError())
+					}
+					fmt.Println()
+					if r.GetPrevious() != "" || r.GetNew() != "" {
+						fmt.Printf("\t%q -> %q\n", r.GetPrevious(), r.GetNew())
+					}
+					fmt.Printf("\t%s\n", r.GetPayload())
+				}
+
+			case "auditverify":
+				res, err := client.VerifyAuditLog(context.Background(), &controlv1.VerifyAuditLogRequest{})
+				if err != nil {
+					fmt.Printf("failed to verify the audit log: %s\n", err)
+					continue
+				}
+
+				if res.GetValid() {
+					fmt.Printf("audit log is valid: %d records, head %s\n", res.GetRecords(), res.GetHead())
+				} else {
+					fmt.Printf("audit log is broken at record #%d: %s\n", res.GetBrokenAt(), res.GetReason())
+				}
+
+			case "watch":
+				req, err := parseWatchArgs(commandData[1:])
+				if err != nil {
+					fmt.Printf("incorrect arguments: %s\n", err)
+					continue
+				}
+
+				ctx, cancel := context.WithCancel(context.Background())
+				done := make(chan struct{})
+				go func() {
+					defer close(done)
+					watchEvents(ctx, client, req)
+				}()
+
+				fmt.Println("watching events, press Enter to stop")
+				select {
+				case <-lines:
+				case <-done:
+				}
+				cancel()
+				<-done
+
+			case "stop":
+				stop <- os.Interrupt
+				stopped = true
+
+			default:
+				fmt.Println("unknown command")
+				showCommandsList()
+			}
+		}
+	}()
+
+	sig := <-stop
+	fmt.Println("stopping cli", slog.String("signal", sig.String()))
+
+	fmt.Println("cli stopped")
+}
+
+// parseDeviceTypes parses a comma separated list of device types, 'all'
+// stands for an empty list.
+func parseDeviceTypes(s string) ([]int32, error) {
+	if s == "all" {
+		return nil, nil
+	}
+
+	var result []int32
+	for _, name := range strings.Split(s, ",") {
+		t, err := models.ParseDeviceType(name)
+		if err != nil {
+			return nil, err
+		}
+
+		result = append(result, int32(t))
+	}
+
+	return result, nil
+}
+
+// parseLifecycles parses a comma separated list of lifecycle states, 'all'
+// stands for every state.
+func parseLifecycles(s string) ([]controlv1.Lifecycle, error) {
+	var result []controlv1.Lifecycle
+	if s == "all" {
+		for l := models.Lifecycle(0); l < models.LifecycleCount; l++ {
+			result = append(result, controlv1.Lifecycle(l))
+		}
+
+		return result, nil
+	}
+
+	for _, name := range strings.Split(s, ",") {
+		l, err := models.ParseLifecycle(name)
+		if err != nil {
+			return nil, err
+		}
+
+		result = append(result, controlv1.Lifecycle(l))
+	}
+
+	return result, nil
+}
+
+// parseListArgs parses the arguments of a list command: optional order:,
+// type:, battery: and lifecycle: prefixes followed by a label selector.
+func parseListArgs(args []string) (*controlv1.DeviceFilter, string, error) {
+	var (
+		filter = new(controlv1.DeviceFilter)
+		order  string
+	)
+
+	for len(args) != 0 {
+		if o, ok := strings.CutPrefix(args[0], "order:"); ok {
+			order = strings.ReplaceAll(o, ",", " ")
+		} else if types, ok := strings.CutPrefix(args[0], "type:"); ok {
+			t, err := parseDeviceTypes(types)
+			if err != nil {
+				return nil, "", err
+			}
+
+			filter.DeviceTypes = t
+		} else if states, ok := strings.CutPrefix(args[0], "lifecycle:"); ok {
+			l, err := parseLifecycles(states)
+			if err != nil {
+				return nil, "", err
+			}
+
+			filter.Lifecycles = l
+		} else if battery, ok := strings.CutPrefix(args[0], "battery:"); ok {
+			low, high, found := strings.Cut(battery, "..")
+			if !found {
+				return nil, "", fmt.Errorf("incorrect battery range %q", battery)
+			}
+
+			if low != "" {
+				v, err := strconv.ParseInt(low, 10, 32)
+				if err != nil {
+					return nil, "", fmt.Errorf("incorrect battery range %q", battery)
+				}
+
+				filter.BatteryMin = proto.Int32(int32(v))
+			}
+
+			if high != "" {
+				v, err := strconv.ParseInt(high, 10, 32)
+				if err != nil {
+					return nil, "", fmt.Errorf("incorrect battery range %q", battery)
+				}
+
+				filter.BatteryMax = proto.Int32(int32(v))
+			}
+		} else {
+			break
+		}
+
+		args = args[1:]
+	}
+
+	filter.Selector = strings.Join(args, " ")
+
+	return filter, order, nil
+}
+
+// parseWatchArgs parses the arguments of the watch command.
+func parseWatchArgs(args []string) (*controlv1.WatchEventsRequest, error) {
+	req := new(controlv1.WatchEventsRequest)
+
+	for _, arg := range args {
+		if arg == "" {
+			continue
+		}
+
+		name, value, found := strings.Cut(arg, ":")
+		if !found {
+			return nil, fmt.Errorf("unknown argument %q", arg)
+		}
+
+		switch name {
+		case "after":
+			seq, err := strconv.ParseInt(value, 10, 64)
+			if err != nil {
+				return nil, fmt.Errorf("incorrect sequence number %q", value)
+			}
+
+			req.AfterSeq = seq
+		case "device":
+			req.DeviceIds = strings.Split(value, ",")
+		case "group":
+			req.Group = value
+		case "type":
+			for _, t := range strings.Split(value, ",") {
+				i := slices.Index(models.EventTypes, models.EventType("device."+t))
+				if i < 0 {
+					return nil, fmt.Errorf("unknown event type %q", t)
+				}
+
+				req.Types = append(req.Types, controlv1.EventType(i))
+			}
+		default:
+			return nil, fmt.Errorf("unknown argument %q", arg)
+		}
+	}
+
+	return req, nil
+}
+
+// parseEnrollmentTokenArgs parses the name:value arguments of etcreate.
+func parseEnrollmentTokenArgs(args []string) (*controlv1.CreateEnrollmentTokenRequest, error) {
+	req := &controlv1.CreateEnrollmentTokenRequest{Labels: make(map[string]string)}
+
+	for _, arg := range args {
+		if arg == "" {
+			continue
+		}
+
+		name, value, found := strings.Cut(arg, ":")
+		if !found {
+			return nil, fmt.Errorf("unknown argument %q", arg)
+		}
+
+		switch name {
+		case "ttl":
+			ttl, err := time.ParseDuration(value)
+			if err != nil {
+				return nil, fmt.Errorf("incorrect ttl %q", value)
+			}
+
+			req.Ttl = durationpb.New(ttl)
+		case "uses":
+			n, err := strconv.ParseInt(value, 10, 32)
+			if err != nil || n < 1 {
+				return nil, fmt.Errorf("incorrect number of uses %q", value)
+			}
+
+			req.MaxUses = int32(n)
+		case "types":
+			types, err := parseDeviceTypes(value)
+			if err != nil {
+				return nil, err
+			}
+
+			req.DeviceTypes = types
+		case "group":
+			req.Group = value
+		case "profile":
+			req.Profile = value
+		case "label":
+			key, value, _ := strings.Cut(value, "=")
+			req.Labels[key] = value
+		case "desc":
+			req.Description = value
+		default:
+			return nil, fmt.Errorf("unknown argument %q", arg)
+		}
+	}
+
+	return req, nil
+}
+
+// parseRoleArgs parses the arguments of rcreate, the selector takes the
+// rest of the line since it may contain spaces.
+func parseRoleArgs(args []string) (*controlv1.Role, error) {
+	if len(args) < 2 {
+		return nil, fmt.Errorf("role name and permissions are required")
+	}
+
+	role := &controlv1.Role{Name: args[0]}
+	for _, name := range strings.Split(args[1], ",") {
+		p, err := models.ParsePermission(name)
+		if err != nil {
+			return nil, err
+		}
+
+		role.Permissions = append(role.Permissions, controlv1.Permission(p))
+	}
+
+	rest := args[2:]
+	if len(rest) != 0 {
+		if groups, ok := strings.CutPrefix(rest[0], "group:"); ok {
+			role.Groups = strings.Split(groups, ",")
+			rest = rest[1:]
+		}
+	}
+	role.Selector = strings.Join(rest, " ")
+
+	return role, nil
+}
+
+// parseAuditArgs parses the name:value arguments of audit.
+func parseAuditArgs(args []string) (*controlv1.AuditLogRequest, error) {
+	req := &controlv1.AuditLogRequest{}
+
+	for _, arg := range args {
+		if arg == "" {
+			continue
+		}
+
+		name, value, found := strings.Cut(arg, ":")
+		if !found {
+			return nil, fmt.Errorf("unknown argument %q", arg)
+		}
+
+		switch name {
+		case "device":
+			req.DeviceId = value
+		case "actor":
+			req.Actor = value
+		case "from", "to":
+			t, err := parseAuditTime(value)
+			if err != nil {
+				return nil, err
+			}
+
+			if name == "from" {
+				req.From = timestamppb.New(t)
+			} else {
+				req.To = timestamppb.New(t)
+			}
+		case "limit":
+			n, err := strconv.ParseInt(value, 10, 32)
+			if err != nil || n < 1 {
+				return nil, fmt.Errorf("incorrect limit %q", value)
+			}
+
+			req.Limit = int32(n)
+		default:
+			return nil, fmt.Errorf("unknown argument %q", arg)
+		}
+	}
+
+	return req, nil
+}
+
+// parseAuditTime parses a duration ago, a date or a local time.
+func parseAuditTime(s string) (time.Time, error) {
+	if d, err := time.ParseDuration(s); err == nil {
+		return time.Now().Add(-d), nil
+	}
+
+	for _, layout := range []string{time.DateOnly, "2006-01-02T15:04", "2006-01-02T15:04:05"} {
+		if t, err := time.ParseInLocation(layout, s, time.Local); err == nil {
+			return t, nil
+		}
+	}
+
+	return time.Time{}, fmt.Errorf("incorrect time %q", s)
+}
+
+// watchEvents prints the events until ctx is done. When the server closes
+// the stream, the watch resumes after the last printed event.
+func watchEvents(ctx context.Context, client controlv1.ControlClient, req *controlv1.WatchEventsRequest) {
+	for {
+		stream, err := client.WatchEvents(ctx, req)
+		if err == nil {
+			for {
+				var event *controlv1.Event
+				if event, err = stream.Recv(); err != nil {
+					break
+				}
+
+				fmt.Printf(
+					"#%d %s %s %s %s\n",
+					event.GetSeq(), formatTime(event.GetTime()), formatEventType(event.GetType()),
+					event.GetDeviceId(), formatEventDetails(event),
+				)
+				req.AfterSeq = event.GetSeq()
+			}
+		}
+
+		if ctx.Err() != nil {
+			return
+		}
+
+		if status.Code(err) != codes.Unavailable {
+			fmt.Printf("failed to watch the events: %s\n", err)
+			return
+		}
+
+		select {
+		case <-time.After(time.Second):
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+func formatEventType(t controlv1.EventType) string {
+	return strings.ToLower(strings.TrimPrefix(t.String(), "EVENT_TYPE_"))
+}
+
+func formatEventDetails(event *controlv1.Event) string {
+	switch d := event.GetDetails().(type) {
+	case *controlv1.Event_Registered:
+		return fmt.Sprintf(
+			"type=%s first=%t labels=%s",
+			models.DeviceType(d.Registered.GetDeviceType()), d.Registered.GetFirst(), formatLabels(d.Registered.GetLabels()),
+		)
+	case *controlv1.Event_Pinged:
+		return fmt.Sprintf("location=%s battery=%d%%", d.Pinged.GetLocation(), d.Pinged.GetBattery())
+	case *controlv1.Event_StatusChanged:
+		s := d.StatusChanged
+		return fmt.Sprintf(
+			"location=%s->%s battery=%d%%->%d%%",
+			s.GetPreviousLocation(), s.GetLocation(), s.GetPreviousBattery(), s.GetBattery(),
+		)
+	case *controlv1.Event_BatteryBandChanged:
+		b := d.BatteryBandChanged
+		return fmt.Sprintf(
+			"band=%s->%s battery=%d%%",
+			formatBatteryBand(b.GetPreviousBand()), formatBatteryBand(b.GetBand()), b.GetBattery(),
+		)
+	case *controlv1.Event_PresenceChanged:
+		p := d.PresenceChanged
+		return fmt.Sprintf("presence=%s->%s", formatPresence(p.GetPreviousPresence()), formatPresence(p.GetPresence()))
+	case *controlv1.Event_Offline:
+		return "last seen " + formatTime(d.Offline.GetLastSeen())
+	case *controlv1.Event_FeatureDesired:
+		f := d.FeatureDesired
+		if f.GetFeature() == "" {
+			return "desired state changed"
+		}
+
+		return fmt.Sprintf("%s=%s revision=%d", f.GetFeature(), formatValue(f.GetValue()), f.GetRevision())
+	case *controlv1.Event_StateApplied:
+		return fmt.Sprintf("revision=%d desired=%d", d.StateApplied.GetRevision(), d.StateApplied.GetDesiredRevision())
+	case *controlv1.Event_LifecycleChanged:
+		return fmt.Sprintf(
+			"%s -> %s",
+			formatLifecycle(d.LifecycleChanged.GetPreviousLifecycle()), formatLifecycle(d.LifecycleChanged.GetLifecycle()),
+		)
+	case *controlv1.Event_InventoryChanged:
+		return fmt.Sprintf("version=%d changes=%d", d.InventoryChanged.GetVersion(), len(d.InventoryChanged.GetChanges()))
+	case *controlv1.Event_ComplianceChanged:
+		c := d.ComplianceChanged
+		return fmt.Sprintf(
+			"compliance=%s->%s reasons=%d",
+			formatComplianceStatus(c.GetPreviousStatus()), formatComplianceStatus(c.GetStatus()), len(c.GetReasons()),
+		)
+	}
+
+	return ""
+}
+
+// printBulkFeatureChanges prints the changes of a bulk feature change and
+// the devices it leaves as is.
+func printBulkFeatureChanges(res *controlv1.BulkSetFeatureStateResponse, value *controlv1.FeatureValue) {
+	fmt.Printf("Changes (%d):\n", len(res.GetChanges()))
+	for _, c := range res.GetChanges() {
+		previous := "none"
+		if c.GetPrevious() != nil {
+			previous = formatValue(c.GetPrevious())
+			if !c.GetOverridden() {
+				previous += " (inherited)"
+			}
+		}
+
+		fmt.Printf("\t%s: %s -> %s\n", c.GetDeviceId(), previous, formatValue(value))
+	}
+
+	if len(res.GetUnchangedDeviceIds()) != 0 {
+		fmt.Printf("already set: %s\n", strings.Join(res.GetUnchangedDeviceIds(), ", "))
+	}
+
+	if len(res.GetSkippedDeviceIds()) != 0 {
+		fmt.Printf("skipped, feature does not apply or device is retired or wiped: %s\n", strings.Join(res.GetSkippedDeviceIds(), ", "))
+	}
+}
+
+func formatLifecycle(l controlv1.Lifecycle) string {
+	return models.Lifecycle(l).String()
+}
+
+func formatBatteryBand(b controlv1.BatteryBand) string {
+	return strings.ToLower(strings.TrimPrefix(b.String(), "BATTERY_BAND_"))
+}
+
+func formatAppRule(r controlv1.AppRule) string {
+	return models.AppRule(r).String()
+}
+
+func printAppViolations(violations []*controlv1.AppViolation) {
+	for _, v := range violations {
+		kind := models.AppViolationKind(v.GetKind()).String()
+		if v.GetVersion() == "" {
+			fmt.Printf("\t%s: %s\n", v.GetAppId(), kind)
+		} else {
+			fmt.Printf("\t%s %s: %s\n", v.GetAppId(), v.GetVersion(), kind)
+		}
+	}
+}
+
+// printInventory prints the inventory version and its changes from the
+// previous version.
+func printInventory(res *controlv1.DeviceInventoryResponse) {
+	i := res.GetInventory()
+
+	fmt.Printf("Inventory version %d of %d\n", res.GetVersion(), res.GetLatestVersion())
+	fmt.Printf("reported %s, confirmed %s\n", formatTime(res.GetReportedAt()), formatTime(res.GetConfirmedAt()))
+	fmt.Printf("os: %s %s\n", i.GetOsName(), i.GetOsVersion())
+	fmt.Printf("model: %s, serial: %s\n", i.GetModel(), i.GetSerial())
+	fmt.Printf("storage: %d bytes\n", i.GetStorageBytes())
+
+	fmt.Printf("Network interfaces (%d):\n", len(i.GetInterfaces()))
+	for _, n := range i.GetInterfaces() {
+		fmt.Printf("  %s mac=%s addresses=%s\n", n.GetName(), n.GetMac(), strings.Join(n.GetAddresses(), ","))
+	}
+
+	fmt.Printf("Apps (%d):\n", len(i.GetApps()))
+	for _, a := range i.GetApps() {
+		fmt.Printf("  %s %s %s\n", a.GetId(), a.GetVersion(), a.GetName())
+	}
+
+	if len(res.GetChanges()) == 0 {
+		return
+	}
+
+	fmt.Println("Changes from the previous version:")
+	for _, c := range res.GetChanges() {
+		previous, current := c.GetPrevious(), c.GetCurrent()
+		switch {
+		case previous == "":
+			fmt.Printf("  + %s: %s\n", c.GetField(), current)
+		case current == "":
+			fmt.Printf("  - %s: %s\n", c.GetField(), previous)
+		default:
+			fmt.Printf("  ~ %s: %s -> %s\n", c.GetField(), previous, current)
+		}
+	}
+}
+
+// printStatusHistory prints the battery values and the locations at the
+// points where they changed.
+func printStatusHistory(points []*controlv1.StatusPoint) {
+	if len(points) == 0 {
+		fmt.Println("No statuses in the period")
+		return
+	}
+
+	low, high := points[0].GetBattery(), points[0].GetBattery()
+	for _, p := range points {
+		low, high = min(low, p.GetBattery()), max(high, p.GetBattery())
+	}
+
+	fmt.Printf(
+		"Battery: %d%% -> %d%% (min %d%%, max %d%%, %d points)\n",
+		points[0].GetBattery(), points[len(points)-1].GetBattery(), low, high, len(points),
+	)
+	for i, p := range points {
+		if i == 0 || p.GetBattery() != points[i-1].GetBattery() {
+			fmt.Printf("\t%s: %d%%\n", formatTime(p.GetTime()), p.GetBattery())
+		}
+	}
+
+	fmt.Println("Location changes:")
+	for i, p := range points {
+		if i == 0 || p.GetLocation() != points[i-1].GetLocation() {
+			fmt.Printf("\t%s: %s\n", formatTime(p.GetTime()), p.GetLocation())
+		}
+	}
+}
+
+// parseAlertRule parses the arguments of arcreate: the value is a battery
+// threshold or a duration depending on the condition, drift rules may be
+// followed by a feature name.
+func parseAlertRule(name string, condition string, args []string) (*controlv1.AlertRule, error) {
+	c, err := models.ParseAlertCondition(condition)
+	if err != nil {
+		return nil, err
+	}
+
+	rule := &controlv1.AlertRule{
+		Name:      name,
+		Condition: controlv1.AlertCondition(c),
+	}
+
+	switch c {
+	case models.AlertBatteryBelow:
+		if len(args) != 1 {
+			return nil, fmt.Errorf("%s requires a percent", condition)
+		}
+
+		v, err := strconv.ParseInt(args[0], 10, 32)
+		if err != nil {
+			return nil, fmt.Errorf("incorrect percent %q", args[0])
+		}
+
+		rule.Threshold = int32(v)
+	case models.AlertOffline, models.AlertFeatureDrift:
+		if len(args) == 0 || len(args) > 2 || len(args) == 2 && c != models.AlertFeatureDrift {
+			return nil, fmt.Errorf("%s requires a duration", condition)
+		}
+
+		d, err := time.ParseDuration(args[0])
+		if err != nil {
+			return nil, fmt.Errorf("incorrect duration %q", args[0])
+		}
+
+		rule.Duration = durationpb.New(d)
+		if len(args) == 2 {
+			rule.Feature = args[1]
+		}
+	default:
+		if len(args) != 0 {
+			return nil, fmt.Errorf("%s takes no value", condition)
+		}
+	}
+
+	return rule, nil
+}
+
+func formatAlertRule(r *controlv1.AlertRule) string {
+	condition := models.AlertCondition(r.GetCondition()).String()
+
+	switch r.GetCondition() {
+	case controlv1.AlertCondition_ALERT_CONDITION_BATTERY_BELOW:
+		return fmt.Sprintf("%s %d%%", condition, r.GetThreshold())
+	case controlv1.AlertCondition_ALERT_CONDITION_OFFLINE:
+		return fmt.Sprintf("%s %s", condition, r.GetDuration().AsDuration())
+	case controlv1.AlertCondition_ALERT_CONDITION_FEATURE_DRIFT:
+		feature := r.GetFeature()
+		if feature == "" {
+			feature = "any feature"
+		}
+
+		return fmt.Sprintf("%s %s, %s", condition, r.GetDuration().AsDuration(), feature)
+	}
+
+	return condition
+}
+
+func parseComplianceRule(name string, condition string, args []string) (*controlv1.ComplianceRule, error) {
+	c, err := models.ParseComplianceCondition(condition)
+	if err != nil {
+		return nil, err
+	}
+
+	rule := &controlv1.ComplianceRule{
+		Name:      name,
+		Condition: controlv1.ComplianceCondition(c),
+	}
+
+	switch c {
+	case models.ComplianceMinOsVersion:
+		if len(args) == 0 || len(args) > 2 {
+			return nil, fmt.Errorf("%s requires a version", condition)
+		}
+
+		rule.Version = args[0]
+		if len(args) == 2 {
+			rule.OsName = args[1]
+		}
+	case models.ComplianceBatteryHealth:
+		if len(args) != 1 {
+			return nil, fmt.Errorf("%s requires a percent", condition)
+		}
+
+		v, err := strconv.ParseInt(args[0], 10, 32)
+		if err != nil {
+			return nil, fmt.Errorf("incorrect percent %q", args[0])
+		}
+
+		rule.Threshold = int32(v)
+	case models.ComplianceFeatureState, models.ComplianceCheckIn:
+		if len(args) == 0 || len(args) > 2 || len(args) == 2 && c != models.ComplianceFeatureState {
+			return nil, fmt.Errorf("%s requires a duration", condition)
+		}
+
+		d, err := time.ParseDuration(args[0])
+		if err != nil {
+			return nil, fmt.Errorf("incorrect duration %q", args[0])
+		}
+
+		rule.Duration = durationpb.New(d)
+		if len(args) == 2 {
+			rule.Feature = args[1]
+		}
+	case models.ComplianceLabels:
+		// селектор может содержать пробелы, например key in (a, b)
+		if len(args) == 0 {
+			return nil, fmt.Errorf("%s requires a selector", condition)
+		}
+
+		rule.Selector = strings.Join(args, " ")
+	}
+
+	return rule, nil
+}
+
+func formatComplianceRule(r *controlv1.ComplianceRule) string {
+	condition := formatComplianceCondition(r.GetCondition())
+
+	switch r.GetCondition() {
+	case controlv1.ComplianceCondition_COMPLIANCE_CONDITION_MIN_OS_VERSION:
+		os := r.GetOsName()
+		if os == "" {
+			os = "any os"
+		}
+
+		return fmt.Sprintf("%s %s, %s", condition, r.GetVersion(), os)
+	case controlv1.ComplianceCondition_COMPLIANCE_CONDITION_FEATURE_STATE:
+		feature := r.GetFeature()
+		if feature == "" {
+			feature = "any feature"
+		}
+
+		return fmt.Sprintf("%s %s, %s", condition, r.GetDuration().AsDuration(), feature)
+	case controlv1.ComplianceCondition_COMPLIANCE_CONDITION_BATTERY_HEALTH:
+		return fmt.Sprintf("%s %d%%", condition, r.GetThreshold())
+	case controlv1.ComplianceCondition_COMPLIANCE_CONDITION_CHECK_IN:
+		return fmt.Sprintf("%s %s", condition, r.GetDuration().AsDuration())
+	case controlv1.ComplianceCondition_COMPLIANCE_CONDITION_LABELS:
+		return fmt.Sprintf("%s %s", condition, r.GetSelector())
+	}
+
+	return condition
+}
+
+func formatComplianceCondition(c controlv1.ComplianceCondition) string {
+	return models.ComplianceCondition(c).String()
+}
+
+func formatComplianceStatus(s controlv1.ComplianceStatus) string {
+	return models.ComplianceStatus(s).String()
+}
+
+func printCompliance(c *controlv1.DeviceCompliance) {
+	if c.GetEvaluatedAt() == nil {
+		fmt.Println("compliance: not evaluated yet")
+		return
+	}
+
+	fmt.Printf(
+		"compliance: %s since %s, evaluated %s\n",
+		formatComplianceStatus(c.GetStatus()), formatTime(c.GetSince()), formatTime(c.GetEvaluatedAt()),
+	)
+	printComplianceReasons(c.GetReasons())
+}
+
+func printComplianceReasons(reasons []*controlv1.ComplianceReason) {
+	for _, r := range reasons {
+		fmt.Printf("\t%s (%s): %s\n", r.GetRule(), formatComplianceCondition(r.GetCondition()), r.GetMessage())
+	}
+}
+
+func formatPresence(p controlv1.Presence) string {
+	return strings.ToLower(strings.TrimPrefix(p.String(), "PRESENCE_"))
+}
+
+// parseCommand parses the arguments of cmdsend, the optional ttl goes first,
+// the rest of the arguments form the payload.
+func parseCommand(device string, kind string, args []string) (*controlv1.CreateCommandRequest, error) {
+	t, err := models.ParseCommandType(kind)
+	if err != nil {
+		return nil, err
+	}
+
+	req := &controlv1.CreateCommandRequest{
+		DeviceId: device,
+		Type:     controlv1.CommandType(t),
+	}
+
+	if len(args) != 0 {
+		if value, found := strings.CutPrefix(args[0], "ttl:"); found {
+			ttl, err := time.ParseDuration(value)
+			if err != nil {
+				return nil, fmt.Errorf("incorrect ttl: %w", err)
+			}
+
+			req.Ttl = durationpb.New(ttl)
+			args = args[1:]
+		}
+	}
+
+	req.Payload = strings.Join(args, " ")
+
+	return req, nil
+}
+
+func formatCommandType(t controlv1.CommandType) string {
+	return models.CommandType(t).String()
+}
+
+func formatCommandStatus(s controlv1.CommandStatus) string {
+	return models.CommandStatus(s).String()
+}
+
+func formatTime(t *timestamppb.Timestamp) string {
+	if t == nil {
+		return "never"
+	}
+
+	return t.AsTime().Local().Format(time.DateTime)
+}
+
+func formatLabels(labels map[string]string) string {
+	if len(labels) == 0 {
+		return "none"
+	}
+
+	result := make([]string, 0, len(labels))
+	for k, v := range labels {
+		result = append(result, k+"="+v)
+	}
+	sort.Strings(result)
+
+	return strings.Join(result, ",")
+}
+
+func printFeature(f *controlv1.Feature) {
+	types := "all"
+	if len(f.GetDeviceTypes()) != 0 {
+		names := make([]string, 0, len(f.GetDeviceTypes()))
+		for _, t := range f.GetDeviceTypes() {
+			names = append(names, models.DeviceType(t).String())
+		}
+
+		types = strings.Join(names, ",")
+	}
+
+	constraints := ""
+	switch f.GetType() {
+	case controlv1.FeatureType_FEATURE_TYPE_INT:
+		if f.IntMin != nil || f.IntMax != nil {
+			constraints = "["
+			if f.IntMin != nil {
+				constraints += strconv.FormatInt(f.GetIntMin(), 10)
+			}
+			constraints += ".."
+			if f.IntMax != nil {
+				constraints += strconv.FormatInt(f.GetIntMax(), 10)
+			}
+			constraints += "]"
+		}
+	case controlv1.FeatureType_FEATURE_TYPE_ENUM:
+		constraints = "(" + strings.Join(f.GetEnumValues(), ",") + ")"
+	}
+
+	fmt.Printf(
+		"%s: type=%s%s, default=%s, device types=%s, deprecated=%t\n\t%s\n",
+		f.GetName(), models.FeatureType(f.GetType()), constraints, formatValue(f.GetDefaultValue()),
+		types, f.GetDeprecated(), f.GetDescription(),
+	)
+}
+
+// parseFeatureType parses a feature type spec of the fcreate command into a
+// request with the type and its constraints filled.
+func parseFeatureType(spec string) (*controlv1.CreateFeatureRequest, error) {
+	name, args, _ := strings.Cut(spec, ":")
+
+	req := &controlv1.CreateFeatureRequest{}
+	switch name {
+	case "bool":
+		req.Type = controlv1.FeatureType_FEATURE_TYPE_BOOL
+	case "int":
+		req.Type = controlv1.FeatureType_FEATURE_TYPE_INT
+
+		if args == "" {
+			break
+		}
+
+		low, high, ok := strings.Cut(args, "..")
+		if !ok {
+			return nil, fmt.Errorf("incorrect int range %q", args)
+		}
+
+		for _, bound := range []struct {
+			s   string
+			dst **int64
+		}{{low, &req.IntMin}, {high, &req.IntMax}} {
+			if bound.s == "" {
+				continue
+			}
+
+			n, err := strconv.ParseInt(bound.s, 10, 64)
+			if err != nil {
+				return nil, fmt.Errorf("incorrect int range %q", args)
+			}
+
+			*bound.dst = &n
+		}
+	case "string":
+		req.Type = controlv1.FeatureType_FEATURE_TYPE_STRING
+	case "list":
+		req.Type = controlv1.FeatureType_FEATURE_TYPE_STRING_LIST
+	case "enum":
+		req.Type = controlv1.FeatureType_FEATURE_TYPE_ENUM
+		req.EnumValues = strings.Split(args, ",")
+	default:
+		return nil, fmt.Errorf("unknown feature type %q", name)
+	}
+
+	return req, nil
+}
+
+// featureValue parses the value of the catalog feature according to its type.
+func featureValue(client controlv1.ControlClient, feature string, s string) (*controlv1.FeatureValue, error) {
+	info, err := client.FeatureInfo(
+		context.Background(),
+		&controlv1.FeatureInfoRequest{Name: feature},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get the feature: %w", err)
+	}
+
+	return parseValue(info.GetFeature().GetType(), s)
+}
+
+// parseValue parses the textual form of a value of the given feature type.
+func parseValue(t controlv1.FeatureType, s string) (*controlv1.FeatureValue, error) {
+	v, err := models.ParseFeatureValue(models.FeatureType(t), s)
+	if err != nil {
+		return nil, err
+	}
+
+	switch v.Type {
+	case models.FeatureBool:
+		return &controlv1.FeatureValue{Kind: &controlv1.FeatureValue_BoolValue{BoolValue: v.Bool}}, nil
+	case models.FeatureInt:
+		return &controlv1.FeatureValue{Kind: &controlv1.FeatureValue_IntValue{IntValue: v.Int}}, nil
+	case models.FeatureString:
+		return &controlv1.FeatureValue{Kind: &controlv1.FeatureValue_StringValue{StringValue: v.Str}}, nil
+	case models.FeatureStringList:
+		return &controlv1.FeatureValue{Kind: &controlv1.FeatureValue_StringListValue{
+			StringListValue: &controlv1.StringList{Values: v.List},
+		}}, nil
+	}
+
+	return &controlv1.FeatureValue{Kind: &controlv1.FeatureValue_EnumValue{EnumValue: v.Str}}, nil
+}
+
+func formatValue(v *controlv1.FeatureValue) string {
+	switch kind := v.GetKind().(type) {
+	case *controlv1.FeatureValue_BoolValue:
+		return strconv.FormatBool(kind.BoolValue)
+	case *controlv1.FeatureValue_IntValue:
+		return strconv.FormatInt(kind.IntValue, 10)
+	case *controlv1.FeatureValue_StringValue:
+		return strconv.Quote(kind.StringValue)
+	case *controlv1.FeatureValue_StringListValue:
+		return "[" + strings.Join(kind.StringListValue.GetValues(), ",") + "]"
+	case *controlv1.FeatureValue_EnumValue:
+		return kind.EnumValue
+	}
+
+	return "<unset>"
 }
@@ -11,37 +11,85 @@ import (
 
 	managementv1 "github.com/dvaxert/mdm/api/gen/go/management"
 	"github.com/dvaxert/mdm/internal/device"
-	"github.com/dvaxert/mdm/internal/domain/models"
 	"github.com/dvaxert/mdm/pkg/logger"
 	"google.golang.org/grpc"
-	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 func main() {
 	conf := device.MustLoadConfig()
 
-	state := models.DefaultFeatures
+	state := device.NewState()
+	apps := device.NewApps(conf)
 
-	log := logger.MustSetup(conf.Env).With(slog.Any("state", state))
+	log := logger.MustSetup(conf.Env)
 	log.Info("starting device", slog.Any("config", conf))
 
-	cc, err := grpc.NewClient(
-		net.JoinHostPort(conf.Grpc.Address, conf.Grpc.Port),
-		grpc.WithTransportCredentials(insecure.NewCredentials()),
-	)
-	if err != nil {
-		panic(err)
+	// выданный сервером сертификат используется для всех соединений
+	if conf.Enrollment.Enabled() {
+		conf.Grpc.TLS.Cert, conf.Grpc.TLS.Key = conf.Enrollment.Cert, conf.Enrollment.Key
 	}
 
+	cc := dial(conf)
 	client := managementv1.NewDeviceManagementClient(cc)
 
-	res, err := client.DeviceRegister(
-		context.Background(),
-		&managementv1.DeviceRegisterRequest{
-			DeviceId:   conf.Uuid,
-			DeviceType: int32(conf.DeviceType),
-		},
-	)
+	req := &managementv1.DeviceRegisterRequest{
+		DeviceId:   conf.Uuid,
+		DeviceType: int32(conf.DeviceType),
+		Labels:     conf.Labels,
+		Inventory:  device.Inventory(conf, apps),
+	}
+
+	// устройство с сертификатом подтверждает себя им, остальные передают token
+	var identity *device.Identity
+	req.EnrollmentToken = conf.Enrollment.Token
+	if conf.Enrollment.Enabled() {
+		expiry, err := device.CertificateExpiry(conf.Enrollment)
+		if err != nil {
+			panic("failed to read the device certificate: " + err.Error())
+		}
+
+		if expiry.IsZero() {
+			identity, err = device.NewIdentity(conf.Uuid)
+			if err != nil {
+				panic(err)
+			}
+
+			req.Csr = identity.CSR
+		} else {
+			req.EnrollmentToken = ""
+		}
+	}
+
+	res, err := client.DeviceRegister(context.Background(), req)
+
+	// сертификат отзывается, когда оператор отправляет устройство на повторную
+	// регистрацию, тогда устройство получает новый по token
+	if status.Code(err) == codes.Unauthenticated && conf.Enrollment.Enabled() && identity == nil && conf.Enrollment.Token != "" {
+		log.Warn("device certificate is not accepted, enrolling again", slog.Any("error", err))
+
+		identity, err = device.NewIdentity(conf.Uuid)
+		if err != nil {
+			panic(err)
+		}
+		req.Csr, req.EnrollmentToken = identity.CSR, conf.Enrollment.Token
+
+		anonymous := *conf
+		anonymous.Grpc.TLS.Cert, anonymous.Grpc.TLS.Key = "", ""
+
+		cc.Close()
+		cc = dial(&anonymous)
+		client = managementv1.NewDeviceManagementClient(cc)
+
+		res, err = client.DeviceRegister(context.Background(), req)
+	}
+	// сервер не принимает token от уже зарегистрированного устройства,
+	// без сертификата устройство не сможет работать дальше
+	if status.Code(err) == codes.AlreadyExists && identity == nil {
+		panic("device is already enrolled: it must register with its certificate, " +
+			"or be moved to re-enrollment by the operator and use a new enrollment token: " + err.Error())
+	}
 	if err != nil {
 		panic(err)
 	}
@@ -50,42 +98,71 @@ func main() {
 		panic("failed to register on the server")
 	}
 
-	go func() {
-		for {
-			time.Sleep(conf.PingPeriod)
+	if identity != nil {
+		if err = identity.Save(conf.Enrollment, res.GetCertificate()); err != nil {
+			panic(err)
+		}
 
-			log.Info("attempting to send device ping")
+		log.Info("device enrolled, certificate saved", slog.String("cert", conf.Enrollment.Cert))
 
-			pingRes, err := client.DevicePing(
-				context.Background(),
-				&managementv1.DevicePingRequest{
-					DeviceId: conf.Uuid,
-					Location: conf.Location,
-					Battery:  int32(conf.Battery),
-				},
-			)
-			if err != nil {
-				log.Error("error when sending a ping to the server", slog.Any("error", err))
+		// текущее соединение установлено без сертификата
+		cc.Close()
+		cc = dial(conf)
+		client = managementv1.NewDeviceManagementClient(cc)
+	}
+
+	if conf.Enrollment.Enabled() {
+		go func() {
+			for {
+				renewCertificate(log, client, conf)
+				time.Sleep(conf.PingPeriod)
 			}
+		}()
+	}
 
-			if pingRes.StateChanged {
-				log.Info("device state change detected, request new state")
-
-				stateRes, err := client.DeviceState(
-					context.Background(),
-					&managementv1.DeviceStateRequest{
-						DeviceId: conf.Uuid,
-					},
-				)
-				if err != nil {
-					log.Error("error when requesting a new device state", slog.Any("error", err))
+	go func() {
+		for {
+			err := runSession(log, client, conf, state, apps)
+			if status.Code(err) == codes.Unimplemented {
+				log.Warn("server does not support push sessions, falling back to ping")
+
+				fetchState(log, client, conf, state, apps)
+				for {
+					ping(log, client, conf, state, apps)
 				}
+			}
+
+			log.Error("device session closed", slog.Any("error", err))
+
+			// пока сессия недоступна, сервер узнает о нас через обычный ping
+			ping(log, client, conf, state, apps)
+		}
+	}()
+
+	go func() {
+		for {
+			time.Sleep(conf.PingPeriod)
 
-				state = stateRes.Features
+			if err := runCommands(log, client, conf); status.Code(err) == codes.Unimplemented {
+				log.Warn("server does not support commands")
+				return
 			}
 		}
 	}()
 
+	if conf.Inventory.Enabled() {
+		go func() {
+			for {
+				time.Sleep(conf.Inventory.Period)
+
+				if err := reportInventory(log, client, conf, apps); status.Code(err) == codes.Unimplemented {
+					log.Warn("server does not support inventory")
+					return
+				}
+			}
+		}()
+	}
+
 	stop := make(chan os.Signal, 1)
 	signal.Notify(stop, syscall.SIGTERM, syscall.SIGINT)
 
@@ -94,3 +171,341 @@ func main() {
 
 	log.Info("device stopped")
 }
+
+func dial(conf *device.Config) *grpc.ClientConn {
+	creds, err := conf.Grpc.TLS.Credentials()
+	if err != nil {
+		panic("incorrect tls config: " + err.Error())
+	}
+
+	cc, err := grpc.NewClient(
+		net.JoinHostPort(conf.Grpc.Address, conf.Grpc.Port),
+		grpc.WithTransportCredentials(creds),
+	)
+	if err != nil {
+		panic(err)
+	}
+
+	return cc
+}
+
+// renewCertificate requests a new certificate with a new key when the
+// current one is about to expire. New connections pick up the saved
+// certificate, the open ones keep the current until it expires.
+func renewCertificate(
+	log *slog.Logger,
+	client managementv1.DeviceManagementClient,
+	conf *device.Config,
+) {
+	expiry, err := device.CertificateExpiry(conf.Enrollment)
+	if err != nil {
+		log.Error("failed to read the device certificate", slog.Any("error", err))
+		return
+	}
+
+	if expiry.IsZero() || time.Until(expiry) > conf.Enrollment.RenewBefore {
+		return
+	}
+
+	log.Info("attempting to renew device certificate", slog.Time("expires_at", expiry))
+
+	identity, err := device.NewIdentity(conf.Uuid)
+	if err != nil {
+		log.Error("failed to create the certificate request", slog.Any("error", err))
+		return
+	}
+
+	res, err := client.RenewCertificate(
+		context.Background(),
+		&managementv1.RenewCertificateRequest{
+			DeviceId: conf.Uuid,
+			Csr:      identity.CSR,
+		},
+	)
+	if err != nil {
+		log.Error("error when renewing the certificate", slog.Any("error", err))
+		return
+	}
+
+	if err = identity.Save(conf.Enrollment, res.GetCertificate()); err != nil {
+		log.Error("failed to save the renewed certificate", slog.Any("error", err))
+		return
+	}
+
+	log.Info("device certificate renewed successfully")
+}
+
+// runSession keeps a push session with the server open. The device status is
+// sent every ping period and feature changes are applied as they arrive.
+func runSession(
+	log *slog.Logger,
+	client managementv1.DeviceManagementClient,
+	conf *device.Config,
+	state *device.State,
+	apps *device.Apps,
+) error {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	stream, err := client.Connect(ctx)
+	if err != nil {
+		return err
+	}
+
+	report := func() *managementv1.ConnectRequest {
+		return &managementv1.ConnectRequest{
+			DeviceId:        conf.Uuid,
+			Location:        conf.Location,
+			Battery:         int32(conf.Battery),
+			AppliedRevision: state.Revision(),
+		}
+	}
+
+	if err = stream.Send(report()); err != nil {
+		return err
+	}
+
+	go func() {
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(conf.PingPeriod):
+			}
+
+			log.Info("attempting to send device status")
+
+			if err := stream.Send(report()); err != nil {
+				log.Error("error when sending a status to the server", slog.Any("error", err))
+				return
+			}
+		}
+	}()
+
+	for {
+		res, err := stream.Recv()
+		if err != nil {
+			return err
+		}
+
+		values := device.Values(res.GetFeatures(), res.GetValues())
+		if res.GetFull() {
+			state.Replace(values, res.GetRevision())
+		} else {
+			state.Apply(values, res.GetRevision())
+		}
+
+		reportState(log, client, conf, state)
+
+		if res.GetFull() {
+			enforceApps(log, client, conf, apps, res.GetAppPolicy())
+		}
+	}
+}
+
+// ping reports the device status and applied revision after a ping period and
+// requests the new state if the server reports that it has changed.
+func ping(
+	log *slog.Logger,
+	client managementv1.DeviceManagementClient,
+	conf *device.Config,
+	state *device.State,
+	apps *device.Apps,
+) {
+	time.Sleep(conf.PingPeriod)
+
+	log.Info("attempting to send device ping")
+
+	pingRes, err := client.DevicePing(
+		context.Background(),
+		&managementv1.DevicePingRequest{
+			DeviceId:        conf.Uuid,
+			Location:        conf.Location,
+			Battery:         int32(conf.Battery),
+			AppliedRevision: state.Revision(),
+		},
+	)
+	if err != nil {
+		log.Error("error when sending a ping to the server", slog.Any("error", err))
+		return
+	}
+
+	if !pingRes.StateChanged {
+		return
+	}
+
+	log.Info("device state change detected, request new state")
+
+	fetchState(log, client, conf, state, apps)
+}
+
+// fetchState requests the device state from the server, applies it and
+// reports the applied state back.
+func fetchState(
+	log *slog.Logger,
+	client managementv1.DeviceManagementClient,
+	conf *device.Config,
+	state *device.State,
+	apps *device.Apps,
+) {
+	stateRes, err := client.DeviceState(
+		context.Background(),
+		&managementv1.DeviceStateRequest{
+			DeviceId: conf.Uuid,
+		},
+	)
+	if err != nil {
+		log.Error("error when requesting a new device state", slog.Any("error", err))
+		return
+	}
+
+	state.Replace(device.Values(stateRes.GetFeatures(), stateRes.GetValues()), stateRes.GetRevision())
+
+	reportState(log, client, conf, state)
+
+	enforceApps(log, client, conf, apps, stateRes.GetAppPolicy())
+}
+
+// enforceApps installs and removes apps according to the app policy and
+// reports the changed inventory right away. Servers that predate app
+// policies send none, then the apps are left as they are.
+func enforceApps(
+	log *slog.Logger,
+	client managementv1.DeviceManagementClient,
+	conf *device.Config,
+	apps *device.Apps,
+	policy *managementv1.AppPolicy,
+) {
+	if policy == nil || !conf.Inventory.Enabled() || !apps.Enforce(policy) {
+		return
+	}
+
+	log.Info("installed apps changed by app policy", slog.Any("apps", apps.List()))
+
+	reportInventory(log, client, conf, apps)
+}
+
+// reportState tells the server which feature state the device has applied.
+func reportState(
+	log *slog.Logger,
+	client managementv1.DeviceManagementClient,
+	conf *device.Config,
+	state *device.State,
+) {
+	features, revision := state.Features(), state.Revision()
+
+	log.Info(
+		"device state updated",
+		slog.Any("features", features),
+		slog.Int64("revision", revision),
+	)
+
+	_, err := client.DeviceReportState(
+		context.Background(),
+		&managementv1.DeviceReportStateRequest{
+			DeviceId: conf.Uuid,
+			Features: device.BoolFeatures(features),
+			Revision: revision,
+			Values:   features,
+		},
+	)
+	if err != nil {
+		log.Error("error when reporting the applied state", slog.Any("error", err))
+	}
+}
+
+// reportInventory sends the device inventory, the server stores a new
+// version only if it has changed.
+func reportInventory(
+	log *slog.Logger,
+	client managementv1.DeviceManagementClient,
+	conf *device.Config,
+	apps *device.Apps,
+) error {
+	log.Info("attempting to report device inventory")
+
+	res, err := client.DeviceReportInventory(
+		context.Background(),
+		&managementv1.DeviceReportInventoryRequest{
+			DeviceId:  conf.Uuid,
+			Inventory: device.Inventory(conf, apps),
+		},
+	)
+	if err != nil {
+		log.Error("error when reporting the inventory", slog.Any("error", err))
+		return err
+	}
+
+	log.Info("device inventory reported", slog.Int64("version", res.GetVersion()), slog.Bool("changed", res.GetChanged()))
+
+	return nil
+}
+
+// runCommands fetches the queued commands, executes them one by one and
+// reports the progress of each to the server.
+func runCommands(
+	log *slog.Logger,
+	client managementv1.DeviceManagementClient,
+	conf *device.Config,
+) error {
+	res, err := client.DeviceCommands(
+		context.Background(),
+		&managementv1.DeviceCommandsRequest{
+			DeviceId: conf.Uuid,
+		},
+	)
+	if err != nil {
+		log.Error("error when requesting commands", slog.Any("error", err))
+		return err
+	}
+
+	for _, command := range res.GetCommands() {
+		log := log.With(
+			slog.Int64("command", command.GetId()),
+			slog.String("type", command.GetType().String()),
+		)
+
+		log.Info("executing command")
+
+		err = reportCommand(client, conf, command.GetId(), managementv1.CommandStatus_COMMAND_STATUS_ACKNOWLEDGED, "")
+		if err != nil {
+			log.Error("error when acknowledging the command", slog.Any("error", err))
+			continue
+		}
+
+		result, err := device.Execute(conf, command)
+
+		reported := managementv1.CommandStatus_COMMAND_STATUS_SUCCEEDED
+		if err != nil {
+			reported, result = managementv1.CommandStatus_COMMAND_STATUS_FAILED, err.Error()
+		}
+
+		log.Info("command executed", slog.String("status", reported.String()), slog.String("result", result))
+
+		if err = reportCommand(client, conf, command.GetId(), reported, result); err != nil {
+			log.Error("error when reporting the command result", slog.Any("error", err))
+		}
+	}
+
+	return nil
+}
+
+func reportCommand(
+	client managementv1.DeviceManagementClient,
+	conf *device.Config,
+	id int64,
+	reported managementv1.CommandStatus,
+	result string,
+) error {
+	_, err := client.DeviceReportCommand(
+		context.Background(),
+		&managementv1.DeviceReportCommandRequest{
+			DeviceId: conf.Uuid,
+			Id:       id,
+			Status:   reported,
+			Result:   result,
+		},
+	)
+
+	return err
+}
@@ -17,7 +17,7 @@ func main() {
 	log := logger.MustSetup(conf.Env)
 	log.Info("starting application", slog.Any("config", conf))
 
-	application := serverapp.New(log, conf.Grpc.Port, conf.StoragePath)
+	application := serverapp.New(log, conf)
 	go application.MustRun()
 
 	stop := make(chan os.Signal, 1)
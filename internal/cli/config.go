@@ -1,19 +1,63 @@
 package cli
 
 import (
+	"context"
 	"flag"
 	"os"
 
+	"github.com/dvaxert/mdm/pkg/tlsconfig"
 	"github.com/ilyakaznacheev/cleanenv"
+	"google.golang.org/grpc/credentials"
 )
 
 type Config struct {
 	Grpc GrpcConfig `yaml:"grpc" env-required:"true"`
 }
 
+// GrpcConfig: token - API token оператора, без него оператор входит по
+// клиентскому сертификату из tls
 type GrpcConfig struct {
-	Address string `yaml:"address"`
-	Port    string `yaml:"port"`
+	Address string    `yaml:"address"`
+	Port    string    `yaml:"port"`
+	Token   string    `yaml:"token" env:"MDM_TOKEN"`
+	TLS     TLSConfig `yaml:"tls"`
+}
+
+// Credentials returns the credentials passing the API token with every
+// call, nil if the token is not set.
+func (c GrpcConfig) Credentials() credentials.PerRPCCredentials {
+	if c.Token == "" {
+		return nil
+	}
+
+	return tokenCredentials(c.Token)
+}
+
+type tokenCredentials string
+
+func (t tokenCredentials) GetRequestMetadata(ctx context.Context, uri ...string) (map[string]string, error) {
+	return map[string]string{"authorization": "Bearer " + string(t)}, nil
+}
+
+// RequireTransportSecurity разрешает токен и без TLS, например для
+// локального сервера
+func (t tokenCredentials) RequireTransportSecurity() bool {
+	return false
+}
+
+// TLSConfig включает TLS при enabled. Сертификат сервера проверяется по ca,
+// или по системным корневым сертификатам, если ca не задан. cert и key
+// задают сертификат клиента для серверов, проверяющих клиентов
+type TLSConfig struct {
+	Enabled    bool   `yaml:"enabled"`
+	CA         string `yaml:"ca"`
+	Cert       string `yaml:"cert"`
+	Key        string `yaml:"key"`
+	ServerName string `yaml:"server_name"` // по умолчанию адрес сервера
+}
+
+func (c TLSConfig) Credentials() (credentials.TransportCredentials, error) {
+	return tlsconfig.ClientCredentials(c.Enabled, c.CA, c.Cert, c.Key, c.ServerName)
 }
 
 func MustLoadConfig() *Config {
@@ -0,0 +1,67 @@
+package device
+
+import (
+	"slices"
+	"strings"
+	"sync"
+
+	managementv1 "github.com/dvaxert/mdm/api/gen/go/management"
+)
+
+// Apps are the apps installed on the device, the configured apps at start.
+type Apps struct {
+	mu   sync.Mutex
+	apps []AppConfig
+}
+
+func NewApps(conf *Config) *Apps {
+	return &Apps{apps: slices.Clone(conf.Inventory.Apps)}
+}
+
+func (a *Apps) List() []AppConfig {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	return slices.Clone(a.apps)
+}
+
+// Enforce installs the required apps and removes the blocked ones, in an
+// allowlist policy the apps without a rule are removed as well. It reports
+// whether the installed apps have changed.
+func (a *Apps) Enforce(policy *managementv1.AppPolicy) bool {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	rules := make(map[string]managementv1.AppRule, len(policy.GetApps()))
+	allowlist := false
+	for _, e := range policy.GetApps() {
+		rules[e.GetId()] = e.GetRule()
+		allowlist = allowlist || e.GetRule() == managementv1.AppRule_APP_RULE_ALLOW
+	}
+
+	changed := false
+
+	a.apps = slices.DeleteFunc(a.apps, func(app AppConfig) bool {
+		rule, ok := rules[app.Id]
+		remove := ok && rule == managementv1.AppRule_APP_RULE_BLOCK || !ok && allowlist
+		changed = changed || remove
+		return remove
+	})
+
+	for _, e := range policy.GetApps() {
+		if e.GetRule() != managementv1.AppRule_APP_RULE_REQUIRE {
+			continue
+		}
+
+		if slices.ContainsFunc(a.apps, func(app AppConfig) bool { return app.Id == e.GetId() }) {
+			continue
+		}
+
+		a.apps = append(a.apps, AppConfig{Id: e.GetId(), Name: e.GetName(), Version: e.GetVersion()})
+		changed = true
+	}
+
+	slices.SortFunc(a.apps, func(x, y AppConfig) int { return strings.Compare(x.Id, y.Id) })
+
+	return changed
+}
@@ -0,0 +1,49 @@
+package device
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	managementv1 "github.com/dvaxert/mdm/api/gen/go/management"
+)
+
+// minDestructiveBattery is the battery level below which the device refuses
+// to wipe or reboot.
+const minDestructiveBattery = 20
+
+var ErrLowBattery = errors.New("battery is too low")
+
+// Execute simulates the command on the device and returns its result.
+func Execute(conf *Config, command *managementv1.Command) (string, error) {
+	switch command.GetType() {
+	case managementv1.CommandType_COMMAND_TYPE_LOCK:
+		if command.GetPayload() != "" {
+			return "device locked with message: " + command.GetPayload(), nil
+		}
+
+		return "device locked", nil
+	case managementv1.CommandType_COMMAND_TYPE_WIPE:
+		if conf.Battery < minDestructiveBattery {
+			return "", ErrLowBattery
+		}
+
+		time.Sleep(time.Second)
+
+		return "device wiped", nil
+	case managementv1.CommandType_COMMAND_TYPE_REBOOT:
+		if conf.Battery < minDestructiveBattery {
+			return "", ErrLowBattery
+		}
+
+		time.Sleep(time.Second)
+
+		return "device rebooted", nil
+	case managementv1.CommandType_COMMAND_TYPE_LOCATE:
+		return "ringing, location: " + conf.Location, nil
+	case managementv1.CommandType_COMMAND_TYPE_CUSTOM:
+		return "custom action executed: " + command.GetPayload(), nil
+	}
+
+	return "", fmt.Errorf("unsupported command type %s", command.GetType())
+}
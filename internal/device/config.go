@@ -6,7 +6,9 @@ import (
 	"time"
 
 	"github.com/dvaxert/mdm/internal/domain/models"
+	"github.com/dvaxert/mdm/pkg/tlsconfig"
 	"github.com/ilyakaznacheev/cleanenv"
+	"google.golang.org/grpc/credentials"
 )
 
 type Config struct {
@@ -17,11 +19,76 @@ type Config struct {
 	PingPeriod time.Duration     `yaml:"ping_period" env-required:"true"`
 	Location   string            `yaml:"location" env-required:"true"`
 	Battery    int               `yaml:"battery" env-required:"true"`
+	Labels     map[string]string `yaml:"labels"` // сообщаются серверу при регистрации
+	Enrollment EnrollmentConfig  `yaml:"enrollment"`
+	Inventory  InventoryConfig   `yaml:"inventory"`
 }
 
 type GrpcConfig struct {
-	Address string `yaml:"address"`
-	Port    string `yaml:"port"`
+	Address string    `yaml:"address"`
+	Port    string    `yaml:"port"`
+	TLS     TLSConfig `yaml:"tls"`
+}
+
+// TLSConfig включает TLS при enabled. Сертификат сервера проверяется по ca,
+// или по системным корневым сертификатам, если ca не задан. cert и key
+// задают сертификат устройства, CN сертификата должен совпадать с uuid
+type TLSConfig struct {
+	Enabled    bool   `yaml:"enabled"`
+	CA         string `yaml:"ca"`
+	Cert       string `yaml:"cert"`
+	Key        string `yaml:"key"`
+	ServerName string `yaml:"server_name"` // по умолчанию адрес сервера
+}
+
+// EnrollmentConfig задает token, по которому устройство регистрируется на
+// сервере, и файлы сертификата и ключа, выданных сервером. Если сертификата
+// еще нет, устройство получает его при регистрации по token и обновляет за
+// renew_before до окончания срока. Устройство без сертификата передает token
+// при каждой регистрации, устройство с отозванным сертификатом получает по
+// token новый
+type EnrollmentConfig struct {
+	Token       string        `yaml:"token"`
+	Cert        string        `yaml:"cert"`
+	Key         string        `yaml:"key"`
+	RenewBefore time.Duration `yaml:"renew_before" env-default:"168h"`
+}
+
+// InventoryConfig описывает инвентарь, который устройство сообщает при
+// регистрации и затем каждые period. Устройство без инвентаря его не сообщает
+type InventoryConfig struct {
+	OsName       string                   `yaml:"os_name"`
+	OsVersion    string                   `yaml:"os_version"`
+	Model        string                   `yaml:"model"`
+	Serial       string                   `yaml:"serial"`
+	StorageBytes int64                    `yaml:"storage_bytes"`
+	Interfaces   []NetworkInterfaceConfig `yaml:"interfaces"`
+	Apps         []AppConfig              `yaml:"apps"`
+	Period       time.Duration            `yaml:"period" env-default:"1h"`
+}
+
+type NetworkInterfaceConfig struct {
+	Name      string   `yaml:"name"`
+	Mac       string   `yaml:"mac"`
+	Addresses []string `yaml:"addresses"`
+}
+
+type AppConfig struct {
+	Id      string `yaml:"id"`
+	Name    string `yaml:"name"`
+	Version string `yaml:"version"`
+}
+
+func (c InventoryConfig) Enabled() bool {
+	return c.OsName != "" || c.Model != "" || c.Serial != "" || len(c.Interfaces) != 0 || len(c.Apps) != 0
+}
+
+func (c EnrollmentConfig) Enabled() bool {
+	return c.Cert != "" && c.Key != ""
+}
+
+func (c TLSConfig) Credentials() (credentials.TransportCredentials, error) {
+	return tlsconfig.ClientCredentials(c.Enabled, c.CA, c.Cert, c.Key, c.ServerName)
 }
 
 func MustLoadConfig() *Config {
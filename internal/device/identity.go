@@ -0,0 +1,89 @@
+package device
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"time"
+)
+
+// Identity is a private key of the device with a certificate signing request
+// for it. The key is kept in memory until the server issues the certificate.
+type Identity struct {
+	key []byte
+	CSR []byte
+}
+
+// NewIdentity generates a new key of the device and a CSR with the device
+// UUID as the common name.
+func NewIdentity(uuid string) (*Identity, error) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate the key: %w", err)
+	}
+
+	csr, err := x509.CreateCertificateRequest(
+		rand.Reader,
+		&x509.CertificateRequest{Subject: pkix.Name{CommonName: uuid}},
+		key,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create the csr: %w", err)
+	}
+
+	der, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode the key: %w", err)
+	}
+
+	return &Identity{
+		key: pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: der}),
+		CSR: pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE REQUEST", Bytes: csr}),
+	}, nil
+}
+
+// Save writes the certificate issued for the identity and its key to the
+// enrollment files. The key is written first, so a certificate on disk
+// always has its key next to it.
+func (i *Identity) Save(conf EnrollmentConfig, cert []byte) error {
+	if err := os.WriteFile(conf.Key, i.key, 0o600); err != nil {
+		return fmt.Errorf("failed to save the key: %w", err)
+	}
+
+	if err := os.WriteFile(conf.Cert, cert, 0o644); err != nil {
+		return fmt.Errorf("failed to save the certificate: %w", err)
+	}
+
+	return nil
+}
+
+// CertificateExpiry returns the end of the validity of the enrolled
+// certificate, zero time if the device has not been enrolled yet.
+func CertificateExpiry(conf EnrollmentConfig) (time.Time, error) {
+	data, err := os.ReadFile(conf.Cert)
+	if errors.Is(err, fs.ErrNotExist) {
+		return time.Time{}, nil
+	}
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return time.Time{}, fmt.Errorf("no certificate found in %s", conf.Cert)
+	}
+
+	cert, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	return cert.NotAfter, nil
+}
@@ -0,0 +1,40 @@
+package device
+
+import (
+	managementv1 "github.com/dvaxert/mdm/api/gen/go/management"
+)
+
+// Inventory returns the configured inventory with the installed apps in the
+// form reported to the server, nil if the device has no inventory.
+func Inventory(conf *Config, apps *Apps) *managementv1.Inventory {
+	if !conf.Inventory.Enabled() {
+		return nil
+	}
+
+	c := conf.Inventory
+	result := &managementv1.Inventory{
+		OsName:       c.OsName,
+		OsVersion:    c.OsVersion,
+		Model:        c.Model,
+		Serial:       c.Serial,
+		StorageBytes: c.StorageBytes,
+	}
+
+	for _, n := range c.Interfaces {
+		result.Interfaces = append(result.Interfaces, &managementv1.NetworkInterface{
+			Name:      n.Name,
+			Mac:       n.Mac,
+			Addresses: n.Addresses,
+		})
+	}
+
+	for _, a := range apps.List() {
+		result.Apps = append(result.Apps, &managementv1.App{
+			Id:      a.Id,
+			Name:    a.Name,
+			Version: a.Version,
+		})
+	}
+
+	return result
+}
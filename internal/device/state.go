@@ -0,0 +1,80 @@
+package device
+
+import (
+	"maps"
+	"sync"
+
+	managementv1 "github.com/dvaxert/mdm/api/gen/go/management"
+)
+
+// State is the feature state applied on the device together with the
+// revision it corresponds to.
+type State struct {
+	mu       sync.Mutex
+	features map[string]*managementv1.FeatureValue
+	revision int64
+}
+
+func NewState() *State {
+	return &State{features: make(map[string]*managementv1.FeatureValue)}
+}
+
+func (s *State) Features() map[string]*managementv1.FeatureValue {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return maps.Clone(s.features)
+}
+
+func (s *State) Revision() int64 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.revision
+}
+
+// Replace sets the full feature state received from the server.
+func (s *State) Replace(features map[string]*managementv1.FeatureValue, revision int64) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.features = maps.Clone(features)
+	s.revision = revision
+}
+
+// Apply merges a feature delta received from the server.
+func (s *State) Apply(features map[string]*managementv1.FeatureValue, revision int64) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	maps.Copy(s.features, features)
+	s.revision = revision
+}
+
+// Values returns the typed feature values sent by the server. Servers that
+// predate typed values send only the bool map, it is converted instead.
+func Values(features map[string]bool, values map[string]*managementv1.FeatureValue) map[string]*managementv1.FeatureValue {
+	if len(values) != 0 {
+		return values
+	}
+
+	result := make(map[string]*managementv1.FeatureValue, len(features))
+	for name, state := range features {
+		result[name] = &managementv1.FeatureValue{Kind: &managementv1.FeatureValue_BoolValue{BoolValue: state}}
+	}
+
+	return result
+}
+
+// BoolFeatures returns the bool valued features, the form understood by
+// servers that predate typed values.
+func BoolFeatures(values map[string]*managementv1.FeatureValue) map[string]bool {
+	result := make(map[string]bool, len(values))
+	for name, v := range values {
+		if kind, ok := v.GetKind().(*managementv1.FeatureValue_BoolValue); ok {
+			result[name] = kind.BoolValue
+		}
+	}
+
+	return result
+}
@@ -0,0 +1,125 @@
+package models
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var ErrInvalidAlertRule = errors.New("invalid alert rule")
+
+type AlertCondition int
+
+const (
+	AlertBatteryBelow AlertCondition = iota
+	AlertOffline
+	AlertLocationChanged
+	AlertFeatureDrift
+
+	AlertConditionCount
+)
+
+func (c AlertCondition) String() string {
+	switch c {
+	case AlertBatteryBelow:
+		return "battery_below"
+	case AlertOffline:
+		return "offline"
+	case AlertLocationChanged:
+		return "location_changed"
+	case AlertFeatureDrift:
+		return "feature_drift"
+	}
+
+	return "unknown"
+}
+
+func ParseAlertCondition(s string) (AlertCondition, error) {
+	for c := AlertCondition(0); c < AlertConditionCount; c++ {
+		if s == c.String() {
+			return c, nil
+		}
+	}
+
+	return 0, fmt.Errorf("%w: unknown condition %q", ErrInvalidAlertRule, s)
+}
+
+// AlertRule raises an alert for every device matching the condition:
+//   - battery_below: the battery is below Threshold percent;
+//   - offline: the device has not been seen for Duration;
+//   - location_changed: the device reported a location other than the
+//     previous one;
+//   - feature_drift: the reported value of Feature, or of any feature if it
+//     is empty, has differed from the desired one for Duration.
+//
+// Battery and location rules are evaluated on pings, offline and drift
+// rules by the periodic sweep. Rules from the server config are read only.
+type AlertRule struct {
+	Id         int64
+	Name       string
+	Condition  AlertCondition
+	Threshold  int
+	Duration   time.Duration
+	Feature    string
+	FromConfig bool
+}
+
+// Validate checks that the rule has the parameters its condition needs.
+// The returned error wraps ErrInvalidAlertRule.
+func (r AlertRule) Validate() error {
+	if r.Name == "" {
+		return fmt.Errorf("%w: name is required", ErrInvalidAlertRule)
+	}
+
+	switch r.Condition {
+	case AlertBatteryBelow:
+		if r.Threshold <= 0 || r.Threshold > 100 {
+			return fmt.Errorf("%w: %s: threshold must be in 1..100", ErrInvalidAlertRule, r.Name)
+		}
+	case AlertOffline:
+		if r.Duration <= 0 {
+			return fmt.Errorf("%w: %s: duration is required", ErrInvalidAlertRule, r.Name)
+		}
+	case AlertLocationChanged:
+	case AlertFeatureDrift:
+		if r.Duration < 0 {
+			return fmt.Errorf("%w: %s: negative duration", ErrInvalidAlertRule, r.Name)
+		}
+	default:
+		return fmt.Errorf("%w: %s: unknown condition", ErrInvalidAlertRule, r.Name)
+	}
+
+	if r.Feature != "" && r.Condition != AlertFeatureDrift {
+		return fmt.Errorf("%w: %s: feature applies to drift rules only", ErrInvalidAlertRule, r.Name)
+	}
+
+	return nil
+}
+
+// Alert is raised by a rule for a device. While the condition holds the
+// alert stays firing and repeated triggers only increase Count, once it is
+// resolved the next trigger raises a new alert. Acknowledging does not
+// resolve the alert.
+type Alert struct {
+	Id             int64
+	Rule           string
+	DeviceUuid     uuid.UUID
+	Message        string
+	Firing         bool
+	Count          int
+	FiredAt        time.Time
+	LastFiredAt    time.Time
+	ResolvedAt     time.Time
+	AcknowledgedAt time.Time
+}
+
+// AlertFilter selects alerts for listing. Empty fields do not restrict the
+// result. Scope limits the alerts to the devices of the scope.
+type AlertFilter struct {
+	FiringOnly bool
+	Device     uuid.UUID
+	Limit      int
+	Scope      *DeviceScope
+}
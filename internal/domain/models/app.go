@@ -0,0 +1,201 @@
+package models
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+var ErrInvalidApp = errors.New("invalid app")
+
+// AppRule is the rule of the app policy for a catalog app.
+type AppRule int
+
+const (
+	AppAllow AppRule = iota
+	AppBlock
+	AppRequire
+
+	AppRuleCount
+)
+
+func (r AppRule) String() string {
+	switch r {
+	case AppAllow:
+		return "allow"
+	case AppBlock:
+		return "block"
+	case AppRequire:
+		return "require"
+	}
+
+	return "unknown"
+}
+
+func (r AppRule) MarshalText() ([]byte, error) {
+	return []byte(r.String()), nil
+}
+
+// ParseAppRule accepts a rule name, case insensitive, or its number.
+func ParseAppRule(s string) (AppRule, error) {
+	for r := AppRule(0); r < AppRuleCount; r++ {
+		if strings.EqualFold(s, r.String()) {
+			return r, nil
+		}
+	}
+
+	n, err := strconv.Atoi(s)
+	if err != nil || n < 0 || AppRule(n) >= AppRuleCount {
+		return 0, fmt.Errorf("unknown app rule %q", s)
+	}
+
+	return AppRule(n), nil
+}
+
+// CatalogApp is an application known to the server. Id is the package name
+// or the bundle id, Version is the version a device installs when the app
+// is required. Rules are the profiles and the groups that have a rule for
+// the app.
+type CatalogApp struct {
+	Id      string
+	Name    string
+	Version string
+	Rules   []AppRuleTarget
+}
+
+// AppRuleTarget is a rule set on a profile or on a group, one of Profile
+// and Group is set.
+type AppRuleTarget struct {
+	Rule    AppRule
+	Profile string
+	Group   string
+}
+
+// ValidateCatalogApp checks the id and the description of the app.
+func ValidateCatalogApp(app CatalogApp) error {
+	if app.Id == "" || len(app.Id) > maxInventoryField || strings.ContainsAny(app.Id, " \t\n") {
+		return fmt.Errorf("%w: incorrect app id %q", ErrInvalidApp, app.Id)
+	}
+
+	if len(app.Name) > maxInventoryField || len(app.Version) > maxInventoryField {
+		return fmt.Errorf("%w: name or version of %q is too long", ErrInvalidApp, app.Id)
+	}
+
+	return nil
+}
+
+// AppPolicyEntry is the rule resolved for a device and the catalog app it
+// applies to.
+type AppPolicyEntry struct {
+	Id      string
+	Name    string
+	Version string
+	Rule    AppRule
+}
+
+// AppPolicy is the app policy resolved for a device, ordered by app id. The
+// rules come from the profiles and the groups of the device in the order
+// described for Group, the first rule for an app wins.
+//
+// Required apps have to be installed and blocked apps removed. If the policy
+// allows any app it is an allowlist: the apps that are neither allowed nor
+// required have to be removed as well.
+type AppPolicy []AppPolicyEntry
+
+// Allowlist reports whether the policy allows only the listed apps.
+func (p AppPolicy) Allowlist() bool {
+	return slices.ContainsFunc(p, func(e AppPolicyEntry) bool { return e.Rule == AppAllow })
+}
+
+// AppViolationKind tells how an installed app set breaks the app policy.
+type AppViolationKind int
+
+const (
+	AppMissing    AppViolationKind = iota // обязательное приложение не установлено
+	AppBlocked                            // установлено запрещенное приложение
+	AppNotAllowed                         // установлено приложение вне allowlist
+
+	AppViolationKindCount
+)
+
+func (k AppViolationKind) String() string {
+	switch k {
+	case AppMissing:
+		return "missing"
+	case AppBlocked:
+		return "blocked"
+	case AppNotAllowed:
+		return "not_allowed"
+	}
+
+	return "unknown"
+}
+
+func (k AppViolationKind) MarshalText() ([]byte, error) {
+	return []byte(k.String()), nil
+}
+
+// AppViolation is an app that breaks the policy. Version is the installed
+// version, empty for a missing app.
+type AppViolation struct {
+	AppId   string
+	Kind    AppViolationKind
+	Version string
+}
+
+// Violations returns the apps of the installed set that break the policy,
+// ordered by app id.
+func (p AppPolicy) Violations(installed []InstalledApp) []AppViolation {
+	rules := make(map[string]AppRule, len(p))
+	for _, e := range p {
+		rules[e.Id] = e.Rule
+	}
+
+	versions := make(map[string]string, len(installed))
+	for _, a := range installed {
+		versions[a.Id] = a.Version
+	}
+
+	var result []AppViolation
+	for _, e := range p {
+		if _, ok := versions[e.Id]; !ok && e.Rule == AppRequire {
+			result = append(result, AppViolation{AppId: e.Id, Kind: AppMissing})
+		}
+	}
+
+	allowlist := p.Allowlist()
+	for _, a := range installed {
+		rule, ok := rules[a.Id]
+		switch {
+		case ok && rule == AppBlock:
+			result = append(result, AppViolation{AppId: a.Id, Kind: AppBlocked, Version: a.Version})
+		case allowlist && !ok:
+			result = append(result, AppViolation{AppId: a.Id, Kind: AppNotAllowed, Version: a.Version})
+		}
+	}
+
+	slices.SortFunc(result, func(a, b AppViolation) int { return strings.Compare(a.AppId, b.AppId) })
+
+	return result
+}
+
+// AppCompliance is the app policy of a device checked against the apps of
+// its latest inventory. Reported is false if the device has not reported
+// its inventory yet, then there are no violations.
+type AppCompliance struct {
+	Policy           AppPolicy
+	Violations       []AppViolation
+	Reported         bool
+	InventoryVersion int64
+}
+
+// DeviceAppViolations lists the app policy violations of the device.
+type DeviceAppViolations struct {
+	DeviceId   int64
+	DeviceUuid uuid.UUID
+	Violations []AppViolation
+}
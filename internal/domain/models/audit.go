@@ -0,0 +1,89 @@
+package models
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// AuditRecord is an entry of the audit log, written for every Control call
+// that changes something. Payload is the request in JSON with the secrets
+// hidden, Previous and New describe the changed value where the call knows
+// it. Result is the gRPC status code of the call and Error its message.
+//
+// Records are chained: PrevHash is the Hash of the previous record, the
+// first record has an empty PrevHash. Changing, inserting or removing a
+// record breaks the chain from that record on.
+type AuditRecord struct {
+	Id       int64
+	Time     time.Time
+	Actor    string // пустой, если аутентификация отключена
+	Peer     string
+	Method   string
+	Devices  []uuid.UUID
+	Group    string
+	Payload  string
+	Previous string
+	New      string
+	Result   string
+	Error    string
+	PrevHash string
+	Hash     string
+}
+
+// ComputeHash returns the hash of the record chained to PrevHash. Every
+// field is prefixed with its length, so shifting text between fields
+// changes the hash.
+func (r AuditRecord) ComputeHash() string {
+	h := sha256.New()
+
+	write := func(s string) {
+		fmt.Fprintf(h, "%d:%s;", len(s), s)
+	}
+
+	write(r.PrevHash)
+	write(strconv.FormatInt(r.Id, 10))
+	write(strconv.FormatInt(r.Time.Unix(), 10))
+	write(r.Actor)
+	write(r.Peer)
+	write(r.Method)
+	write(strconv.Itoa(len(r.Devices)))
+	for _, d := range r.Devices {
+		write(d.String())
+	}
+	write(r.Group)
+	write(r.Payload)
+	write(r.Previous)
+	write(r.New)
+	write(r.Result)
+	write(r.Error)
+
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// AuditFilter selects audit records. Empty fields do not restrict the
+// result, From and To bound the record time inclusively.
+type AuditFilter struct {
+	Device uuid.UUID
+	Actor  string
+	From   time.Time
+	To     time.Time
+	Limit  int
+}
+
+// AuditVerification is the result of checking the audit chain. Records is
+// the number of records checked, BrokenAt the first record that does not
+// match the chain and Head the hash of the last record. Removing records
+// from the end of the log keeps the chain valid, comparing Head with a
+// previously saved one detects it.
+type AuditVerification struct {
+	Records  int64
+	Valid    bool
+	BrokenAt int64
+	Reason   string
+	Head     string
+}
@@ -0,0 +1,78 @@
+package models
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+func testAuditRecord() AuditRecord {
+	return AuditRecord{
+		Id:       2,
+		Time:     time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC),
+		Actor:    "alice",
+		Peer:     "10.0.0.1:5000",
+		Method:   "/control.Control/SetDeviceFeatureState",
+		Devices:  []uuid.UUID{uuid.MustParse("11111111-1111-1111-1111-111111111111"), uuid.MustParse("22222222-2222-2222-2222-222222222222")},
+		Group:    "oslo",
+		Payload:  `{"feature":"camera"}`,
+		Previous: "false",
+		New:      "true",
+		Result:   "OK",
+		PrevHash: "aaaa",
+	}
+}
+
+func TestAuditRecordComputeHash(t *testing.T) {
+	base := testAuditRecord()
+	hash := base.ComputeHash()
+
+	if again := testAuditRecord().ComputeHash(); again != hash {
+		t.Fatalf("ComputeHash() is not stable: %s != %s", again, hash)
+	}
+
+	// хеш не зависит от самого хеша и от долей секунды, которые не хранятся
+	same := testAuditRecord()
+	same.Hash = "bbbb"
+	same.Time = same.Time.Add(500 * time.Millisecond)
+	if got := same.ComputeHash(); got != hash {
+		t.Errorf("ComputeHash() changed with Hash and subsecond time")
+	}
+
+	tests := []struct {
+		name   string
+		change func(r *AuditRecord)
+	}{
+		{name: "prev hash", change: func(r *AuditRecord) { r.PrevHash = "aaab" }},
+		{name: "id", change: func(r *AuditRecord) { r.Id = 3 }},
+		{name: "time", change: func(r *AuditRecord) { r.Time = r.Time.Add(time.Second) }},
+		{name: "actor", change: func(r *AuditRecord) { r.Actor = "bob" }},
+		{name: "peer", change: func(r *AuditRecord) { r.Peer = "10.0.0.2:5000" }},
+		{name: "method", change: func(r *AuditRecord) { r.Method = "/control.Control/DeleteDevice" }},
+		{name: "device removed", change: func(r *AuditRecord) { r.Devices = r.Devices[:1] }},
+		{name: "devices reordered", change: func(r *AuditRecord) { r.Devices[0], r.Devices[1] = r.Devices[1], r.Devices[0] }},
+		{name: "group", change: func(r *AuditRecord) { r.Group = "bergen" }},
+		{name: "payload", change: func(r *AuditRecord) { r.Payload = `{"feature":"storage"}` }},
+		{name: "previous", change: func(r *AuditRecord) { r.Previous = "true" }},
+		{name: "new", change: func(r *AuditRecord) { r.New = "false" }},
+		{name: "result", change: func(r *AuditRecord) { r.Result = "PermissionDenied" }},
+		{name: "error", change: func(r *AuditRecord) { r.Error = "denied" }},
+		{name: "text shifted between fields", change: func(r *AuditRecord) { r.Previous, r.New = "falset", "rue" }},
+		{name: "text shifted into device list", change: func(r *AuditRecord) {
+			r.Method += "2:" + r.Devices[0].String()
+			r.Devices = r.Devices[1:]
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := testAuditRecord()
+			tt.change(&r)
+
+			if r.ComputeHash() == hash {
+				t.Errorf("ComputeHash() did not change")
+			}
+		})
+	}
+}
@@ -0,0 +1,66 @@
+package models
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+)
+
+var ErrInvalidBulkTarget = errors.New("invalid bulk target")
+
+// BulkTarget selects the devices of a bulk operation: the listed devices,
+// the members of the group and its subgroups or the devices matching the
+// selector. Exactly one of them is set. The group and the selector do not
+// select retired and wiped devices.
+type BulkTarget struct {
+	Devices  []uuid.UUID
+	Group    string
+	Selector Selector
+}
+
+// Validate checks that the target sets exactly one way to select devices.
+// The returned error wraps ErrInvalidBulkTarget.
+func (t BulkTarget) Validate() error {
+	set := 0
+	for _, ok := range []bool{len(t.Devices) != 0, t.Group != "", len(t.Selector) != 0} {
+		if ok {
+			set++
+		}
+	}
+
+	if set != 1 {
+		return fmt.Errorf("%w: exactly one of devices, group or selector is required", ErrInvalidBulkTarget)
+	}
+
+	return nil
+}
+
+// FeatureChange is the change of the desired feature value of a device.
+// Previous is nil if the device has no value of the feature yet,
+// Overridden reports that the previous value was set on the device itself
+// rather than inherited.
+type FeatureChange struct {
+	DeviceUuid uuid.UUID
+	Previous   *FeatureValue
+	Overridden bool
+}
+
+// BulkFeatureResult lists the devices of a bulk feature change: the changed
+// ones, the ones that already have the value set on them and the skipped
+// ones, which the feature does not apply to or which are retired or wiped.
+type BulkFeatureResult struct {
+	Changes   []FeatureChange
+	Unchanged []uuid.UUID
+	Skipped   []uuid.UUID
+}
+
+// Changed returns the uuids of the changed devices.
+func (r BulkFeatureResult) Changed() []uuid.UUID {
+	result := make([]uuid.UUID, 0, len(r.Changes))
+	for _, c := range r.Changes {
+		result = append(result, c.DeviceUuid)
+	}
+
+	return result
+}
@@ -0,0 +1,136 @@
+package models
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var ErrInvalidCommand = errors.New("invalid command")
+
+type CommandType int
+
+const (
+	CommandLock CommandType = iota
+	CommandWipe
+	CommandReboot
+	CommandLocate
+	CommandCustom
+
+	CommandTypeCount
+)
+
+func (t CommandType) String() string {
+	switch t {
+	case CommandLock:
+		return "lock"
+	case CommandWipe:
+		return "wipe"
+	case CommandReboot:
+		return "reboot"
+	case CommandLocate:
+		return "locate"
+	case CommandCustom:
+		return "custom"
+	}
+
+	return "unknown"
+}
+
+func ParseCommandType(s string) (CommandType, error) {
+	for t := CommandType(0); t < CommandTypeCount; t++ {
+		if s == t.String() {
+			return t, nil
+		}
+	}
+
+	return 0, fmt.Errorf("%w: unknown command type %q", ErrInvalidCommand, s)
+}
+
+// CommandStatus is the stage of a command. A command is queued, delivered
+// once the device fetches it, acknowledged when the device starts executing
+// it and ends succeeded or failed as reported by the device. A command that
+// does not end before its deadline expires.
+type CommandStatus int
+
+const (
+	CommandQueued CommandStatus = iota
+	CommandDelivered
+	CommandAcknowledged
+	CommandSucceeded
+	CommandFailed
+	CommandExpired
+
+	CommandStatusCount
+)
+
+func (s CommandStatus) String() string {
+	switch s {
+	case CommandQueued:
+		return "queued"
+	case CommandDelivered:
+		return "delivered"
+	case CommandAcknowledged:
+		return "acknowledged"
+	case CommandSucceeded:
+		return "succeeded"
+	case CommandFailed:
+		return "failed"
+	case CommandExpired:
+		return "expired"
+	}
+
+	return "unknown"
+}
+
+// Completed reports whether the status is final.
+func (s CommandStatus) Completed() bool {
+	return s == CommandSucceeded || s == CommandFailed || s == CommandExpired
+}
+
+// Reportable reports whether the device can move a command to the status.
+func (s CommandStatus) Reportable() bool {
+	return s == CommandAcknowledged || s == CommandSucceeded || s == CommandFailed
+}
+
+// Command is an action queued for a device. Payload carries the parameters
+// of the command, for example the lock message or the custom action, and is
+// passed to the device as is. Result is the output reported by the device.
+type Command struct {
+	Id             int64
+	DeviceUuid     uuid.UUID
+	Type           CommandType
+	Payload        string
+	Status         CommandStatus
+	Result         string
+	CreatedAt      time.Time
+	DeliveredAt    time.Time
+	AcknowledgedAt time.Time
+	CompletedAt    time.Time
+	ExpiresAt      time.Time
+}
+
+// Validate checks the type of the command, custom commands need a payload.
+// The returned error wraps ErrInvalidCommand.
+func (c Command) Validate() error {
+	if c.Type < 0 || c.Type >= CommandTypeCount {
+		return fmt.Errorf("%w: unknown command type", ErrInvalidCommand)
+	}
+
+	if c.Type == CommandCustom && c.Payload == "" {
+		return fmt.Errorf("%w: custom command requires a payload", ErrInvalidCommand)
+	}
+
+	return nil
+}
+
+// CommandFilter selects commands for listing. Empty fields do not restrict
+// the result. Scope limits the commands to the devices of the scope.
+type CommandFilter struct {
+	Device      uuid.UUID
+	PendingOnly bool
+	Limit       int
+	Scope       *DeviceScope
+}
@@ -0,0 +1,312 @@
+package models
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var ErrInvalidComplianceRule = errors.New("invalid compliance rule")
+
+type ComplianceCondition int
+
+const (
+	ComplianceMinOsVersion ComplianceCondition = iota
+	ComplianceFeatureState
+	ComplianceBatteryHealth
+	ComplianceCheckIn
+	ComplianceLabels
+
+	ComplianceConditionCount
+)
+
+func (c ComplianceCondition) String() string {
+	switch c {
+	case ComplianceMinOsVersion:
+		return "min_os_version"
+	case ComplianceFeatureState:
+		return "feature_state"
+	case ComplianceBatteryHealth:
+		return "battery_health"
+	case ComplianceCheckIn:
+		return "check_in"
+	case ComplianceLabels:
+		return "labels"
+	}
+
+	return "unknown"
+}
+
+func (c ComplianceCondition) MarshalText() ([]byte, error) {
+	return []byte(c.String()), nil
+}
+
+func ParseComplianceCondition(s string) (ComplianceCondition, error) {
+	for c := ComplianceCondition(0); c < ComplianceConditionCount; c++ {
+		if s == c.String() {
+			return c, nil
+		}
+	}
+
+	return 0, fmt.Errorf("%w: unknown condition %q", ErrInvalidComplianceRule, s)
+}
+
+// ComplianceRule is a requirement every active device has to meet:
+//   - min_os_version: the reported OS version is Version or newer, if OsName
+//     is set only devices running that OS are checked;
+//   - feature_state: the applied value of Feature, or of every feature if it
+//     is empty, has not differed from the desired one for longer than
+//     Duration;
+//   - battery_health: the battery charge is at least Threshold percent, the
+//     devices report no other battery health data;
+//   - check_in: the device has been seen within Duration;
+//   - labels: the device labels match Selector.
+//
+// Rules are evaluated on every ping, for all devices when the rules change
+// and by the periodic sweep, which catches the devices that stop checking
+// in.
+type ComplianceRule struct {
+	Id        int64
+	Name      string
+	Condition ComplianceCondition
+	OsName    string
+	Version   string
+	Feature   string
+	Threshold int
+	Duration  time.Duration
+	Selector  string
+}
+
+// Validate checks that the rule has the parameters its condition needs.
+// The returned error wraps ErrInvalidComplianceRule.
+func (r ComplianceRule) Validate() error {
+	if r.Name == "" {
+		return fmt.Errorf("%w: name is required", ErrInvalidComplianceRule)
+	}
+
+	switch r.Condition {
+	case ComplianceMinOsVersion:
+		if r.Version == "" {
+			return fmt.Errorf("%w: %s: version is required", ErrInvalidComplianceRule, r.Name)
+		}
+	case ComplianceFeatureState:
+		if r.Duration < 0 {
+			return fmt.Errorf("%w: %s: negative duration", ErrInvalidComplianceRule, r.Name)
+		}
+	case ComplianceBatteryHealth:
+		if r.Threshold <= 0 || r.Threshold > 100 {
+			return fmt.Errorf("%w: %s: threshold must be in 1..100", ErrInvalidComplianceRule, r.Name)
+		}
+	case ComplianceCheckIn:
+		if r.Duration <= 0 {
+			return fmt.Errorf("%w: %s: duration is required", ErrInvalidComplianceRule, r.Name)
+		}
+	case ComplianceLabels:
+		selector, err := ParseSelector(r.Selector)
+		if err != nil {
+			return fmt.Errorf("%w: %s: %s", ErrInvalidComplianceRule, r.Name, err)
+		}
+
+		if len(selector) == 0 {
+			return fmt.Errorf("%w: %s: selector is required", ErrInvalidComplianceRule, r.Name)
+		}
+	default:
+		return fmt.Errorf("%w: %s: unknown condition", ErrInvalidComplianceRule, r.Name)
+	}
+
+	if (r.OsName != "" || r.Version != "") && r.Condition != ComplianceMinOsVersion {
+		return fmt.Errorf("%w: %s: os name and version apply to min_os_version rules only", ErrInvalidComplianceRule, r.Name)
+	}
+
+	if r.Feature != "" && r.Condition != ComplianceFeatureState {
+		return fmt.Errorf("%w: %s: feature applies to feature_state rules only", ErrInvalidComplianceRule, r.Name)
+	}
+
+	if r.Selector != "" && r.Condition != ComplianceLabels {
+		return fmt.Errorf("%w: %s: selector applies to labels rules only", ErrInvalidComplianceRule, r.Name)
+	}
+
+	return nil
+}
+
+type ComplianceStatus int
+
+const (
+	ComplianceUnknown ComplianceStatus = iota // устройство еще не проверялось
+	ComplianceCompliant
+	ComplianceNonCompliant
+)
+
+func (s ComplianceStatus) String() string {
+	switch s {
+	case ComplianceUnknown:
+		return "unknown"
+	case ComplianceCompliant:
+		return "compliant"
+	case ComplianceNonCompliant:
+		return "non_compliant"
+	}
+
+	return "unknown"
+}
+
+func (s ComplianceStatus) MarshalText() ([]byte, error) {
+	return []byte(s.String()), nil
+}
+
+// ComplianceReason is a rule the device does not meet.
+type ComplianceReason struct {
+	Rule      string              `json:"rule"`
+	Condition ComplianceCondition `json:"condition"`
+	Message   string              `json:"message"`
+}
+
+// ComplianceInput is the device data the rules are evaluated against.
+// StatusReported is false until the first ping, Inventory is false until
+// the first inventory report. Drifts hold only the name and the drift start
+// of the drifted features.
+type ComplianceInput struct {
+	DeviceUuid     uuid.UUID
+	Battery        int
+	StatusReported bool
+	LastSeen       time.Time
+	Inventory      bool
+	OsName         string
+	OsVersion      string
+	Labels         map[string]string
+	Drifts         []FeatureDrift
+}
+
+// EvaluateCompliance checks the device against the rules. A device meeting
+// all rules is compliant, otherwise the reasons list the failed rules in
+// the order of the rules.
+func EvaluateCompliance(rules []ComplianceRule, input ComplianceInput, now time.Time) (ComplianceStatus, []ComplianceReason) {
+	var reasons []ComplianceReason
+	for _, rule := range rules {
+		message, ok := rule.check(input, now)
+		if !ok {
+			reasons = append(reasons, ComplianceReason{Rule: rule.Name, Condition: rule.Condition, Message: message})
+		}
+	}
+
+	if len(reasons) != 0 {
+		return ComplianceNonCompliant, reasons
+	}
+
+	return ComplianceCompliant, nil
+}
+
+// check reports whether the device meets the rule and explains why not.
+func (r ComplianceRule) check(input ComplianceInput, now time.Time) (string, bool) {
+	switch r.Condition {
+	case ComplianceMinOsVersion:
+		if !input.Inventory {
+			return "os version is not reported", false
+		}
+
+		if r.OsName != "" && !strings.EqualFold(r.OsName, input.OsName) {
+			return "", true
+		}
+
+		if CompareVersions(input.OsVersion, r.Version) < 0 {
+			return fmt.Sprintf("os version %q is older than %q", input.OsVersion, r.Version), false
+		}
+	case ComplianceFeatureState:
+		for _, d := range input.Drifts {
+			if r.Feature != "" && d.Feature != r.Feature {
+				continue
+			}
+
+			if drifted := now.Sub(d.Since); drifted >= r.Duration {
+				return fmt.Sprintf("feature %s differs from the desired value", d.Feature), false
+			}
+		}
+	case ComplianceBatteryHealth:
+		if !input.StatusReported {
+			return "battery is not reported", false
+		}
+
+		if input.Battery < r.Threshold {
+			return fmt.Sprintf("battery is below %d%%", r.Threshold), false
+		}
+	case ComplianceCheckIn:
+		if input.LastSeen.IsZero() {
+			return "device has never checked in", false
+		}
+
+		if now.Sub(input.LastSeen) > r.Duration {
+			return fmt.Sprintf("device has not checked in for %s", r.Duration), false
+		}
+	case ComplianceLabels:
+		selector, err := ParseSelector(r.Selector)
+		if err != nil || !selector.Matches(input.Labels) {
+			return fmt.Sprintf("labels do not match %q", r.Selector), false
+		}
+	}
+
+	return "", true
+}
+
+// CompareVersions compares dot separated versions part by part, numeric
+// parts as numbers and the others as strings. A missing part is less than
+// any present one, so 14 < 14.1.
+func CompareVersions(a, b string) int {
+	pa, pb := strings.Split(a, "."), strings.Split(b, ".")
+
+	for i := 0; i < len(pa) && i < len(pb); i++ {
+		na, errA := strconv.Atoi(pa[i])
+		nb, errB := strconv.Atoi(pb[i])
+
+		var c int
+		if errA == nil && errB == nil {
+			c = na - nb
+		} else {
+			c = strings.Compare(pa[i], pb[i])
+		}
+
+		if c != 0 {
+			return max(min(c, 1), -1)
+		}
+	}
+
+	return max(min(len(pa)-len(pb), 1), -1)
+}
+
+// DeviceCompliance is the result of the latest evaluation of the device.
+// Since is the time the status or the reasons last changed, both times are
+// zero for a device that has not been evaluated yet.
+type DeviceCompliance struct {
+	DeviceUuid  uuid.UUID
+	Status      ComplianceStatus
+	Reasons     []ComplianceReason
+	EvaluatedAt time.Time
+	Since       time.Time
+}
+
+// ComplianceRecord is an entry of the compliance history, a new entry is
+// recorded when the status or the reasons change.
+type ComplianceRecord struct {
+	Status     ComplianceStatus
+	Reasons    []ComplianceReason
+	RecordedAt time.Time
+}
+
+// ComplianceSummary counts the devices by compliance status and the devices
+// failing every rule.
+type ComplianceSummary struct {
+	Total        int
+	Compliant    int
+	NonCompliant int
+	Unknown      int
+	Rules        []RuleViolations
+}
+
+type RuleViolations struct {
+	Rule      string
+	Condition ComplianceCondition
+	Devices   int
+}
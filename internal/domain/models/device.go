@@ -1,6 +1,13 @@
 package models
 
-import "github.com/google/uuid"
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
 
 type DeviceType int
 
@@ -13,9 +20,15 @@ const (
 )
 
 type Device struct {
-	Id   int64
-	Uuid uuid.UUID
-	Type DeviceType
+	Id                 int64
+	Uuid               uuid.UUID
+	Type               DeviceType
+	Labels             map[string]string
+	LastSeen           time.Time
+	Presence           Presence
+	Lifecycle          Lifecycle
+	LifecycleChangedAt time.Time        // нулевое, если состояние не менялось после регистрации
+	Compliance         DeviceCompliance // заполняется только для сведений об одном устройстве
 }
 
 func (t DeviceType) String() string {
@@ -30,3 +43,23 @@ func (t DeviceType) String() string {
 
 	return "unknown"
 }
+
+func (t DeviceType) MarshalText() ([]byte, error) {
+	return []byte(t.String()), nil
+}
+
+// ParseDeviceType accepts a device type name, case insensitive, or its number.
+func ParseDeviceType(s string) (DeviceType, error) {
+	for t := DeviceType(0); t < DeviceTypeCount; t++ {
+		if strings.EqualFold(s, t.String()) {
+			return t, nil
+		}
+	}
+
+	n, err := strconv.Atoi(s)
+	if err != nil || n < 0 || DeviceType(n) >= DeviceTypeCount {
+		return 0, fmt.Errorf("unknown device type %q", s)
+	}
+
+	return DeviceType(n), nil
+}
@@ -1,19 +1,41 @@
 package models
 
-import "github.com/google/uuid"
+import (
+	"time"
 
-const (
-	Camera  = "camera"
-	Storage = "storage"
+	"github.com/google/uuid"
 )
 
-var DefaultFeatures = map[string]bool{
-	Camera:  false,
-	Storage: false,
+type DeviceFeatures struct {
+	DeviceId   int64
+	DeviceUuid uuid.UUID
+	Features   map[string]FeatureValue // желаемое состояние, заданное администратором
+	Reported   map[string]FeatureValue // состояние, которое применило устройство
+	Apps       AppPolicy               // политика приложений, входит в желаемое состояние
+	Revision   int64
 }
 
-type DeviceFeatures struct {
+// DeviceStateUpdate is pushed to a connected device. Full is set when
+// Features holds the whole state rather than a delta.
+type DeviceStateUpdate struct {
+	Features DeviceFeatures
+	Full     bool
+}
+
+// FeatureDrift describes a feature whose reported state differs from the
+// desired one. Reported is nil if the device has never reported the feature.
+type FeatureDrift struct {
+	Feature  string
+	Desired  FeatureValue
+	Reported *FeatureValue
+	Since    time.Time
+}
+
+// DeviceDrift lists the drifted features of the device. Since is the moment
+// the earliest of them went out of sync.
+type DeviceDrift struct {
 	DeviceId   int64
 	DeviceUuid uuid.UUID
-	Features   map[string]bool
+	Features   []FeatureDrift
+	Since      time.Time
 }
@@ -0,0 +1,15 @@
+package models
+
+// DeviceRevision describes the desired state revision of the device and the
+// revision the device reported as applied. Previous is the applied revision
+// before the report.
+type DeviceRevision struct {
+	DeviceId int64
+	Desired  int64
+	Applied  int64
+	Previous int64
+}
+
+func (r DeviceRevision) StateChanged() bool {
+	return r.Desired != r.Applied
+}
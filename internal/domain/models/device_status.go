@@ -1,10 +1,22 @@
 package models
 
-import "github.com/google/uuid"
+import (
+	"time"
 
+	"github.com/google/uuid"
+)
+
+// DeviceStatus is the last status reported by the device. The timestamps are
+// zero if the event has not happened yet, Presence is computed from LastSeen.
+// Reported is false until the device sends its first status.
 type DeviceStatus struct {
-	DeviceId   int64
-	DeviceUuid uuid.UUID
-	Location   string
-	Battery    int
+	DeviceId       int64
+	DeviceUuid     uuid.UUID
+	Location       string
+	Battery        int
+	Reported       bool
+	RegisteredAt   time.Time
+	LastSeen       time.Time
+	LastStateFetch time.Time
+	Presence       Presence
 }
@@ -0,0 +1,86 @@
+package models
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrInvalidCSR             = errors.New("invalid certificate signing request")
+	ErrInvalidEnrollmentToken = errors.New("invalid enrollment token")
+)
+
+// Certificate is a client certificate issued to a device by the enrollment
+// CA. The subject common name of the certificate is the device UUID. Pem is
+// set only for a certificate that has just been issued.
+type Certificate struct {
+	Serial     string
+	DeviceUuid uuid.UUID
+	NotBefore  time.Time
+	NotAfter   time.Time
+	IssuedAt   time.Time
+	RevokedAt  time.Time
+	Pem        []byte
+}
+
+// Revoked reports whether the certificate has been revoked.
+func (c Certificate) Revoked() bool {
+	return !c.RevokedAt.IsZero()
+}
+
+// EnrollmentToken allows devices to register. A token can be used MaxUses
+// times before it expires or is revoked. Its scope restricts the device types
+// that can register with it, empty DeviceTypes allow any type, and decides
+// the group, the profile and the labels a newly registered device gets.
+type EnrollmentToken struct {
+	Id          int64
+	Description string
+	MaxUses     int
+	Uses        int
+	DeviceTypes []DeviceType
+	Group       string
+	Profile     string
+	Labels      map[string]string
+	CreatedAt   time.Time
+	ExpiresAt   time.Time
+	LastUsedAt  time.Time
+	RevokedAt   time.Time
+}
+
+// Validate checks the usage limit, the device types and the labels of the
+// token. The returned error wraps ErrInvalidEnrollmentToken or
+// ErrInvalidLabel.
+func (t EnrollmentToken) Validate() error {
+	if t.MaxUses < 1 {
+		return fmt.Errorf("%w: max uses must be positive", ErrInvalidEnrollmentToken)
+	}
+
+	for _, dt := range t.DeviceTypes {
+		if dt < 0 || dt >= DeviceTypeCount {
+			return fmt.Errorf("%w: unknown device type %d", ErrInvalidEnrollmentToken, dt)
+		}
+	}
+
+	for key, value := range t.Labels {
+		if err := ValidateLabel(key, value); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AllowsType reports whether a device of the type can register with the
+// token.
+func (t EnrollmentToken) AllowsType(dt DeviceType) bool {
+	return len(t.DeviceTypes) == 0 || slices.Contains(t.DeviceTypes, dt)
+}
+
+// Usable reports whether the token can still be used at the moment.
+func (t EnrollmentToken) Usable(now time.Time) bool {
+	return t.RevokedAt.IsZero() && t.Uses < t.MaxUses && now.Before(t.ExpiresAt)
+}
@@ -0,0 +1,198 @@
+package models
+
+import (
+	"errors"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrEventSequenceExpired = errors.New("event sequence is no longer available")
+	ErrEventSequenceUnknown = errors.New("event sequence has not been reached")
+)
+
+type EventType string
+
+const (
+	EventDeviceRegistered   EventType = "device.registered"
+	EventDevicePinged       EventType = "device.pinged"
+	EventStatusChanged      EventType = "device.status_changed"
+	EventBatteryBandChanged EventType = "device.battery_band_changed"
+	EventPresenceChanged    EventType = "device.presence_changed"
+	EventDeviceOffline      EventType = "device.offline"
+	EventFeatureDesired     EventType = "device.feature_desired"
+	EventStateApplied       EventType = "device.state_applied"
+	EventLifecycleChanged   EventType = "device.lifecycle_changed"
+	EventInventoryChanged   EventType = "device.inventory_changed"
+	EventComplianceChanged  EventType = "device.compliance_changed"
+)
+
+var EventTypes = []EventType{
+	EventDeviceRegistered,
+	EventDevicePinged,
+	EventStatusChanged,
+	EventBatteryBandChanged,
+	EventPresenceChanged,
+	EventDeviceOffline,
+	EventFeatureDesired,
+	EventStateApplied,
+	EventLifecycleChanged,
+	EventInventoryChanged,
+	EventComplianceChanged,
+}
+
+// WebhookEventTypes are the event types delivered to webhooks, the frequent
+// events are available through the event feed only.
+var WebhookEventTypes = []EventType{
+	EventDeviceRegistered,
+	EventDeviceOffline,
+	EventBatteryBandChanged,
+	EventStateApplied,
+	EventLifecycleChanged,
+	EventInventoryChanged,
+	EventComplianceChanged,
+}
+
+// Event describes a change in the lifecycle of a device. Seq is assigned
+// when the event is published and grows with every event. Data holds one of
+// the *Data types below matching the event type and is encoded as a JSON
+// object.
+type Event struct {
+	Seq        int64     `json:"seq"`
+	Id         uuid.UUID `json:"id"`
+	Type       EventType `json:"type"`
+	DeviceUuid uuid.UUID `json:"device_id"`
+	Time       time.Time `json:"time"`
+	Data       any       `json:"data,omitempty"`
+}
+
+func NewEvent(t EventType, device_uuid uuid.UUID, data any) Event {
+	return Event{
+		Id:         uuid.New(),
+		Type:       t,
+		DeviceUuid: device_uuid,
+		Time:       time.Now().UTC(),
+		Data:       data,
+	}
+}
+
+type DeviceRegisteredData struct {
+	DeviceType DeviceType        `json:"device_type"`
+	Labels     map[string]string `json:"labels,omitempty"`
+	First      bool              `json:"first"` // устройство зарегистрировано впервые или заново после перевода в pending
+}
+
+type DevicePingedData struct {
+	Location string `json:"location"`
+	Battery  int    `json:"battery"`
+}
+
+type StatusChangedData struct {
+	Location         string `json:"location"`
+	Battery          int    `json:"battery"`
+	PreviousLocation string `json:"previous_location"`
+	PreviousBattery  int    `json:"previous_battery"`
+}
+
+type BatteryBandChangedData struct {
+	Battery      int         `json:"battery"`
+	Band         BatteryBand `json:"band"`
+	PreviousBand BatteryBand `json:"previous_band"`
+}
+
+type PresenceChangedData struct {
+	Presence         Presence `json:"presence"`
+	PreviousPresence Presence `json:"previous_presence"`
+}
+
+type DeviceOfflineData struct {
+	LastSeen time.Time `json:"last_seen"`
+}
+
+// FeatureDesiredData describes a change of the desired state. A change in
+// bulk, for example by a group or a catalog change, has no feature and value.
+type FeatureDesiredData struct {
+	Feature  string        `json:"feature,omitempty"`
+	Value    *FeatureValue `json:"value,omitempty"`
+	Revision int64         `json:"revision,omitempty"`
+}
+
+type StateAppliedData struct {
+	Revision        int64 `json:"revision"`
+	DesiredRevision int64 `json:"desired_revision"`
+}
+
+type LifecycleChangedData struct {
+	Lifecycle         Lifecycle `json:"lifecycle"`
+	PreviousLifecycle Lifecycle `json:"previous_lifecycle"`
+}
+
+// InventoryChangedData is the new version of the device inventory and its
+// differences from the previous one, empty for the first version.
+type InventoryChangedData struct {
+	Version int64             `json:"version"`
+	Changes []InventoryChange `json:"changes,omitempty"`
+}
+
+// ComplianceChangedData is the new compliance status of the device and the
+// rules it fails.
+type ComplianceChangedData struct {
+	Status         ComplianceStatus   `json:"status"`
+	PreviousStatus ComplianceStatus   `json:"previous_status"`
+	Reasons        []ComplianceReason `json:"reasons,omitempty"`
+}
+
+// EventFilter selects events for the feed. Nil Devices and empty Types do
+// not restrict the result, an empty non-nil Devices matches no events.
+type EventFilter struct {
+	Devices []uuid.UUID
+	Types   []EventType
+}
+
+func (f EventFilter) Match(event Event) bool {
+	if f.Devices != nil && !slices.Contains(f.Devices, event.DeviceUuid) {
+		return false
+	}
+
+	return len(f.Types) == 0 || slices.Contains(f.Types, event.Type)
+}
+
+type BatteryBand int
+
+const (
+	BatteryCritical BatteryBand = iota
+	BatteryLow
+	BatteryNormal
+)
+
+func (b BatteryBand) String() string {
+	switch b {
+	case BatteryCritical:
+		return "critical"
+	case BatteryLow:
+		return "low"
+	case BatteryNormal:
+		return "normal"
+	}
+
+	return "unknown"
+}
+
+func (b BatteryBand) MarshalText() ([]byte, error) {
+	return []byte(b.String()), nil
+}
+
+// BatteryBandOf returns the band of the battery charge in percent: critical
+// up to 10%, low up to 30%, normal above.
+func BatteryBandOf(battery int) BatteryBand {
+	switch {
+	case battery <= 10:
+		return BatteryCritical
+	case battery <= 30:
+		return BatteryLow
+	}
+
+	return BatteryNormal
+}
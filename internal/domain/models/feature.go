@@ -0,0 +1,80 @@
+package models
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+)
+
+var ErrInvalidFeatureValue = errors.New("invalid feature value")
+
+// Feature is an entry of the feature catalog. A feature without device types
+// applies to devices of every type. Min and Max bound int features, nil means
+// unbounded; EnumValues lists the values allowed for enum features.
+type Feature struct {
+	Id          int64
+	Name        string
+	Description string
+	Type        FeatureType
+	Default     FeatureValue
+	Min         *int64
+	Max         *int64
+	EnumValues  []string
+	DeviceTypes []DeviceType
+	Deprecated  bool
+}
+
+func (f Feature) AppliesTo(t DeviceType) bool {
+	return len(f.DeviceTypes) == 0 || slices.Contains(f.DeviceTypes, t)
+}
+
+// Validate checks that the value has the feature type and satisfies its
+// constraints. The returned error wraps ErrInvalidFeatureValue.
+func (f Feature) Validate(v FeatureValue) error {
+	if v.Type != f.Type {
+		return fmt.Errorf("%w: %s expects %s, got %s", ErrInvalidFeatureValue, f.Name, f.Type, v.Type)
+	}
+
+	switch f.Type {
+	case FeatureInt:
+		if f.Min != nil && v.Int < *f.Min {
+			return fmt.Errorf("%w: %s must be at least %d", ErrInvalidFeatureValue, f.Name, *f.Min)
+		}
+
+		if f.Max != nil && v.Int > *f.Max {
+			return fmt.Errorf("%w: %s must be at most %d", ErrInvalidFeatureValue, f.Name, *f.Max)
+		}
+	case FeatureEnum:
+		if !slices.Contains(f.EnumValues, v.Str) {
+			return fmt.Errorf("%w: %s must be one of %v", ErrInvalidFeatureValue, f.Name, f.EnumValues)
+		}
+	}
+
+	return nil
+}
+
+// ValidateDefinition checks that the feature constraints are consistent and
+// that the default value satisfies them.
+func (f Feature) ValidateDefinition() error {
+	if f.Type < 0 || f.Type >= FeatureTypeCount {
+		return fmt.Errorf("%w: unknown feature type", ErrInvalidFeatureValue)
+	}
+
+	if (f.Min != nil || f.Max != nil) && f.Type != FeatureInt {
+		return fmt.Errorf("%w: range applies to int features only", ErrInvalidFeatureValue)
+	}
+
+	if f.Min != nil && f.Max != nil && *f.Min > *f.Max {
+		return fmt.Errorf("%w: empty range", ErrInvalidFeatureValue)
+	}
+
+	if f.Type == FeatureEnum && len(f.EnumValues) == 0 {
+		return fmt.Errorf("%w: enum feature requires values", ErrInvalidFeatureValue)
+	}
+
+	if f.Type != FeatureEnum && len(f.EnumValues) != 0 {
+		return fmt.Errorf("%w: values apply to enum features only", ErrInvalidFeatureValue)
+	}
+
+	return f.Validate(f.Default)
+}
@@ -0,0 +1,148 @@
+package models
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+type FeatureType int
+
+const (
+	FeatureBool FeatureType = iota
+	FeatureInt
+	FeatureString
+	FeatureStringList
+	FeatureEnum
+
+	FeatureTypeCount
+)
+
+func (t FeatureType) String() string {
+	switch t {
+	case FeatureBool:
+		return "bool"
+	case FeatureInt:
+		return "int"
+	case FeatureString:
+		return "string"
+	case FeatureStringList:
+		return "list"
+	case FeatureEnum:
+		return "enum"
+	}
+
+	return "unknown"
+}
+
+// FeatureValue is a typed feature value. Only the field matching Type is
+// meaningful: Str holds both string and enum values.
+type FeatureValue struct {
+	Type FeatureType
+	Bool bool
+	Int  int64
+	Str  string
+	List []string
+}
+
+func BoolValue(b bool) FeatureValue {
+	return FeatureValue{Type: FeatureBool, Bool: b}
+}
+
+func (v FeatureValue) Equal(other FeatureValue) bool {
+	if v.Type != other.Type {
+		return false
+	}
+
+	switch v.Type {
+	case FeatureBool:
+		return v.Bool == other.Bool
+	case FeatureInt:
+		return v.Int == other.Int
+	case FeatureString, FeatureEnum:
+		return v.Str == other.Str
+	case FeatureStringList:
+		return slices.Equal(v.List, other.List)
+	}
+
+	return false
+}
+
+func (v FeatureValue) String() string {
+	switch v.Type {
+	case FeatureBool:
+		return strconv.FormatBool(v.Bool)
+	case FeatureInt:
+		return strconv.FormatInt(v.Int, 10)
+	case FeatureString, FeatureEnum:
+		return v.Str
+	case FeatureStringList:
+		return strings.Join(v.List, ",")
+	}
+
+	return ""
+}
+
+// MarshalJSON encodes the value as the JSON value of its type.
+func (v FeatureValue) MarshalJSON() ([]byte, error) {
+	switch v.Type {
+	case FeatureBool:
+		return json.Marshal(v.Bool)
+	case FeatureInt:
+		return json.Marshal(v.Int)
+	case FeatureStringList:
+		if v.List == nil {
+			return []byte("[]"), nil
+		}
+
+		return json.Marshal(v.List)
+	}
+
+	return json.Marshal(v.Str)
+}
+
+// ParseFeatureValue parses the textual form of a value of the given type, the
+// inverse of FeatureValue.String.
+func ParseFeatureValue(t FeatureType, s string) (FeatureValue, error) {
+	v := FeatureValue{Type: t}
+
+	switch t {
+	case FeatureBool:
+		b, err := strconv.ParseBool(s)
+		if err != nil {
+			return FeatureValue{}, fmt.Errorf("incorrect bool value %q", s)
+		}
+		v.Bool = b
+	case FeatureInt:
+		n, err := strconv.ParseInt(s, 10, 64)
+		if err != nil {
+			return FeatureValue{}, fmt.Errorf("incorrect int value %q", s)
+		}
+		v.Int = n
+	case FeatureString, FeatureEnum:
+		v.Str = s
+	case FeatureStringList:
+		if s != "" {
+			v.List = strings.Split(s, ",")
+		}
+	default:
+		return FeatureValue{}, fmt.Errorf("unknown feature type %d", t)
+	}
+
+	return v, nil
+}
+
+// BoolFeatures returns the bool valued features, the form understood by
+// devices that predate typed values.
+func BoolFeatures(values map[string]FeatureValue) map[string]bool {
+	result := make(map[string]bool, len(values))
+	for name, v := range values {
+		if v.Type == FeatureBool {
+			result[name] = v.Bool
+		}
+	}
+
+	return result
+}
@@ -0,0 +1,24 @@
+package models
+
+import "github.com/google/uuid"
+
+// Group is a named set of devices with feature values and profiles applied
+// to all of its members. Groups form a tree: the values of a group also apply
+// to the devices of its subgroups.
+//
+// The desired value of a device feature is resolved in the following order:
+//  1. the value set on the device itself;
+//  2. the profiles assigned to the device, ordered by name;
+//  3. the groups of the device, nearest first: the groups the device belongs
+//     to directly, then their parents and so on, groups at the same distance
+//     are ordered by name. The values set on a group take precedence over
+//     the profiles assigned to it, which are ordered by name;
+//  4. the catalog default.
+type Group struct {
+	Id       int64
+	Name     string
+	Parent   string
+	Devices  []uuid.UUID
+	Features map[string]FeatureValue
+	Profiles []string
+}
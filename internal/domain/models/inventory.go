@@ -0,0 +1,222 @@
+package models
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var ErrInvalidInventory = errors.New("invalid inventory")
+
+const (
+	maxInventoryField      = 256
+	maxInventoryInterfaces = 64
+	maxInventoryAddresses  = 16
+	maxInventoryApps       = 2048
+)
+
+// Inventory is the hardware and software of a device as reported by the
+// device.
+type Inventory struct {
+	OsName       string             `json:"os_name"`
+	OsVersion    string             `json:"os_version"`
+	Model        string             `json:"model"`
+	Serial       string             `json:"serial"`
+	StorageBytes int64              `json:"storage_bytes"`
+	Interfaces   []NetworkInterface `json:"interfaces,omitempty"`
+	Apps         []InstalledApp     `json:"apps,omitempty"`
+}
+
+type NetworkInterface struct {
+	Name      string   `json:"name"`
+	Mac       string   `json:"mac,omitempty"`
+	Addresses []string `json:"addresses,omitempty"`
+}
+
+// InstalledApp is an application installed on the device, Id is the package
+// name or the bundle id.
+type InstalledApp struct {
+	Id      string `json:"id"`
+	Name    string `json:"name,omitempty"`
+	Version string `json:"version,omitempty"`
+}
+
+// Normalize sorts the interfaces, their addresses and the apps, so that
+// the same inventory compares equal regardless of the reported order.
+func (i *Inventory) Normalize() {
+	slices.SortFunc(i.Interfaces, func(a, b NetworkInterface) int { return strings.Compare(a.Name, b.Name) })
+	for _, n := range i.Interfaces {
+		slices.Sort(n.Addresses)
+	}
+
+	slices.SortFunc(i.Apps, func(a, b InstalledApp) int { return strings.Compare(a.Id, b.Id) })
+}
+
+// ValidateInventory checks the limits of the reported inventory, interface
+// names and app ids must be unique.
+func ValidateInventory(i Inventory) error {
+	for name, value := range map[string]string{
+		"os name":    i.OsName,
+		"os version": i.OsVersion,
+		"model":      i.Model,
+		"serial":     i.Serial,
+	} {
+		if len(value) > maxInventoryField {
+			return fmt.Errorf("%w: %s is longer than %d bytes", ErrInvalidInventory, name, maxInventoryField)
+		}
+	}
+
+	if i.StorageBytes < 0 {
+		return fmt.Errorf("%w: storage capacity is negative", ErrInvalidInventory)
+	}
+
+	if len(i.Interfaces) > maxInventoryInterfaces {
+		return fmt.Errorf("%w: more than %d network interfaces", ErrInvalidInventory, maxInventoryInterfaces)
+	}
+
+	names := make(map[string]bool, len(i.Interfaces))
+	for _, n := range i.Interfaces {
+		if n.Name == "" || len(n.Name) > maxInventoryField || len(n.Mac) > maxInventoryField {
+			return fmt.Errorf("%w: incorrect network interface %q", ErrInvalidInventory, n.Name)
+		}
+
+		if names[n.Name] {
+			return fmt.Errorf("%w: duplicate network interface %q", ErrInvalidInventory, n.Name)
+		}
+		names[n.Name] = true
+
+		if len(n.Addresses) > maxInventoryAddresses {
+			return fmt.Errorf("%w: more than %d addresses of %q", ErrInvalidInventory, maxInventoryAddresses, n.Name)
+		}
+	}
+
+	if len(i.Apps) > maxInventoryApps {
+		return fmt.Errorf("%w: more than %d apps", ErrInvalidInventory, maxInventoryApps)
+	}
+
+	ids := make(map[string]bool, len(i.Apps))
+	for _, a := range i.Apps {
+		if a.Id == "" || len(a.Id) > maxInventoryField || len(a.Name) > maxInventoryField || len(a.Version) > maxInventoryField {
+			return fmt.Errorf("%w: incorrect app %q", ErrInvalidInventory, a.Id)
+		}
+
+		if ids[a.Id] {
+			return fmt.Errorf("%w: duplicate app %q", ErrInvalidInventory, a.Id)
+		}
+		ids[a.Id] = true
+	}
+
+	return nil
+}
+
+// InventoryChange is a difference between two inventory snapshots. Field
+// is os_name, os_version, model, serial, storage_bytes, interface:$name or
+// app:$id; an empty Previous means the interface or the app was added, an
+// empty Current that it was removed.
+type InventoryChange struct {
+	Field    string `json:"field"`
+	Previous string `json:"previous,omitempty"`
+	Current  string `json:"current,omitempty"`
+}
+
+// InventoryChanges returns the differences between the normalized
+// inventories: the device fields first, then the interfaces and the apps
+// ordered by name.
+func InventoryChanges(previous Inventory, current Inventory) []InventoryChange {
+	var result []InventoryChange
+
+	compare := func(field string, a, b string) {
+		if a != b {
+			result = append(result, InventoryChange{Field: field, Previous: a, Current: b})
+		}
+	}
+
+	compare("os_name", previous.OsName, current.OsName)
+	compare("os_version", previous.OsVersion, current.OsVersion)
+	compare("model", previous.Model, current.Model)
+	compare("serial", previous.Serial, current.Serial)
+	compare("storage_bytes", strconv.FormatInt(previous.StorageBytes, 10), strconv.FormatInt(current.StorageBytes, 10))
+
+	interfaces := make(map[string][2]string)
+	for _, n := range previous.Interfaces {
+		interfaces[n.Name] = [2]string{n.String(), ""}
+	}
+	for _, n := range current.Interfaces {
+		v := interfaces[n.Name]
+		v[1] = n.String()
+		interfaces[n.Name] = v
+	}
+	for _, name := range sortedKeys(interfaces) {
+		compare("interface:"+name, interfaces[name][0], interfaces[name][1])
+	}
+
+	apps := make(map[string][2]string)
+	for _, a := range previous.Apps {
+		apps[a.Id] = [2]string{a.String(), ""}
+	}
+	for _, a := range current.Apps {
+		v := apps[a.Id]
+		v[1] = a.String()
+		apps[a.Id] = v
+	}
+	for _, id := range sortedKeys(apps) {
+		compare("app:"+id, apps[id][0], apps[id][1])
+	}
+
+	return result
+}
+
+// String describes the interface by its name, MAC and addresses.
+func (n NetworkInterface) String() string {
+	parts := []string{n.Name}
+	if n.Mac != "" {
+		parts = append(parts, n.Mac)
+	}
+	if len(n.Addresses) != 0 {
+		parts = append(parts, strings.Join(n.Addresses, ","))
+	}
+
+	return strings.Join(parts, " ")
+}
+
+// String describes the installed app by its id, version and name.
+func (a InstalledApp) String() string {
+	s := a.Id
+	if a.Version != "" {
+		s += " " + a.Version
+	}
+	if a.Name != "" {
+		s += " (" + a.Name + ")"
+	}
+
+	return s
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	slices.Sort(keys)
+
+	return keys
+}
+
+// InventorySnapshot is a version of the device inventory. A new version is
+// stored only when the reported inventory differs from the previous one,
+// Changes are the differences from it, empty for the first version.
+// ConfirmedAt is the last time the device reported this inventory.
+type InventorySnapshot struct {
+	DeviceUuid    uuid.UUID
+	Version       int64
+	LatestVersion int64
+	Inventory     Inventory
+	Changes       []InventoryChange
+	ReportedAt    time.Time
+	ConfirmedAt   time.Time
+}
@@ -0,0 +1,101 @@
+package models
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// Lifecycle is the administrative state of a device. A device is enrolled
+// when it registers. Only an enrolled device is served by the device API:
+// a suspended device is refused until it is resumed, a pending device has
+// to enroll again with an enrollment token, retired and wiped devices are
+// decommissioned and kept for their history only.
+type Lifecycle int
+
+const (
+	LifecyclePending Lifecycle = iota
+	LifecycleEnrolled
+	LifecycleSuspended
+	LifecycleRetired
+	LifecycleWiped
+
+	LifecycleCount
+)
+
+// lifecycleChanges are the changes an operator can make, the move from
+// pending to enrolled is made by the device when it enrolls again.
+var lifecycleChanges = map[Lifecycle][]Lifecycle{
+	LifecyclePending:   {LifecycleRetired, LifecycleWiped},
+	LifecycleEnrolled:  {LifecyclePending, LifecycleSuspended, LifecycleRetired, LifecycleWiped},
+	LifecycleSuspended: {LifecyclePending, LifecycleEnrolled, LifecycleRetired, LifecycleWiped},
+	LifecycleRetired:   {LifecyclePending, LifecycleWiped},
+	LifecycleWiped:     {LifecyclePending, LifecycleRetired},
+}
+
+func (l Lifecycle) String() string {
+	switch l {
+	case LifecyclePending:
+		return "pending"
+	case LifecycleEnrolled:
+		return "enrolled"
+	case LifecycleSuspended:
+		return "suspended"
+	case LifecycleRetired:
+		return "retired"
+	case LifecycleWiped:
+		return "wiped"
+	}
+
+	return "unknown"
+}
+
+func (l Lifecycle) MarshalText() ([]byte, error) {
+	return []byte(l.String()), nil
+}
+
+// Decommissioned reports whether the device has been taken out of service.
+// Decommissioned devices are hidden from the device lists unless the filter
+// asks for them.
+func (l Lifecycle) Decommissioned() bool {
+	return l == LifecycleRetired || l == LifecycleWiped
+}
+
+// CanChange reports whether an operator can move a device from l to the
+// state. Moving a device to the state it is in changes nothing and is
+// allowed.
+func (l Lifecycle) CanChange(to Lifecycle) bool {
+	return l == to || slices.Contains(lifecycleChanges[l], to)
+}
+
+// Revokes reports whether moving a device to the state revokes its
+// identity: the certificates and the commands it has not completed.
+func (l Lifecycle) Revokes() bool {
+	return l == LifecyclePending || l.Decommissioned()
+}
+
+// ParseLifecycle accepts a lifecycle state name, case insensitive, or its
+// number.
+func ParseLifecycle(s string) (Lifecycle, error) {
+	for l := Lifecycle(0); l < LifecycleCount; l++ {
+		if strings.EqualFold(s, l.String()) {
+			return l, nil
+		}
+	}
+
+	n, err := strconv.Atoi(s)
+	if err != nil || n < 0 || Lifecycle(n) >= LifecycleCount {
+		return 0, fmt.Errorf("unknown lifecycle state %q", s)
+	}
+
+	return Lifecycle(n), nil
+}
+
+// LifecycleChange is the result of moving a device between states.
+// Certificates is the number of certificates revoked by the change.
+type LifecycleChange struct {
+	Previous     Lifecycle
+	Current      Lifecycle
+	Certificates int
+}
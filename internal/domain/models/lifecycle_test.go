@@ -0,0 +1,57 @@
+package models
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestLifecycleCanChange(t *testing.T) {
+	// в enrolled из pending устройство переходит само, при новой регистрации
+	allowed := map[Lifecycle][]Lifecycle{
+		LifecyclePending:   {LifecyclePending, LifecycleRetired, LifecycleWiped},
+		LifecycleEnrolled:  {LifecyclePending, LifecycleEnrolled, LifecycleSuspended, LifecycleRetired, LifecycleWiped},
+		LifecycleSuspended: {LifecyclePending, LifecycleEnrolled, LifecycleSuspended, LifecycleRetired, LifecycleWiped},
+		LifecycleRetired:   {LifecyclePending, LifecycleRetired, LifecycleWiped},
+		LifecycleWiped:     {LifecyclePending, LifecycleRetired, LifecycleWiped},
+	}
+
+	for from := Lifecycle(0); from < LifecycleCount; from++ {
+		for to := Lifecycle(0); to < LifecycleCount; to++ {
+			want := slices.Contains(allowed[from], to)
+
+			if got := from.CanChange(to); got != want {
+				t.Errorf("%s.CanChange(%s) = %t, want %t", from, to, got, want)
+			}
+		}
+	}
+}
+
+func TestParseLifecycle(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    Lifecycle
+		wantErr bool
+	}{
+		{in: "pending", want: LifecyclePending},
+		{in: "Enrolled", want: LifecycleEnrolled},
+		{in: "RETIRED", want: LifecycleRetired},
+		{in: "4", want: LifecycleWiped},
+		{in: "5", wantErr: true},
+		{in: "-1", wantErr: true},
+		{in: "deleted", wantErr: true},
+		{in: "", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			got, err := ParseLifecycle(tt.in)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseLifecycle() error = %v, want error %t", err, tt.wantErr)
+			}
+
+			if err == nil && got != tt.want {
+				t.Errorf("ParseLifecycle() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
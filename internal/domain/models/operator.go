@@ -0,0 +1,206 @@
+package models
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrInvalidOperator = errors.New("invalid operator")
+	ErrInvalidRole     = errors.New("invalid role")
+)
+
+// Permission allows a group of Control calls. Read allows the calls that do
+// not change anything, features allows changing the feature states and the
+// labels of devices, commands allows queueing device commands and admin
+// allows everything else: the catalog, groups, profiles, alerting,
+// enrollment and operators.
+type Permission int
+
+const (
+	PermissionRead Permission = iota
+	PermissionFeatures
+	PermissionCommands
+	PermissionAdmin
+
+	PermissionCount
+)
+
+func (p Permission) String() string {
+	switch p {
+	case PermissionRead:
+		return "read"
+	case PermissionFeatures:
+		return "features"
+	case PermissionCommands:
+		return "commands"
+	case PermissionAdmin:
+		return "admin"
+	}
+
+	return "unknown"
+}
+
+func ParsePermission(s string) (Permission, error) {
+	for p := Permission(0); p < PermissionCount; p++ {
+		if s == p.String() {
+			return p, nil
+		}
+	}
+
+	return 0, fmt.Errorf("%w: unknown permission %q", ErrInvalidRole, s)
+}
+
+// Role grants permissions to operators. A role without groups and selector
+// grants them for the whole fleet. A scoped role grants them only for the
+// calls on devices that are members of one of the groups, subgroups
+// included, or match the label selector, and on the groups themselves.
+type Role struct {
+	Name        string
+	Description string
+	Permissions []Permission
+	Groups      []string
+	Selector    string
+	Builtin     bool
+}
+
+// Scoped reports whether the role is limited to a part of the fleet.
+func (r Role) Scoped() bool {
+	return len(r.Groups) != 0 || r.Selector != ""
+}
+
+// Allows reports whether the role grants the permission.
+func (r Role) Allows(p Permission) bool {
+	return slices.Contains(r.Permissions, p)
+}
+
+// Validate checks the name, the permissions and the selector of the role.
+// The returned error wraps ErrInvalidRole or ErrInvalidSelector.
+func (r Role) Validate() error {
+	if r.Name == "" {
+		return fmt.Errorf("%w: name is required", ErrInvalidRole)
+	}
+
+	if len(r.Permissions) == 0 {
+		return fmt.Errorf("%w: role grants no permissions", ErrInvalidRole)
+	}
+
+	for _, p := range r.Permissions {
+		if p < 0 || p >= PermissionCount {
+			return fmt.Errorf("%w: unknown permission %d", ErrInvalidRole, p)
+		}
+	}
+
+	if _, err := ParseSelector(r.Selector); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// BuiltinRoles are the roles every server has, they can not be changed.
+var BuiltinRoles = []Role{
+	{
+		Name:        "read-only",
+		Description: "read access to the whole fleet",
+		Permissions: []Permission{PermissionRead},
+		Builtin:     true,
+	},
+	{
+		Name:        "feature-admin",
+		Description: "manage feature states, labels and commands of the whole fleet",
+		Permissions: []Permission{PermissionRead, PermissionFeatures, PermissionCommands},
+		Builtin:     true,
+	},
+	{
+		Name:        "full-admin",
+		Description: "full access",
+		Permissions: []Permission{PermissionRead, PermissionFeatures, PermissionCommands, PermissionAdmin},
+		Builtin:     true,
+	},
+}
+
+// BuiltinRole returns the built-in role with the name.
+func BuiltinRole(name string) (Role, bool) {
+	i := slices.IndexFunc(BuiltinRoles, func(r Role) bool { return r.Name == name })
+	if i < 0 {
+		return Role{}, false
+	}
+
+	return BuiltinRoles[i], true
+}
+
+// Operator is a user of the Control API. An operator authenticates with its
+// API token or with a client certificate whose subject common name is the
+// operator name. Operators defined in the server config can not be changed
+// through the API. TokenHash is the stored form of the API token (see
+// TokenHash), an operator without it authenticates only with a certificate.
+type Operator struct {
+	Name       string
+	Roles      []string
+	TokenHash  string
+	FromConfig bool
+	CreatedAt  time.Time
+}
+
+// Validate checks the operator name. A device certificate must not
+// authenticate an operator, so names that are UUIDs are rejected. The
+// returned error wraps ErrInvalidOperator.
+func (o Operator) Validate() error {
+	if o.Name == "" {
+		return fmt.Errorf("%w: name is required", ErrInvalidOperator)
+	}
+
+	if _, err := uuid.Parse(o.Name); err == nil {
+		return fmt.Errorf("%w: name must not be a uuid", ErrInvalidOperator)
+	}
+
+	return nil
+}
+
+// AccessTarget is the part of the fleet a Control call works on. An empty
+// target means the call is not limited to particular devices or groups.
+// Catalog marks the calls that do not touch devices at all, such as reading
+// the feature catalog, any role granting the permission allows them. List
+// marks the calls listing devices, a scoped role allows them and the list is
+// limited to its scope, see DeviceScope.
+type AccessTarget struct {
+	Devices []uuid.UUID
+	Group   string
+	Catalog bool
+	List    bool
+}
+
+func (t AccessTarget) Empty() bool {
+	return len(t.Devices) == 0 && t.Group == ""
+}
+
+// DeviceScope is the part of the fleet the scoped roles of an operator give
+// access to: the members of the groups, subgroups included, and the devices
+// matching one of the selectors. An empty scope contains no devices.
+type DeviceScope struct {
+	Groups    []string
+	Selectors []Selector
+}
+
+// Add extends the scope with the scope of the role.
+func (s *DeviceScope) Add(role Role) error {
+	s.Groups = append(s.Groups, role.Groups...)
+
+	if role.Selector == "" {
+		return nil
+	}
+
+	selector, err := ParseSelector(role.Selector)
+	if err != nil {
+		return err
+	}
+
+	s.Selectors = append(s.Selectors, selector)
+
+	return nil
+}
@@ -0,0 +1,85 @@
+package models
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+var ErrInvalidOrder = errors.New("invalid order")
+
+const (
+	DefaultPageSize = 100
+	MaxPageSize     = 1000
+)
+
+type DeviceOrderField int
+
+const (
+	OrderByRegistration DeviceOrderField = iota
+	OrderByUuid
+	OrderByType
+)
+
+var deviceOrderFields = map[DeviceOrderField]string{
+	OrderByRegistration: "registered",
+	OrderByUuid:         "uuid",
+	OrderByType:         "type",
+}
+
+// DeviceOrder sets the order of device lists, devices with equal field
+// values keep the registration order.
+type DeviceOrder struct {
+	Field DeviceOrderField
+	Desc  bool
+}
+
+func (o DeviceOrder) String() string {
+	if o.Desc {
+		return deviceOrderFields[o.Field] + " desc"
+	}
+
+	return deviceOrderFields[o.Field]
+}
+
+// ParseDeviceOrder parses a field name optionally followed by asc or desc,
+// an empty string stands for the registration order. The returned error
+// wraps ErrInvalidOrder.
+func ParseDeviceOrder(s string) (DeviceOrder, error) {
+	parts := strings.Fields(s)
+	if len(parts) == 0 {
+		return DeviceOrder{}, nil
+	}
+
+	if len(parts) > 2 {
+		return DeviceOrder{}, fmt.Errorf("%w: %q", ErrInvalidOrder, s)
+	}
+
+	var result DeviceOrder
+	if len(parts) == 2 {
+		switch strings.ToLower(parts[1]) {
+		case "asc":
+		case "desc":
+			result.Desc = true
+		default:
+			return DeviceOrder{}, fmt.Errorf("%w: %q", ErrInvalidOrder, s)
+		}
+	}
+
+	for field, name := range deviceOrderFields {
+		if strings.EqualFold(parts[0], name) {
+			result.Field = field
+			return result, nil
+		}
+	}
+
+	return DeviceOrder{}, fmt.Errorf("%w: unknown field %q", ErrInvalidOrder, parts[0])
+}
+
+// Page selects a part of a device list. Token is empty for the first page
+// and is taken from the previous page otherwise.
+type Page struct {
+	Size  int
+	Token string
+	Order DeviceOrder
+}
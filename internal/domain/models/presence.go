@@ -0,0 +1,51 @@
+package models
+
+import "time"
+
+type Presence int
+
+const (
+	PresenceOffline Presence = iota
+	PresenceStale
+	PresenceOnline
+)
+
+func (p Presence) String() string {
+	switch p {
+	case PresenceOnline:
+		return "online"
+	case PresenceStale:
+		return "stale"
+	case PresenceOffline:
+		return "offline"
+	}
+
+	return "unknown"
+}
+
+func (p Presence) MarshalText() ([]byte, error) {
+	return []byte(p.String()), nil
+}
+
+// PresencePolicy derives the presence of a device from the time it was last
+// seen. A device is stale once it has been silent for StaleAfter and offline
+// after OfflineAfter; a device that has never been seen is offline.
+type PresencePolicy struct {
+	StaleAfter   time.Duration
+	OfflineAfter time.Duration
+}
+
+func (p PresencePolicy) Presence(lastSeen time.Time, now time.Time) Presence {
+	if lastSeen.IsZero() {
+		return PresenceOffline
+	}
+
+	switch silent := now.Sub(lastSeen); {
+	case silent >= p.OfflineAfter:
+		return PresenceOffline
+	case silent >= p.StaleAfter:
+		return PresenceStale
+	}
+
+	return PresenceOnline
+}
@@ -0,0 +1,16 @@
+package models
+
+import "github.com/google/uuid"
+
+// Profile is a named bundle of feature values assigned to devices and
+// groups. Version is incremented each time the bundle changes. See Group for
+// the precedence of profiles over other sources of feature values.
+type Profile struct {
+	Id          int64
+	Name        string
+	Description string
+	Version     int64
+	Features    map[string]FeatureValue
+	Devices     []uuid.UUID
+	Groups      []string
+}
@@ -0,0 +1,197 @@
+package models
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+)
+
+var (
+	ErrInvalidLabel    = errors.New("invalid label")
+	ErrInvalidSelector = errors.New("invalid selector")
+)
+
+// Ключи и значения меток не могут содержать символы, используемые
+// в синтаксисе селекторов
+var labelRe = regexp.MustCompile(`^[A-Za-z0-9]([A-Za-z0-9._/-]*[A-Za-z0-9])?$`)
+
+// ValidateLabel checks the label key and value, an empty value is allowed.
+// The returned error wraps ErrInvalidLabel.
+func ValidateLabel(key, value string) error {
+	if !labelRe.MatchString(key) {
+		return fmt.Errorf("%w: incorrect key %q", ErrInvalidLabel, key)
+	}
+
+	if value != "" && !labelRe.MatchString(value) {
+		return fmt.Errorf("%w: incorrect value %q of %s", ErrInvalidLabel, value, key)
+	}
+
+	return nil
+}
+
+type SelectorOp int
+
+const (
+	SelectorEquals SelectorOp = iota
+	SelectorNotEquals
+	SelectorIn
+	SelectorNotIn
+	SelectorExists
+	SelectorNotExists
+)
+
+// Requirement is a single condition on a device label. Values holds one value
+// for the equality operators and the value set for in and notin.
+type Requirement struct {
+	Key    string
+	Op     SelectorOp
+	Values []string
+}
+
+// Selector matches devices whose labels satisfy all of its requirements, an
+// empty selector matches every device.
+type Selector []Requirement
+
+var setRe = regexp.MustCompile(`^(\S+)\s+(in|notin)\s*\((.*)\)$`)
+
+// ParseSelector parses a comma separated list of requirements:
+//
+//	key=value, key==value, key!=value
+//	key in (value1,value2), key notin (value1,value2)
+//	key, !key
+//
+// A label missing on the device satisfies != and notin. The returned error
+// wraps ErrInvalidSelector.
+func ParseSelector(s string) (Selector, error) {
+	if strings.TrimSpace(s) == "" {
+		return nil, nil
+	}
+
+	var result Selector
+	for _, part := range splitRequirements(s) {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			return nil, fmt.Errorf("%w: empty requirement", ErrInvalidSelector)
+		}
+
+		r, err := parseRequirement(part)
+		if err != nil {
+			return nil, err
+		}
+
+		result = append(result, r)
+	}
+
+	return result, nil
+}
+
+func parseRequirement(s string) (Requirement, error) {
+	var r Requirement
+
+	switch m := setRe.FindStringSubmatch(s); {
+	case m != nil:
+		r.Key, r.Op = m[1], SelectorIn
+		if m[2] == "notin" {
+			r.Op = SelectorNotIn
+		}
+
+		if strings.TrimSpace(m[3]) == "" {
+			return Requirement{}, fmt.Errorf("%w: %q: empty value set", ErrInvalidSelector, s)
+		}
+
+		for _, v := range strings.Split(m[3], ",") {
+			r.Values = append(r.Values, strings.TrimSpace(v))
+		}
+	case strings.Contains(s, "!="):
+		key, value, _ := strings.Cut(s, "!=")
+		r.Key, r.Op, r.Values = strings.TrimSpace(key), SelectorNotEquals, []string{strings.TrimSpace(value)}
+	case strings.Contains(s, "="):
+		key, value, _ := strings.Cut(s, "=")
+		value = strings.TrimPrefix(value, "=")
+		r.Key, r.Op, r.Values = strings.TrimSpace(key), SelectorEquals, []string{strings.TrimSpace(value)}
+	case strings.HasPrefix(s, "!"):
+		r.Key, r.Op = strings.TrimSpace(s[1:]), SelectorNotExists
+	default:
+		r.Key, r.Op = s, SelectorExists
+	}
+
+	for _, v := range r.Values {
+		if err := ValidateLabel(r.Key, v); err != nil {
+			return Requirement{}, fmt.Errorf("%w: %q: %s", ErrInvalidSelector, s, err)
+		}
+	}
+
+	if err := ValidateLabel(r.Key, ""); err != nil {
+		return Requirement{}, fmt.Errorf("%w: %q: %s", ErrInvalidSelector, s, err)
+	}
+
+	return r, nil
+}
+
+// splitRequirements splits the selector by the commas outside of value sets.
+func splitRequirements(s string) []string {
+	var (
+		result []string
+		depth  int
+		start  int
+	)
+	for i, c := range s {
+		switch c {
+		case '(':
+			depth++
+		case ')':
+			depth--
+		case ',':
+			if depth == 0 {
+				result = append(result, s[start:i])
+				start = i + 1
+			}
+		}
+	}
+
+	return append(result, s[start:])
+}
+
+// Matches reports whether the labels satisfy all requirements of the
+// selector, with the same semantics as the selector of the device lists.
+func (s Selector) Matches(labels map[string]string) bool {
+	for _, r := range s {
+		value, ok := labels[r.Key]
+
+		var matched bool
+		switch r.Op {
+		case SelectorEquals:
+			matched = ok && value == r.Values[0]
+		case SelectorNotEquals:
+			matched = !ok || value != r.Values[0]
+		case SelectorIn:
+			matched = ok && slices.Contains(r.Values, value)
+		case SelectorNotIn:
+			matched = !ok || !slices.Contains(r.Values, value)
+		case SelectorExists:
+			matched = ok
+		case SelectorNotExists:
+			matched = !ok
+		}
+
+		if !matched {
+			return false
+		}
+	}
+
+	return true
+}
+
+// DeviceFilter selects devices for the list operations. Empty fields do not
+// restrict the result, except for empty Lifecycles, which hides the
+// decommissioned devices. Scope is set for an operator with scoped roles.
+type DeviceFilter struct {
+	Selector   Selector
+	Types      []DeviceType
+	BatteryMin *int
+	BatteryMax *int
+	Lifecycles []Lifecycle
+	Scope      *DeviceScope
+}
@@ -0,0 +1,156 @@
+package models
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestParseSelector(t *testing.T) {
+	tests := []struct {
+		in   string
+		want Selector
+	}{
+		{in: "", want: nil},
+		{in: "  ", want: nil},
+		{in: "site=oslo", want: Selector{{Key: "site", Op: SelectorEquals, Values: []string{"oslo"}}}},
+		{in: "site==oslo", want: Selector{{Key: "site", Op: SelectorEquals, Values: []string{"oslo"}}}},
+		{in: " site = oslo ", want: Selector{{Key: "site", Op: SelectorEquals, Values: []string{"oslo"}}}},
+		{in: "site=", want: Selector{{Key: "site", Op: SelectorEquals, Values: []string{""}}}},
+		{in: "site!=oslo", want: Selector{{Key: "site", Op: SelectorNotEquals, Values: []string{"oslo"}}}},
+		{in: "site", want: Selector{{Key: "site", Op: SelectorExists}}},
+		{in: "!site", want: Selector{{Key: "site", Op: SelectorNotExists}}},
+		{in: "! site", want: Selector{{Key: "site", Op: SelectorNotExists}}},
+		{
+			in:   "site in (oslo, bergen)",
+			want: Selector{{Key: "site", Op: SelectorIn, Values: []string{"oslo", "bergen"}}},
+		},
+		{
+			in:   "site in(oslo)",
+			want: Selector{{Key: "site", Op: SelectorIn, Values: []string{"oslo"}}},
+		},
+		{
+			in:   "site notin (oslo,bergen)",
+			want: Selector{{Key: "site", Op: SelectorNotIn, Values: []string{"oslo", "bergen"}}},
+		},
+		{
+			in: "app.io/tier=web, site in (oslo,bergen), !legacy, owner",
+			want: Selector{
+				{Key: "app.io/tier", Op: SelectorEquals, Values: []string{"web"}},
+				{Key: "site", Op: SelectorIn, Values: []string{"oslo", "bergen"}},
+				{Key: "legacy", Op: SelectorNotExists},
+				{Key: "owner", Op: SelectorExists},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			got, err := ParseSelector(tt.in)
+			if err != nil {
+				t.Fatalf("ParseSelector() error = %v", err)
+			}
+
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ParseSelector() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseSelectorInvalid(t *testing.T) {
+	for _, in := range []string{
+		",",
+		"site=oslo,",
+		",site=oslo",
+		"site=oslo,,owner",
+		"=oslo",
+		"!=oslo",
+		"!",
+		"site=a=b",
+		"site!=a!=b",
+		"site=oslo city",
+		"my site=oslo",
+		"site in ()",
+		"site in ( )",
+		"site notin ()",
+		"site in (oslo",
+		"site in oslo)",
+		"site in (oslo bergen)",
+		"sitein (oslo)",
+		"site in (-oslo)",
+		"-site",
+		"site-=oslo",
+		"(site)",
+	} {
+		t.Run(in, func(t *testing.T) {
+			got, err := ParseSelector(in)
+			if !errors.Is(err, ErrInvalidSelector) {
+				t.Errorf("ParseSelector() = %+v, %v, want an error wrapping ErrInvalidSelector", got, err)
+			}
+		})
+	}
+}
+
+func TestSplitRequirements(t *testing.T) {
+	tests := []struct {
+		in   string
+		want []string
+	}{
+		{in: "a", want: []string{"a"}},
+		{in: "a,b", want: []string{"a", "b"}},
+		{in: "a,", want: []string{"a", ""}},
+		{in: "a in (x,y),b", want: []string{"a in (x,y)", "b"}},
+		{in: "a in (x,y),b notin (z,w)", want: []string{"a in (x,y)", "b notin (z,w)"}},
+		{in: "a in (x,(y,z)),b", want: []string{"a in (x,(y,z))", "b"}},
+	}
+
+	for _, tt := range tests {
+		if got := splitRequirements(tt.in); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("splitRequirements(%q) = %q, want %q", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestSelectorMatches(t *testing.T) {
+	labels := map[string]string{"site": "oslo", "tier": "web", "empty": ""}
+
+	tests := []struct {
+		selector string
+		want     bool
+	}{
+		{selector: "", want: true},
+		{selector: "site=oslo", want: true},
+		{selector: "site==bergen", want: false},
+		{selector: "site!=bergen", want: true},
+		{selector: "site!=oslo", want: false},
+		{selector: "missing!=oslo", want: true},
+		{selector: "site in (bergen,oslo)", want: true},
+		{selector: "site in (bergen)", want: false},
+		{selector: "missing in (oslo)", want: false},
+		{selector: "site notin (bergen)", want: true},
+		{selector: "site notin (oslo)", want: false},
+		{selector: "missing notin (oslo)", want: true},
+		{selector: "site", want: true},
+		{selector: "missing", want: false},
+		{selector: "!missing", want: true},
+		{selector: "!site", want: false},
+		{selector: "empty=", want: true},
+		{selector: "empty", want: true},
+		{selector: "site=oslo,tier=web", want: true},
+		{selector: "site=oslo,tier=db", want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.selector, func(t *testing.T) {
+			s, err := ParseSelector(tt.selector)
+			if err != nil {
+				t.Fatalf("ParseSelector() error = %v", err)
+			}
+
+			if got := s.Matches(labels); got != tt.want {
+				t.Errorf("Matches() = %t, want %t", got, tt.want)
+			}
+		})
+	}
+}
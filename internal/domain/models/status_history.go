@@ -0,0 +1,19 @@
+package models
+
+import "time"
+
+// StatusPoint is a status reported by the device with a ping.
+type StatusPoint struct {
+	Time     time.Time
+	Location string
+	Battery  int
+}
+
+// RetentionPolicy limits the status history. Points older than Retention
+// are removed, points older than DownsampleAfter are thinned out to the
+// last point of every DownsampleInterval.
+type RetentionPolicy struct {
+	Retention          time.Duration
+	DownsampleAfter    time.Duration
+	DownsampleInterval time.Duration
+}
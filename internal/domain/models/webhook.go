@@ -0,0 +1,97 @@
+package models
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var ErrInvalidWebhook = errors.New("invalid webhook")
+
+// Webhook receives the events of the listed types, or of all types if Events
+// is empty, as signed JSON POST requests. Webhooks from the server config are
+// read only.
+type Webhook struct {
+	Id         int64
+	Name       string
+	Url        string
+	Secret     string
+	Events     []EventType
+	FromConfig bool
+}
+
+// Validate checks the URL and the event types of the webhook. The returned
+// error wraps ErrInvalidWebhook.
+func (w Webhook) Validate() error {
+	if w.Name == "" {
+		return fmt.Errorf("%w: name is required", ErrInvalidWebhook)
+	}
+
+	u, err := url.Parse(w.Url)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return fmt.Errorf("%w: %s: url must be an absolute http or https url", ErrInvalidWebhook, w.Name)
+	}
+
+	if w.Secret == "" {
+		return fmt.Errorf("%w: %s: secret is required", ErrInvalidWebhook, w.Name)
+	}
+
+	for _, t := range w.Events {
+		if !slices.Contains(WebhookEventTypes, t) {
+			return fmt.Errorf("%w: %s: unknown event type %q", ErrInvalidWebhook, w.Name, t)
+		}
+	}
+
+	return nil
+}
+
+// Subscribed reports whether the webhook receives events of the type, only
+// WebhookEventTypes are delivered to webhooks.
+func (w Webhook) Subscribed(t EventType) bool {
+	if !slices.Contains(WebhookEventTypes, t) {
+		return false
+	}
+
+	return len(w.Events) == 0 || slices.Contains(w.Events, t)
+}
+
+// WebhookDelivery is a pending POST of an event to a webhook. Attempts is
+// the number of failed attempts so far.
+type WebhookDelivery struct {
+	Id        int64
+	EventId   uuid.UUID
+	EventType EventType
+	Payload   []byte
+	Url       string
+	Secret    string
+	Attempts  int
+	CreatedAt time.Time
+}
+
+// DeliveryPolicy schedules the retries of a failed delivery: the delay
+// starts at Backoff and doubles after every attempt up to MaxBackoff, after
+// MaxAttempts attempts the delivery is given up.
+type DeliveryPolicy struct {
+	MaxAttempts int
+	Backoff     time.Duration
+	MaxBackoff  time.Duration
+}
+
+// Next returns the time of the next attempt after the given number of failed
+// attempts, or the zero time if the delivery must be given up.
+func (p DeliveryPolicy) Next(attempts int, now time.Time) time.Time {
+	if attempts >= p.MaxAttempts {
+		return time.Time{}
+	}
+
+	delay := p.Backoff
+	for i := 1; i < attempts && delay < p.MaxBackoff; i++ {
+		delay *= 2
+	}
+
+	return now.Add(min(delay, p.MaxBackoff))
+}
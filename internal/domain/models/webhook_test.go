@@ -0,0 +1,50 @@
+package models
+
+import (
+	"testing"
+	"time"
+)
+
+func TestDeliveryPolicyNext(t *testing.T) {
+	policy := DeliveryPolicy{MaxAttempts: 6, Backoff: 10 * time.Second, MaxBackoff: time.Minute}
+	now := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		attempts int
+		want     time.Duration
+		givenUp  bool
+	}{
+		{attempts: 1, want: 10 * time.Second},
+		{attempts: 2, want: 20 * time.Second},
+		{attempts: 3, want: 40 * time.Second},
+		{attempts: 4, want: time.Minute},
+		{attempts: 5, want: time.Minute},
+		{attempts: 6, givenUp: true},
+		{attempts: 7, givenUp: true},
+	}
+
+	for _, tt := range tests {
+		next := policy.Next(tt.attempts, now)
+
+		if tt.givenUp {
+			if !next.IsZero() {
+				t.Errorf("Next(%d) = %s, want the delivery given up", tt.attempts, next)
+			}
+			continue
+		}
+
+		if got := next.Sub(now); got != tt.want {
+			t.Errorf("Next(%d) delay = %s, want %s", tt.attempts, got, tt.want)
+		}
+	}
+}
+
+func TestDeliveryPolicyNextLongBackoff(t *testing.T) {
+	// задержка не переполняется при большом числе попыток
+	policy := DeliveryPolicy{MaxAttempts: 1000, Backoff: time.Second, MaxBackoff: time.Hour}
+	now := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+
+	if got := policy.Next(999, now).Sub(now); got != time.Hour {
+		t.Errorf("Next(999) delay = %s, want %s", got, time.Hour)
+	}
+}
@@ -1,38 +1,110 @@
 package serverapp
 
 import (
+	"context"
 	"io"
 	"log/slog"
 
+	"github.com/dvaxert/mdm/internal/server"
 	grpcapp "github.com/dvaxert/mdm/internal/server/app/grpc"
+	alertsrv "github.com/dvaxert/mdm/internal/server/services/alerting"
+	auditsrv "github.com/dvaxert/mdm/internal/server/services/audit"
+	authsrv "github.com/dvaxert/mdm/internal/server/services/auth"
+	compliancesrv "github.com/dvaxert/mdm/internal/server/services/compliance"
 	controlsrv "github.com/dvaxert/mdm/internal/server/services/control"
+	eventsrv "github.com/dvaxert/mdm/internal/server/services/events"
+	historysrv "github.com/dvaxert/mdm/internal/server/services/history"
 	managementsrv "github.com/dvaxert/mdm/internal/server/services/management"
+	webhooksrv "github.com/dvaxert/mdm/internal/server/services/webhooks"
 	"github.com/dvaxert/mdm/internal/server/storage/sqlite"
 )
 
 type App struct {
-	gRPCSrv *grpcapp.App
-	storage io.Closer
+	gRPCSrv    *grpcapp.App
+	history    *historysrv.History
+	alerts     *alertsrv.Alerting
+	compliance *compliancesrv.Compliance
+	webhooks   *webhooksrv.Webhooks
+	events     *eventsrv.Events
+	storage    io.Closer
 }
 
-func New(
-	log *slog.Logger,
-	grpcPort int,
-	storagePath string,
-) *App {
-	storage, err := sqlite.New(storagePath)
+// New builds the server from the config. Invalid parts of the config and
+// storage failures panic, the server can not start without them.
+func New(log *slog.Logger, conf *server.Config) *App {
+	alertRules, err := conf.Alerts.AlertRules()
+	if err != nil {
+		panic("incorrect alert rules: " + err.Error())
+	}
+
+	webhooks, err := conf.Webhooks.Webhooks()
+	if err != nil {
+		panic("incorrect webhooks: " + err.Error())
+	}
+
+	operators, err := conf.Auth.Operators()
+	if err != nil {
+		panic("incorrect operators: " + err.Error())
+	}
+
+	tlsConf, err := conf.Grpc.TLS.Config()
+	if err != nil {
+		panic("incorrect tls config: " + err.Error())
+	}
+
+	authority, err := conf.Enrollment.CA()
+	if err != nil {
+		panic("failed to load the enrollment ca: " + err.Error())
+	}
+
+	storage, err := sqlite.New(conf.StoragePath)
 	if err != nil {
 		panic(err)
 	}
 
-	managementSrv := managementsrv.New(log, storage)
-	controlSrv := controlsrv.New(log, storage, managementSrv)
+	if err = storage.SyncConfigAlertRules(context.Background(), alertRules); err != nil {
+		panic(err)
+	}
+
+	if err = storage.SyncConfigWebhooks(context.Background(), webhooks); err != nil {
+		panic(err)
+	}
+
+	if err = storage.SyncConfigOperators(context.Background(), operators); err != nil {
+		panic(err)
+	}
+
+	presence := conf.Presence.Policy()
 
-	grpcApp := grpcapp.New(log, grpcPort, managementSrv, controlSrv)
+	alertSrv := alertsrv.New(log, storage, conf.Alerts.SweepPeriod)
+	webhookSrv := webhooksrv.New(log, storage, conf.Webhooks.Policy(), conf.Webhooks.Period, conf.Webhooks.Timeout)
+	eventSrv := eventsrv.New(log, storage, presence, conf.Events.PresenceCheck, conf.Events.BufferSize, webhookSrv)
+	complianceSrv := compliancesrv.New(log, storage, eventSrv, conf.Compliance.SweepPeriod)
+
+	// nil *ca.CA нельзя передать как интерфейс, сервис проверяет его на nil
+	var certs managementsrv.CertificateAuthority
+	if authority != nil {
+		certs = authority
+	}
+
+	managementSrv := managementsrv.New(log, storage, alertSrv, complianceSrv, eventSrv, certs, !conf.Enrollment.OpenRegistration)
+	auditSrv := auditsrv.New(log, storage)
+	controlSrv := controlsrv.New(log, storage, managementSrv, eventSrv, auditSrv, complianceSrv, presence, conf.Commands.TTL, conf.Enrollment.TokenTTL)
+
+	authSrv := authsrv.New(log, storage, !conf.Auth.Disabled)
+
+	historySrv := historysrv.New(log, storage, conf.History.Policy(), conf.History.CompactPeriod)
+
+	grpcApp := grpcapp.New(log, conf.Grpc.Port, tlsConf, conf.Grpc.TLS.RequireDeviceCert, managementSrv, controlSrv, authSrv, auditSrv)
 
 	return &App{
-		gRPCSrv: grpcApp,
-		storage: storage,
+		gRPCSrv:    grpcApp,
+		history:    historySrv,
+		alerts:     alertSrv,
+		compliance: complianceSrv,
+		webhooks:   webhookSrv,
+		events:     eventSrv,
+		storage:    storage,
 	}
 }
 
@@ -43,10 +115,22 @@ func (a *App) MustRun() {
 }
 
 func (a *App) Run() error {
+	go a.history.Run()
+	go a.alerts.Run()
+	go a.compliance.Run()
+	go a.webhooks.Run()
+	go a.events.Run()
+
 	return a.gRPCSrv.Run()
 }
 
 func (a *App) Stop() {
+	// потоки событий закрываются до остановки gRPC, иначе она их ждет
+	a.events.Stop()
 	a.gRPCSrv.Stop()
+	a.history.Stop()
+	a.alerts.Stop()
+	a.compliance.Stop()
+	a.webhooks.Stop()
 	a.storage.Close()
 }
@@ -1,6 +1,7 @@
 package grpcapp
 
 import (
+	"crypto/tls"
 	"fmt"
 	"log/slog"
 	"net"
@@ -8,6 +9,7 @@ import (
 	controlgrpc "github.com/dvaxert/mdm/internal/server/grpc/control"
 	managementgrpc "github.com/dvaxert/mdm/internal/server/grpc/management"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
 )
 
 type App struct {
@@ -19,13 +21,26 @@ type App struct {
 func New(
 	log *slog.Logger,
 	port int,
+	tlsConf *tls.Config,
+	requireDeviceCert bool,
 	mng managementgrpc.Management,
 	ctl controlgrpc.Control,
+	auth controlgrpc.Auth,
+	audit controlgrpc.Audit,
 ) *App {
-	gRPCServer := grpc.NewServer()
+	opts := []grpc.ServerOption{
+		// аудит идет до проверки прав, чтобы записывать и отклоненные вызовы
+		grpc.ChainUnaryInterceptor(controlgrpc.AuditInterceptor(log, audit), controlgrpc.UnaryInterceptor(auth)),
+		grpc.ChainStreamInterceptor(controlgrpc.StreamInterceptor(auth)),
+	}
+	if tlsConf != nil {
+		opts = append(opts, grpc.Creds(credentials.NewTLS(tlsConf)))
+	}
+
+	gRPCServer := grpc.NewServer(opts...)
 
 	controlgrpc.Register(gRPCServer, ctl)
-	managementgrpc.Register(gRPCServer, mng)
+	managementgrpc.Register(gRPCServer, mng, requireDeviceCert)
 
 	return &App{
 		log:        log,
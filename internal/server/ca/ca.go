@@ -0,0 +1,201 @@
+package ca
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"math/big"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/dvaxert/mdm/internal/domain/models"
+	"github.com/google/uuid"
+)
+
+// caValidity is the validity period of a generated CA certificate.
+const caValidity = 10 * 365 * 24 * time.Hour
+
+// CA issues the client certificates of devices.
+type CA struct {
+	cert     *x509.Certificate
+	certPEM  []byte
+	key      crypto.Signer
+	validity time.Duration
+}
+
+// Load reads the CA certificate and key. If neither file exists, a new CA is
+// generated and written to them, so the CA survives server restarts.
+func Load(certPath string, keyPath string, validity time.Duration) (*CA, error) {
+	const op = "ca.Load"
+
+	_, certErr := os.Stat(certPath)
+	_, keyErr := os.Stat(keyPath)
+	if errors.Is(certErr, os.ErrNotExist) && errors.Is(keyErr, os.ErrNotExist) {
+		if err := generate(certPath, keyPath); err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+	}
+
+	certPEM, err := os.ReadFile(certPath)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	keyPEM, err := os.ReadFile(keyPath)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	block, _ := pem.Decode(certPEM)
+	if block == nil {
+		return nil, fmt.Errorf("%s: no certificate found in %s", op, certPath)
+	}
+
+	cert, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	block, _ = pem.Decode(keyPEM)
+	if block == nil {
+		return nil, fmt.Errorf("%s: no key found in %s", op, keyPath)
+	}
+
+	key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	signer, ok := key.(crypto.Signer)
+	if !ok {
+		return nil, fmt.Errorf("%s: unsupported key type %T", op, key)
+	}
+
+	return &CA{
+		cert:     cert,
+		certPEM:  certPEM,
+		key:      signer,
+		validity: validity,
+	}, nil
+}
+
+// CertificatePEM returns the CA certificate, clients verify the issued
+// certificates with it.
+func (c *CA) CertificatePEM() []byte {
+	return c.certPEM
+}
+
+// Sign issues a client certificate for the device from the PEM encoded
+// CSR. The subject of the CSR is replaced, the common name of the issued
+// certificate is always the device UUID. An invalid CSR is reported with an
+// error wrapping models.ErrInvalidCSR.
+func (c *CA) Sign(csrPEM []byte, device_uuid uuid.UUID) (models.Certificate, error) {
+	const op = "ca.Sign"
+
+	block, _ := pem.Decode(csrPEM)
+	if block == nil || block.Type != "CERTIFICATE REQUEST" {
+		return models.Certificate{}, fmt.Errorf("%s: %w: no request found", op, models.ErrInvalidCSR)
+	}
+
+	csr, err := x509.ParseCertificateRequest(block.Bytes)
+	if err != nil {
+		return models.Certificate{}, fmt.Errorf("%s: %w: %s", op, models.ErrInvalidCSR, err)
+	}
+
+	if err = csr.CheckSignature(); err != nil {
+		return models.Certificate{}, fmt.Errorf("%s: %w: %s", op, models.ErrInvalidCSR, err)
+	}
+
+	serial, err := serialNumber()
+	if err != nil {
+		return models.Certificate{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	// небольшой запас на расхождение часов устройства и сервера
+	now := time.Now().Truncate(time.Second)
+	template := &x509.Certificate{
+		SerialNumber: serial,
+		Subject:      pkix.Name{CommonName: device_uuid.String()},
+		NotBefore:    now.Add(-5 * time.Minute),
+		NotAfter:     now.Add(c.validity),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, c.cert, csr.PublicKey, c.key)
+	if err != nil {
+		return models.Certificate{}, fmt.Errorf("%s: %w: %s", op, models.ErrInvalidCSR, err)
+	}
+
+	return models.Certificate{
+		Serial:     serial.Text(16),
+		DeviceUuid: device_uuid,
+		NotBefore:  template.NotBefore,
+		NotAfter:   template.NotAfter,
+		IssuedAt:   now,
+		Pem:        pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}),
+	}, nil
+}
+
+// Serial returns the serial number of a certificate in the form used by
+// the certificate inventory.
+func Serial(cert *x509.Certificate) string {
+	return cert.SerialNumber.Text(16)
+}
+
+func generate(certPath string, keyPath string) error {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return err
+	}
+
+	serial, err := serialNumber()
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	template := &x509.Certificate{
+		SerialNumber:          serial,
+		Subject:               pkix.Name{CommonName: "mdm enrollment ca"},
+		NotBefore:             now,
+		NotAfter:              now.Add(caValidity),
+		KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageCRLSign,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, template, key.Public(), key)
+	if err != nil {
+		return err
+	}
+
+	keyDer, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		return err
+	}
+
+	for _, path := range []string{certPath, keyPath} {
+		if err = os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			return err
+		}
+	}
+
+	err = os.WriteFile(keyPath, pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: keyDer}), 0o600)
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(certPath, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0o644)
+}
+
+func serialNumber() (*big.Int, error) {
+	return rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), 128))
+}
@@ -1,22 +1,280 @@
 package server
 
 import (
+	"crypto/tls"
 	"flag"
+	"fmt"
 	"os"
 	"time"
 
+	"github.com/dvaxert/mdm/internal/domain/models"
+	"github.com/dvaxert/mdm/internal/server/ca"
+	"github.com/dvaxert/mdm/pkg/tlsconfig"
 	"github.com/ilyakaznacheev/cleanenv"
 )
 
 type Config struct {
-	Env         string     `yaml:"env" env-default:"prod"` // local dev prod
-	StoragePath string     `yaml:"storage_path" env-required:"true"`
-	Grpc        GrpcConfig `yaml:"grpc" env-required:"true"`
+	Env         string           `yaml:"env" env-default:"prod"` // local dev prod
+	StoragePath string           `yaml:"storage_path" env-required:"true"`
+	Grpc        GrpcConfig       `yaml:"grpc" env-required:"true"`
+	Presence    PresenceConfig   `yaml:"presence"`
+	History     HistoryConfig    `yaml:"history"`
+	Alerts      AlertsConfig     `yaml:"alerts"`
+	Compliance  ComplianceConfig `yaml:"compliance"`
+	Webhooks    WebhooksConfig   `yaml:"webhooks"`
+	Events      EventsConfig     `yaml:"events"`
+	Commands    CommandsConfig   `yaml:"commands"`
+	Enrollment  EnrollmentConfig `yaml:"enrollment"`
+	Auth        AuthConfig       `yaml:"auth"`
 }
 
 type GrpcConfig struct {
 	Port    int           `yaml:"port"`
 	Timeout time.Duration `yaml:"timeout"`
+	TLS     TLSConfig     `yaml:"tls"`
+}
+
+// TLSConfig включает TLS, если заданы cert и key. Если задан client_ca,
+// предъявленные сертификаты клиентов проверяются по нему, а
+// require_client_cert отклоняет клиентов без сертификата. Устройство с
+// сертификатом может действовать только от имени UUID из CN сертификата,
+// require_device_cert запрещает устройствам без сертификата все вызовы
+// DeviceManagement, кроме регистрации
+type TLSConfig struct {
+	Cert              string `yaml:"cert"`
+	Key               string `yaml:"key"`
+	ClientCA          string `yaml:"client_ca"`
+	RequireClientCert bool   `yaml:"require_client_cert"`
+	RequireDeviceCert bool   `yaml:"require_device_cert"`
+}
+
+// Config returns nil if TLS is not configured.
+func (c TLSConfig) Config() (*tls.Config, error) {
+	if c.Cert == "" && c.Key == "" {
+		return nil, nil
+	}
+
+	return tlsconfig.Server(c.Cert, c.Key, c.ClientCA, c.RequireClientCert)
+}
+
+// PresenceConfig задает пороги присутствия устройств в периодах ping:
+// устройство, молчащее stale_after периодов, считается stale,
+// offline_after периодов - offline
+type PresenceConfig struct {
+	PingPeriod   time.Duration `yaml:"ping_period" env-default:"5s"`
+	StaleAfter   int           `yaml:"stale_after" env-default:"3"`
+	OfflineAfter int           `yaml:"offline_after" env-default:"12"`
+}
+
+func (c PresenceConfig) Policy() models.PresencePolicy {
+	return models.PresencePolicy{
+		StaleAfter:   c.PingPeriod * time.Duration(c.StaleAfter),
+		OfflineAfter: c.PingPeriod * time.Duration(c.OfflineAfter),
+	}
+}
+
+// HistoryConfig задает хранение истории статусов: точки старше retention
+// удаляются, старше downsample_after прореживаются до одной точки
+// на downsample_interval, проверка выполняется раз в compact_period
+type HistoryConfig struct {
+	Retention          time.Duration `yaml:"retention" env-default:"720h"`
+	DownsampleAfter    time.Duration `yaml:"downsample_after" env-default:"24h"`
+	DownsampleInterval time.Duration `yaml:"downsample_interval" env-default:"1h"`
+	CompactPeriod      time.Duration `yaml:"compact_period" env-default:"10m"`
+}
+
+func (c HistoryConfig) Policy() models.RetentionPolicy {
+	return models.RetentionPolicy{
+		Retention:          c.Retention,
+		DownsampleAfter:    c.DownsampleAfter,
+		DownsampleInterval: c.DownsampleInterval,
+	}
+}
+
+// AlertsConfig задает правила оповещений, которые нельзя изменить через
+// Control API, и период проверки offline и drift правил
+type AlertsConfig struct {
+	SweepPeriod time.Duration     `yaml:"sweep_period" env-default:"30s"`
+	Rules       []AlertRuleConfig `yaml:"rules"`
+}
+
+type AlertRuleConfig struct {
+	Name      string        `yaml:"name"`
+	Condition string        `yaml:"condition"` // battery_below offline location_changed feature_drift
+	Threshold int           `yaml:"threshold"`
+	Duration  time.Duration `yaml:"duration"`
+	Feature   string        `yaml:"feature"`
+}
+
+func (c AlertsConfig) AlertRules() ([]models.AlertRule, error) {
+	result := make([]models.AlertRule, 0, len(c.Rules))
+	for _, r := range c.Rules {
+		condition, err := models.ParseAlertCondition(r.Condition)
+		if err != nil {
+			return nil, err
+		}
+
+		rule := models.AlertRule{
+			Name:       r.Name,
+			Condition:  condition,
+			Threshold:  r.Threshold,
+			Duration:   r.Duration,
+			Feature:    r.Feature,
+			FromConfig: true,
+		}
+		if err = rule.Validate(); err != nil {
+			return nil, err
+		}
+
+		result = append(result, rule)
+	}
+
+	return result, nil
+}
+
+// ComplianceConfig задает период проверки всех устройств по правилам
+// соответствия, между проверками устройство проверяется при каждом ping
+type ComplianceConfig struct {
+	SweepPeriod time.Duration `yaml:"sweep_period" env-default:"1m"`
+}
+
+// EventsConfig задает число последних событий, хранимых для возобновления
+// потока WatchEvents, и период проверки присутствия устройств
+type EventsConfig struct {
+	BufferSize    int           `yaml:"buffer_size" env-default:"10000"`
+	PresenceCheck time.Duration `yaml:"presence_check" env-default:"5s"`
+}
+
+// CommandsConfig задает срок, за который устройство должно выполнить
+// команду, если он не указан при ее создании
+type CommandsConfig struct {
+	TTL time.Duration `yaml:"ttl" env-default:"24h"`
+}
+
+// EnrollmentConfig включает выпуск сертификатов устройств, если задан
+// ca_cert. Если файлов ca_cert и ca_key нет, сервер создает новый CA.
+// validity - срок действия выданных сертификатов, token_ttl - срок действия
+// токенов регистрации по умолчанию. Чтобы сервер принимал выданные
+// сертификаты, ca_cert указывается в grpc.tls.client_ca.
+// Новые устройства регистрируются только по токену, повторно устройство
+// регистрируется без него только со своим сертификатом. open_registration
+// разрешает регистрацию без токена. Флаг выключен по умолчанию: cleanenv
+// подставляет env-default вместо явного false из файла
+type EnrollmentConfig struct {
+	CACert           string        `yaml:"ca_cert"`
+	CAKey            string        `yaml:"ca_key"`
+	Validity         time.Duration `yaml:"validity" env-default:"720h"`
+	TokenTTL         time.Duration `yaml:"token_ttl" env-default:"24h"`
+	OpenRegistration bool          `yaml:"open_registration"`
+}
+
+// CA returns nil if enrollment is not configured.
+func (c EnrollmentConfig) CA() (*ca.CA, error) {
+	if c.CACert == "" {
+		return nil, nil
+	}
+
+	return ca.Load(c.CACert, c.CAKey, c.Validity)
+}
+
+// AuthConfig задает операторов Control API. disabled отключает
+// аутентификацию, и Control API доступен всем. Операторов из users нельзя
+// изменить через Control API, token - их API token, без него оператор
+// входит только по клиентскому сертификату с CN, равным name. Роли -
+// встроенные (read-only, feature-admin, full-admin) или созданные через
+// Control API
+type AuthConfig struct {
+	Disabled bool             `yaml:"disabled"`
+	Users    []OperatorConfig `yaml:"users"`
+}
+
+type OperatorConfig struct {
+	Name  string   `yaml:"name"`
+	Token string   `yaml:"token"`
+	Roles []string `yaml:"roles"`
+}
+
+// String скрывает токен, чтобы он не попадал в лог конфигурации
+func (c OperatorConfig) String() string {
+	return fmt.Sprintf("{Name:%s Token:*** Roles:%v}", c.Name, c.Roles)
+}
+
+func (c AuthConfig) Operators() ([]models.Operator, error) {
+	result := make([]models.Operator, 0, len(c.Users))
+	for _, o := range c.Users {
+		operator := models.Operator{
+			Name:       o.Name,
+			Roles:      o.Roles,
+			FromConfig: true,
+		}
+		if o.Token != "" {
+			operator.TokenHash = models.TokenHash(o.Token)
+		}
+
+		if err := operator.Validate(); err != nil {
+			return nil, err
+		}
+
+		result = append(result, operator)
+	}
+
+	return result, nil
+}
+
+// WebhooksConfig задает вебхуки, которые нельзя изменить через Control API,
+// и повторы доставки: задержка начинается с backoff и удваивается после
+// каждой попытки до max_backoff, после max_attempts попыток доставка
+// прекращается. Очередь доставок проверяется раз в period
+type WebhooksConfig struct {
+	Period      time.Duration   `yaml:"period" env-default:"5s"`
+	Timeout     time.Duration   `yaml:"timeout" env-default:"10s"`
+	MaxAttempts int             `yaml:"max_attempts" env-default:"10"`
+	Backoff     time.Duration   `yaml:"backoff" env-default:"10s"`
+	MaxBackoff  time.Duration   `yaml:"max_backoff" env-default:"1h"`
+	Hooks       []WebhookConfig `yaml:"hooks"`
+}
+
+type WebhookConfig struct {
+	Name   string   `yaml:"name"`
+	Url    string   `yaml:"url"`
+	Secret string   `yaml:"secret"`
+	Events []string `yaml:"events"` // пустой список означает все события
+}
+
+// String скрывает секрет, чтобы он не попадал в лог конфигурации
+func (c WebhookConfig) String() string {
+	return fmt.Sprintf("{Name:%s Url:%s Secret:*** Events:%v}", c.Name, c.Url, c.Events)
+}
+
+func (c WebhooksConfig) Policy() models.DeliveryPolicy {
+	return models.DeliveryPolicy{
+		MaxAttempts: c.MaxAttempts,
+		Backoff:     c.Backoff,
+		MaxBackoff:  c.MaxBackoff,
+	}
+}
+
+func (c WebhooksConfig) Webhooks() ([]models.Webhook, error) {
+	result := make([]models.Webhook, 0, len(c.Hooks))
+	for _, h := range c.Hooks {
+		webhook := models.Webhook{
+			Name:       h.Name,
+			Url:        h.Url,
+			Secret:     h.Secret,
+			FromConfig: true,
+		}
+		for _, t := range h.Events {
+			webhook.Events = append(webhook.Events, models.EventType(t))
+		}
+
+		if err := webhook.Validate(); err != nil {
+			return nil, err
+		}
+
+		result = append(result, webhook)
+	}
+
+	return result, nil
 }
 
 func MustLoadConfig() *Config {
@@ -0,0 +1,167 @@
+package controlgrpc
+
+import (
+	"context"
+	"errors"
+
+	controlv1 "github.com/dvaxert/mdm/api/gen/go/control"
+	"github.com/dvaxert/mdm/internal/domain/models"
+	authsrv "github.com/dvaxert/mdm/internal/server/services/auth"
+	controlsrv "github.com/dvaxert/mdm/internal/server/services/control"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+func (s *serverApi) CreateAlertRule(
+	ctx context.Context,
+	req *controlv1.CreateAlertRuleRequest,
+) (*controlv1.CreateAlertRuleResponse, error) {
+	rule := req.GetRule()
+	if rule == nil {
+		return nil, status.Error(codes.InvalidArgument, "alert rule is required")
+	}
+
+	err := s.control.CreateAlertRule(ctx, models.AlertRule{
+		Name:      rule.GetName(),
+		Condition: models.AlertCondition(rule.GetCondition()),
+		Threshold: int(rule.GetThreshold()),
+		Duration:  rule.GetDuration().AsDuration(),
+		Feature:   rule.GetFeature(),
+	})
+	if err != nil {
+		return nil, alertStatus(err)
+	}
+
+	return &controlv1.CreateAlertRuleResponse{Success: true}, nil
+}
+
+func (s *serverApi) DeleteAlertRule(
+	ctx context.Context,
+	req *controlv1.DeleteAlertRuleRequest,
+) (*controlv1.DeleteAlertRuleResponse, error) {
+	if req.Name == "" {
+		return nil, status.Error(codes.InvalidArgument, "alert rule name is required")
+	}
+
+	if err := s.control.DeleteAlertRule(ctx, req.GetName()); err != nil {
+		return nil, alertStatus(err)
+	}
+
+	return &controlv1.DeleteAlertRuleResponse{Success: true}, nil
+}
+
+func (s *serverApi) AlertRuleList(
+	ctx context.Context,
+	req *controlv1.AlertRuleListRequest,
+) (*controlv1.AlertRuleListResponse, error) {
+	list, err := s.control.AlertRuleList(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	result := make([]*controlv1.AlertRule, 0, len(list))
+	for _, item := range list {
+		result = append(result, alertRuleToProto(item))
+	}
+
+	return &controlv1.AlertRuleListResponse{Items: result}, nil
+}
+
+func (s *serverApi) AlertList(
+	ctx context.Context,
+	req *controlv1.AlertListRequest,
+) (*controlv1.AlertListResponse, error) {
+	if req.Limit < 0 {
+		return nil, status.Error(codes.InvalidArgument, "negative limit")
+	}
+
+	filter := models.AlertFilter{
+		FiringOnly: req.GetFiringOnly(),
+		Limit:      int(req.GetLimit()),
+		Scope:      authsrv.ScopeFromContext(ctx),
+	}
+
+	if req.DeviceId != "" {
+		id, err := uuid.Parse(req.GetDeviceId())
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, "incorrect device id")
+		}
+
+		filter.Device = id
+	}
+
+	list, err := s.control.AlertList(ctx, filter)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	result := make([]*controlv1.Alert, 0, len(list))
+	for _, item := range list {
+		result = append(result, &controlv1.Alert{
+			Id:             item.Id,
+			Rule:           item.Rule,
+			DeviceId:       item.DeviceUuid.String(),
+			Message:        item.Message,
+			Firing:         item.Firing,
+			Count:          int32(item.Count),
+			FiredAt:        timeToProto(item.FiredAt),
+			LastFiredAt:    timeToProto(item.LastFiredAt),
+			ResolvedAt:     timeToProto(item.ResolvedAt),
+			AcknowledgedAt: timeToProto(item.AcknowledgedAt),
+		})
+	}
+
+	return &controlv1.AlertListResponse{Items: result}, nil
+}
+
+func (s *serverApi) AcknowledgeAlert(
+	ctx context.Context,
+	req *controlv1.AcknowledgeAlertRequest,
+) (*controlv1.AcknowledgeAlertResponse, error) {
+	if req.Id <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "alert id is required")
+	}
+
+	if err := s.control.AcknowledgeAlert(ctx, req.GetId()); err != nil {
+		return nil, alertStatus(err)
+	}
+
+	return &controlv1.AcknowledgeAlertResponse{Success: true}, nil
+}
+
+func alertStatus(err error) error {
+	switch {
+	case errors.Is(err, models.ErrInvalidAlertRule):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, controlsrv.ErrAlertRuleNotFound):
+		return status.Error(codes.NotFound, "alert rule not found")
+	case errors.Is(err, controlsrv.ErrAlertNotFound):
+		return status.Error(codes.NotFound, "alert not found")
+	case errors.Is(err, controlsrv.ErrFeatureNotFound):
+		return status.Error(codes.NotFound, "feature not found")
+	case errors.Is(err, controlsrv.ErrAlertRuleExists):
+		return status.Error(codes.AlreadyExists, "alert rule already exists")
+	case errors.Is(err, controlsrv.ErrAlertRuleReadOnly):
+		return status.Error(codes.FailedPrecondition, "alert rule is defined in the server config")
+	}
+
+	return status.Error(codes.Internal, err.Error())
+}
+
+func alertRuleToProto(rule models.AlertRule) *controlv1.AlertRule {
+	result := &controlv1.AlertRule{
+		Name:       rule.Name,
+		Condition:  controlv1.AlertCondition(rule.Condition),
+		Threshold:  int32(rule.Threshold),
+		Feature:    rule.Feature,
+		FromConfig: rule.FromConfig,
+	}
+
+	if rule.Duration != 0 {
+		result.Duration = durationpb.New(rule.Duration)
+	}
+
+	return result
+}
@@ -0,0 +1,239 @@
+package controlgrpc
+
+import (
+	"context"
+	"errors"
+
+	controlv1 "github.com/dvaxert/mdm/api/gen/go/control"
+	"github.com/dvaxert/mdm/internal/domain/models"
+	controlsrv "github.com/dvaxert/mdm/internal/server/services/control"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *serverApi) CreateApp(
+	ctx context.Context,
+	req *controlv1.CreateAppRequest,
+) (*controlv1.CreateAppResponse, error) {
+	if req.GetId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "app id is required")
+	}
+
+	err := s.control.CreateApp(ctx, models.CatalogApp{
+		Id:      req.GetId(),
+		Name:    req.GetName(),
+		Version: req.GetVersion(),
+	})
+	if err != nil {
+		return nil, appStatus(err)
+	}
+
+	return &controlv1.CreateAppResponse{Success: true}, nil
+}
+
+func (s *serverApi) DeleteApp(
+	ctx context.Context,
+	req *controlv1.DeleteAppRequest,
+) (*controlv1.DeleteAppResponse, error) {
+	if req.GetId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "app id is required")
+	}
+
+	if err := s.control.DeleteApp(ctx, req.GetId()); err != nil {
+		return nil, appStatus(err)
+	}
+
+	return &controlv1.DeleteAppResponse{Success: true}, nil
+}
+
+func (s *serverApi) AppList(
+	ctx context.Context,
+	req *controlv1.AppListRequest,
+) (*controlv1.AppListResponse, error) {
+	list, err := s.control.AppList(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	result := make([]*controlv1.CatalogApp, 0, len(list))
+	for _, app := range list {
+		rules := make([]*controlv1.AppRuleTarget, 0, len(app.Rules))
+		for _, r := range app.Rules {
+			target := &controlv1.AppRuleTarget{Rule: controlv1.AppRule(r.Rule)}
+			if r.Group != "" {
+				target.Target = &controlv1.AppRuleTarget_Group{Group: r.Group}
+			} else {
+				target.Target = &controlv1.AppRuleTarget_Profile{Profile: r.Profile}
+			}
+
+			rules = append(rules, target)
+		}
+
+		result = append(result, &controlv1.CatalogApp{
+			Id:      app.Id,
+			Name:    app.Name,
+			Version: app.Version,
+			Rules:   rules,
+		})
+	}
+
+	return &controlv1.AppListResponse{Items: result}, nil
+}
+
+func (s *serverApi) SetAppRule(
+	ctx context.Context,
+	req *controlv1.SetAppRuleRequest,
+) (*controlv1.SetAppRuleResponse, error) {
+	if req.GetRule() < 0 || models.AppRule(req.GetRule()) >= models.AppRuleCount {
+		return nil, status.Error(codes.InvalidArgument, "unknown app rule")
+	}
+
+	target := models.AppRuleTarget{
+		Rule:    models.AppRule(req.GetRule()),
+		Profile: req.GetProfile(),
+		Group:   req.GetGroup(),
+	}
+
+	if err := checkAppRule(req.GetAppId(), target); err != nil {
+		return nil, err
+	}
+
+	if err := s.control.SetAppRule(ctx, req.GetAppId(), target); err != nil {
+		return nil, appStatus(err)
+	}
+
+	return &controlv1.SetAppRuleResponse{Success: true}, nil
+}
+
+func (s *serverApi) ClearAppRule(
+	ctx context.Context,
+	req *controlv1.ClearAppRuleRequest,
+) (*controlv1.ClearAppRuleResponse, error) {
+	target := models.AppRuleTarget{
+		Profile: req.GetProfile(),
+		Group:   req.GetGroup(),
+	}
+
+	if err := checkAppRule(req.GetAppId(), target); err != nil {
+		return nil, err
+	}
+
+	if err := s.control.ClearAppRule(ctx, req.GetAppId(), target); err != nil {
+		return nil, appStatus(err)
+	}
+
+	return &controlv1.ClearAppRuleResponse{Success: true}, nil
+}
+
+func (s *serverApi) DeviceAppCompliance(
+	ctx context.Context,
+	req *controlv1.DeviceAppComplianceRequest,
+) (*controlv1.DeviceAppComplianceResponse, error) {
+	if req.GetDeviceId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "device id is required")
+	}
+
+	id, err := uuid.Parse(req.GetDeviceId())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "incorrect device id")
+	}
+
+	compliance, err := s.control.DeviceAppCompliance(ctx, id)
+	if err != nil {
+		return nil, appStatus(err)
+	}
+
+	policy := make([]*controlv1.AppPolicyEntry, 0, len(compliance.Policy))
+	for _, e := range compliance.Policy {
+		policy = append(policy, &controlv1.AppPolicyEntry{
+			Id:      e.Id,
+			Name:    e.Name,
+			Version: e.Version,
+			Rule:    controlv1.AppRule(e.Rule),
+		})
+	}
+
+	return &controlv1.DeviceAppComplianceResponse{
+		Policy:           policy,
+		Violations:       appViolationsToProto(compliance.Violations),
+		Reported:         compliance.Reported,
+		InventoryVersion: compliance.InventoryVersion,
+	}, nil
+}
+
+func (s *serverApi) AppViolationList(
+	ctx context.Context,
+	req *controlv1.AppViolationListRequest,
+) (*controlv1.AppViolationListResponse, error) {
+	filter, err := filterFromProto(ctx, req.GetFilter())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	page, err := pageFromProto(req.GetPageSize(), req.GetPageToken(), req.GetOrderBy())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	list, next, err := s.control.AppViolationList(ctx, filter, page)
+	if err != nil {
+		return nil, listStatus(err)
+	}
+
+	result := make([]*controlv1.AppViolationListItem, 0, len(list))
+	for _, item := range list {
+		result = append(result, &controlv1.AppViolationListItem{
+			DeviceId:   item.DeviceUuid.String(),
+			Violations: appViolationsToProto(item.Violations),
+		})
+	}
+
+	return &controlv1.AppViolationListResponse{
+		Items:         result,
+		NextPageToken: next,
+	}, nil
+}
+
+// checkAppRule checks the app and the target of the rule request, one of the
+// profile and the group is required.
+func checkAppRule(app_id string, target models.AppRuleTarget) error {
+	if app_id == "" {
+		return status.Error(codes.InvalidArgument, "app id is required")
+	}
+
+	if target.Profile == "" && target.Group == "" {
+		return status.Error(codes.InvalidArgument, "profile or group is required")
+	}
+
+	return nil
+}
+
+func appViolationsToProto(violations []models.AppViolation) []*controlv1.AppViolation {
+	result := make([]*controlv1.AppViolation, 0, len(violations))
+	for _, v := range violations {
+		result = append(result, &controlv1.AppViolation{
+			AppId:   v.AppId,
+			Kind:    controlv1.AppViolationKind(v.Kind),
+			Version: v.Version,
+		})
+	}
+
+	return result
+}
+
+// appStatus translates the errors of the app operations to gRPC statuses.
+func appStatus(err error) error {
+	switch {
+	case errors.Is(err, controlsrv.ErrAppNotFound):
+		return status.Error(codes.NotFound, "app not found")
+	case errors.Is(err, controlsrv.ErrAppRuleNotFound):
+		return status.Error(codes.NotFound, "app rule not found")
+	case errors.Is(err, controlsrv.ErrAppExists):
+		return status.Error(codes.AlreadyExists, "app already exists")
+	case errors.Is(err, models.ErrInvalidApp):
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return profileStatus(err)
+}
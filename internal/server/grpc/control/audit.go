@@ -0,0 +1,234 @@
+package controlgrpc
+
+import (
+	"context"
+	"log/slog"
+	"strings"
+	"time"
+
+	controlv1 "github.com/dvaxert/mdm/api/gen/go/control"
+	"github.com/dvaxert/mdm/internal/domain/models"
+	auditsrv "github.com/dvaxert/mdm/internal/server/services/audit"
+	"github.com/google/uuid"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type Audit interface {
+	Record(ctx context.Context, record models.AuditRecord) error
+}
+
+func (s *serverApi) AuditLog(
+	ctx context.Context,
+	req *controlv1.AuditLogRequest,
+) (*controlv1.AuditLogResponse, error) {
+	if req.Limit < 0 {
+		return nil, status.Error(codes.InvalidArgument, "negative limit")
+	}
+
+	filter := models.AuditFilter{
+		Actor: req.GetActor(),
+		Limit: int(req.GetLimit()),
+	}
+
+	if req.DeviceId != "" {
+		id, err := uuid.Parse(req.GetDeviceId())
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, "incorrect device id")
+		}
+
+		filter.Device = id
+	}
+
+	if req.From != nil {
+		filter.From = req.GetFrom().AsTime()
+	}
+
+	if req.To != nil {
+		filter.To = req.GetTo().AsTime()
+	}
+
+	if !filter.From.IsZero() && !filter.To.IsZero() && filter.From.After(filter.To) {
+		return nil, status.Error(codes.InvalidArgument, "from is after to")
+	}
+
+	list, err := s.control.AuditLog(ctx, filter)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	result := make([]*controlv1.AuditRecord, 0, len(list))
+	for _, item := range list {
+		devices := make([]string, 0, len(item.Devices))
+		for _, d := range item.Devices {
+			devices = append(devices, d.String())
+		}
+
+		result = append(result, &controlv1.AuditRecord{
+			Id:        item.Id,
+			Time:      timestamppb.New(item.Time),
+			Actor:     item.Actor,
+			Peer:      item.Peer,
+			Method:    item.Method,
+			DeviceIds: devices,
+			Group:     item.Group,
+			Payload:   item.Payload,
+			Previous:  item.Previous,
+			New:       item.New,
+			Result:    item.Result,
+			Error:     item.Error,
+			PrevHash:  item.PrevHash,
+			Hash:      item.Hash,
+		})
+	}
+
+	return &controlv1.AuditLogResponse{Items: result}, nil
+}
+
+func (s *serverApi) VerifyAuditLog(
+	ctx context.Context,
+	req *controlv1.VerifyAuditLogRequest,
+) (*controlv1.VerifyAuditLogResponse, error) {
+	result, err := s.control.VerifyAuditLog(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &controlv1.VerifyAuditLogResponse{
+		Valid:    result.Valid,
+		Records:  result.Records,
+		BrokenAt: result.BrokenAt,
+		Reason:   result.Reason,
+		Head:     result.Head,
+	}, nil
+}
+
+// queryMethods only read, though they need PermissionAdmin, and are not
+// audited like the calls needing PermissionRead.
+var queryMethods = map[string]bool{
+	controlv1.Control_EnrollmentTokenList_FullMethodName: true,
+	controlv1.Control_OperatorList_FullMethodName:        true,
+	controlv1.Control_RoleList_FullMethodName:            true,
+	controlv1.Control_AuditLog_FullMethodName:            true,
+	controlv1.Control_VerifyAuditLog_FullMethodName:      true,
+}
+
+// secretFields are hidden in the audited requests.
+var secretFields = map[protoreflect.Name]bool{
+	"secret": true,
+	"token":  true,
+}
+
+// AuditInterceptor appends a record to the audit log for every Control call
+// that changes something, whatever its result. Dry runs change nothing and
+// are not recorded. It precedes UnaryInterceptor, so the calls the operator
+// is not allowed to make are recorded as well.
+//
+// A call whose record can not be appended fails with codes.Internal. The
+// change itself has already been made by then, the error tells the operator
+// that it is missing from the audit log.
+func AuditInterceptor(log *slog.Logger, audit Audit) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		if !audited(info.FullMethod, req) {
+			return handler(ctx, req)
+		}
+
+		ctx, call := auditsrv.WithCall(ctx)
+
+		res, err := handler(ctx, req)
+
+		record := models.AuditRecord{
+			Time:     time.Now(),
+			Actor:    call.Actor,
+			Method:   info.FullMethod[len(controlPrefix):],
+			Payload:  auditPayload(req),
+			Previous: call.Previous,
+			New:      call.New,
+			Result:   status.Code(err).String(),
+		}
+		if err != nil {
+			record.Error = status.Convert(err).Message()
+		}
+
+		if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+			record.Peer = p.Addr.String()
+		}
+
+		// при неверных идентификаторах устройств вызов отклонен, запись
+		// остается без устройств
+		if target, targetErr := accessTarget(req); targetErr == nil {
+			record.Devices = target.Devices
+			record.Group = target.Group
+		}
+
+		// изменение без записи в журнале не должно выглядеть успешным
+		if auditErr := audit.Record(context.WithoutCancel(ctx), record); auditErr != nil {
+			log.Error(
+				"failed to append audit record",
+				slog.String("method", info.FullMethod),
+				slog.String("result", record.Result),
+				slog.Any("error", auditErr),
+			)
+
+			return nil, status.Error(codes.Internal, "the call is not recorded in the audit log")
+		}
+
+		return res, err
+	}
+}
+
+func audited(method string, req any) bool {
+	if !strings.HasPrefix(method, controlPrefix) || queryMethods[method] {
+		return false
+	}
+
+	if r, ok := req.(interface{ GetDryRun() bool }); ok && r.GetDryRun() {
+		return false
+	}
+
+	permission, ok := methodPermissions[method]
+
+	return !ok || permission != models.PermissionRead
+}
+
+// auditPayload returns the request in JSON with the secret fields hidden.
+func auditPayload(req any) string {
+	m, ok := req.(proto.Message)
+	if !ok {
+		return ""
+	}
+
+	m = proto.Clone(m)
+	hideSecrets(m.ProtoReflect())
+
+	data, err := protojson.Marshal(m)
+	if err != nil {
+		return ""
+	}
+
+	return string(data)
+}
+
+func hideSecrets(m protoreflect.Message) {
+	m.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		switch {
+		case secretFields[fd.Name()] && fd.Kind() == protoreflect.StringKind && !fd.IsList() && !fd.IsMap():
+			m.Set(fd, protoreflect.ValueOfString("***"))
+		case fd.Kind() == protoreflect.MessageKind && fd.IsList():
+			list := v.List()
+			for i := 0; i < list.Len(); i++ {
+				hideSecrets(list.Get(i).Message())
+			}
+		case fd.Kind() == protoreflect.MessageKind && !fd.IsMap():
+			hideSecrets(v.Message())
+		}
+
+		return true
+	})
+}
@@ -0,0 +1,116 @@
+package controlgrpc
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"testing"
+
+	controlv1 "github.com/dvaxert/mdm/api/gen/go/control"
+	"github.com/dvaxert/mdm/internal/domain/models"
+	"github.com/google/uuid"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type fakeAudit struct {
+	records []models.AuditRecord
+	err     error
+}
+
+func (a *fakeAudit) Record(ctx context.Context, record models.AuditRecord) error {
+	if a.err != nil {
+		return a.err
+	}
+
+	a.records = append(a.records, record)
+
+	return nil
+}
+
+func TestAuditInterceptor(t *testing.T) {
+	device := uuid.NewString()
+
+	tests := []struct {
+		name      string
+		method    string
+		req       any
+		handleErr error
+		auditErr  error
+		want      codes.Code
+		recorded  bool
+	}{
+		{
+			name:     "change",
+			method:   controlv1.Control_SetDeviceFeatureState_FullMethodName,
+			req:      &controlv1.SetDeviceFeatureStateRequest{DeviceId: device},
+			want:     codes.OK,
+			recorded: true,
+		},
+		{
+			name:      "failed change",
+			method:    controlv1.Control_SetDeviceFeatureState_FullMethodName,
+			req:       &controlv1.SetDeviceFeatureStateRequest{DeviceId: device},
+			handleErr: status.Error(codes.NotFound, "device not found"),
+			want:      codes.NotFound,
+			recorded:  true,
+		},
+		{
+			name:     "change not recorded",
+			method:   controlv1.Control_SetDeviceFeatureState_FullMethodName,
+			req:      &controlv1.SetDeviceFeatureStateRequest{DeviceId: device},
+			auditErr: errors.New("disk is full"),
+			want:     codes.Internal,
+		},
+		{
+			name:     "read",
+			method:   controlv1.Control_DeviceInfo_FullMethodName,
+			req:      &controlv1.DeviceInfoRequest{DeviceId: device},
+			auditErr: errors.New("disk is full"),
+			want:     codes.OK,
+		},
+		{
+			name:     "dry run",
+			method:   controlv1.Control_BulkSetFeatureState_FullMethodName,
+			req:      &controlv1.BulkSetFeatureStateRequest{DeviceIds: []string{device}, DryRun: true},
+			auditErr: errors.New("disk is full"),
+			want:     codes.OK,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			audit := &fakeAudit{err: tt.auditErr}
+			intercept := AuditInterceptor(slog.New(slog.NewTextHandler(io.Discard, nil)), audit)
+
+			handled := false
+			_, err := intercept(context.Background(), tt.req, &grpc.UnaryServerInfo{FullMethod: tt.method},
+				func(ctx context.Context, req any) (any, error) {
+					handled = true
+					return nil, tt.handleErr
+				},
+			)
+
+			if !handled {
+				t.Fatalf("handler is not called")
+			}
+
+			if got := status.Code(err); got != tt.want {
+				t.Errorf("call code = %s, want %s (%v)", got, tt.want, err)
+			}
+
+			if recorded := len(audit.records) == 1; recorded != tt.recorded {
+				t.Fatalf("recorded = %t, want %t", recorded, tt.recorded)
+			}
+
+			if tt.recorded {
+				record := audit.records[0]
+				if record.Result != status.Code(tt.handleErr).String() || len(record.Devices) != 1 {
+					t.Errorf("record = %+v, want result %s for one device", record, status.Code(tt.handleErr))
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,268 @@
+package controlgrpc
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	controlv1 "github.com/dvaxert/mdm/api/gen/go/control"
+	"github.com/dvaxert/mdm/internal/domain/models"
+	auditsrv "github.com/dvaxert/mdm/internal/server/services/audit"
+	authsrv "github.com/dvaxert/mdm/internal/server/services/auth"
+	"github.com/google/uuid"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+type Auth interface {
+	Enabled() bool
+	Authenticate(ctx context.Context, token string, certName string) (models.Operator, error)
+	Authorize(ctx context.Context, operator models.Operator, permission models.Permission, target models.AccessTarget) error
+	Scope(ctx context.Context, operator models.Operator, permission models.Permission) (*models.DeviceScope, error)
+}
+
+// methodPermissions are the permissions the calls need, calls missing here
+// need PermissionAdmin.
+var methodPermissions = map[string]models.Permission{
+	controlv1.Control_DeviceList_FullMethodName:          models.PermissionRead,
+	controlv1.Control_DeviceInfo_FullMethodName:          models.PermissionRead,
+	controlv1.Control_DeviceStatus_FullMethodName:        models.PermissionRead,
+	controlv1.Control_DeviceStatusHistory_FullMethodName: models.PermissionRead,
+	controlv1.Control_DeviceFeatures_FullMethodName:      models.PermissionRead,
+	controlv1.Control_DeviceInventory_FullMethodName:     models.PermissionRead,
+	controlv1.Control_DeviceInfoList_FullMethodName:      models.PermissionRead,
+	controlv1.Control_DeviceStatusList_FullMethodName:    models.PermissionRead,
+	controlv1.Control_DeviceFeaturesList_FullMethodName:  models.PermissionRead,
+	controlv1.Control_DeviceDriftList_FullMethodName:     models.PermissionRead,
+	controlv1.Control_FeatureInfo_FullMethodName:         models.PermissionRead,
+	controlv1.Control_FeatureList_FullMethodName:         models.PermissionRead,
+	controlv1.Control_GroupInfo_FullMethodName:           models.PermissionRead,
+	controlv1.Control_GroupList_FullMethodName:           models.PermissionRead,
+	controlv1.Control_ProfileInfo_FullMethodName:         models.PermissionRead,
+	controlv1.Control_ProfileList_FullMethodName:         models.PermissionRead,
+	controlv1.Control_AppList_FullMethodName:             models.PermissionRead,
+	controlv1.Control_DeviceAppCompliance_FullMethodName: models.PermissionRead,
+	controlv1.Control_AppViolationList_FullMethodName:    models.PermissionRead,
+	controlv1.Control_AlertRuleList_FullMethodName:       models.PermissionRead,
+	controlv1.Control_AlertList_FullMethodName:           models.PermissionRead,
+	controlv1.Control_ComplianceRuleList_FullMethodName:  models.PermissionRead,
+	controlv1.Control_DeviceCompliance_FullMethodName:    models.PermissionRead,
+	controlv1.Control_ComplianceSummary_FullMethodName:   models.PermissionRead,
+	controlv1.Control_WebhookList_FullMethodName:         models.PermissionRead,
+	controlv1.Control_CommandInfo_FullMethodName:         models.PermissionRead,
+	controlv1.Control_CommandList_FullMethodName:         models.PermissionRead,
+	controlv1.Control_CertificateList_FullMethodName:     models.PermissionRead,
+	controlv1.Control_WatchEvents_FullMethodName:         models.PermissionRead,
+
+	controlv1.Control_SetDeviceFeatureState_FullMethodName:   models.PermissionFeatures,
+	controlv1.Control_BulkSetFeatureState_FullMethodName:     models.PermissionFeatures,
+	controlv1.Control_UpdateDeviceLabels_FullMethodName:      models.PermissionFeatures,
+	controlv1.Control_ResetDeviceFeatureState_FullMethodName: models.PermissionFeatures,
+	controlv1.Control_SetGroupFeatureState_FullMethodName:    models.PermissionFeatures,
+	controlv1.Control_ClearGroupFeatureState_FullMethodName:  models.PermissionFeatures,
+	controlv1.Control_AcknowledgeAlert_FullMethodName:        models.PermissionFeatures,
+
+	controlv1.Control_CreateCommand_FullMethodName: models.PermissionCommands,
+}
+
+// catalogMethods do not touch devices, scoped roles allow them as well.
+var catalogMethods = map[string]bool{
+	controlv1.Control_FeatureInfo_FullMethodName:        true,
+	controlv1.Control_FeatureList_FullMethodName:        true,
+	controlv1.Control_ProfileList_FullMethodName:        true,
+	controlv1.Control_AppList_FullMethodName:            true,
+	controlv1.Control_AlertRuleList_FullMethodName:      true,
+	controlv1.Control_ComplianceRuleList_FullMethodName: true,
+}
+
+// listMethods list devices or their records, scoped roles allow them and
+// the list is limited to the scope of the operator.
+var listMethods = map[string]bool{
+	controlv1.Control_DeviceList_FullMethodName:         true,
+	controlv1.Control_DeviceInfoList_FullMethodName:     true,
+	controlv1.Control_DeviceStatusList_FullMethodName:   true,
+	controlv1.Control_DeviceFeaturesList_FullMethodName: true,
+	controlv1.Control_DeviceDriftList_FullMethodName:    true,
+	controlv1.Control_AppViolationList_FullMethodName:   true,
+	controlv1.Control_AlertList_FullMethodName:          true,
+	controlv1.Control_ComplianceSummary_FullMethodName:  true,
+	controlv1.Control_CommandList_FullMethodName:        true,
+}
+
+// controlPrefix отделяет вызовы Control от вызовов Management на том же
+// сервере, устройства аутентифицируются иначе
+var controlPrefix = "/" + controlv1.Control_ServiceDesc.ServiceName + "/"
+
+// UnaryInterceptor authenticates the operator of every Control call and
+// checks that its roles allow the call on the devices and the group of the
+// request. The operator and the scope lists are limited to are passed to
+// the handler in the context, see authsrv.OperatorFromContext and
+// authsrv.ScopeFromContext.
+func UnaryInterceptor(auth Auth) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		if !auth.Enabled() || !strings.HasPrefix(info.FullMethod, controlPrefix) {
+			return handler(ctx, req)
+		}
+
+		ctx, err := authorize(ctx, auth, info.FullMethod, req)
+		if err != nil {
+			return nil, err
+		}
+
+		return handler(ctx, req)
+	}
+}
+
+// StreamInterceptor is UnaryInterceptor for the streaming calls. The
+// request of a stream is read by the handler, so the call is authorized
+// when the first message is received.
+func StreamInterceptor(auth Auth) grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if !auth.Enabled() || !strings.HasPrefix(info.FullMethod, controlPrefix) {
+			return handler(srv, ss)
+		}
+
+		return handler(srv, &authorizedStream{ServerStream: ss, ctx: ss.Context(), auth: auth, method: info.FullMethod})
+	}
+}
+
+type authorizedStream struct {
+	grpc.ServerStream
+	ctx        context.Context
+	auth       Auth
+	method     string
+	authorized bool
+}
+
+func (s *authorizedStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *authorizedStream) RecvMsg(m any) error {
+	if err := s.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+
+	if s.authorized {
+		return nil
+	}
+
+	ctx, err := authorize(s.ctx, s.auth, s.method, m)
+	if err != nil {
+		return err
+	}
+
+	s.ctx = ctx
+	s.authorized = true
+
+	return nil
+}
+
+func authorize(ctx context.Context, auth Auth, method string, req any) (context.Context, error) {
+	operator, err := auth.Authenticate(ctx, bearerToken(ctx), peerName(ctx))
+	if errors.Is(err, authsrv.ErrUnauthenticated) {
+		return nil, status.Error(codes.Unauthenticated, "operator token or certificate is required")
+	}
+	if err != nil {
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	auditsrv.SetActor(ctx, operator.Name)
+
+	target, err := accessTarget(req)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	target.Catalog = catalogMethods[method]
+	target.List = listMethods[method]
+
+	permission, ok := methodPermissions[method]
+	if !ok {
+		permission = models.PermissionAdmin
+	}
+
+	err = auth.Authorize(ctx, operator, permission, target)
+	if errors.Is(err, authsrv.ErrPermissionDenied) {
+		return nil, status.Errorf(codes.PermissionDenied, "operator %s is not allowed to %s", operator.Name, method[len(controlPrefix):])
+	}
+	if err != nil {
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	if target.List {
+		scope, err := auth.Scope(ctx, operator, permission)
+		if err != nil {
+			return nil, status.Error(codes.Internal, "internal error")
+		}
+
+		ctx = authsrv.WithScope(ctx, scope)
+	}
+
+	return authsrv.WithOperator(ctx, operator), nil
+}
+
+// accessTarget returns the devices and the group the request works on.
+func accessTarget(req any) (models.AccessTarget, error) {
+	var target models.AccessTarget
+
+	if r, ok := req.(interface{ GetDeviceId() string }); ok && r.GetDeviceId() != "" {
+		id, err := uuid.Parse(r.GetDeviceId())
+		if err != nil {
+			return models.AccessTarget{}, errors.New("incorrect device id")
+		}
+
+		target.Devices = append(target.Devices, id)
+	}
+
+	if r, ok := req.(interface{ GetDeviceIds() []string }); ok {
+		for _, s := range r.GetDeviceIds() {
+			id, err := uuid.Parse(s)
+			if err != nil {
+				return models.AccessTarget{}, errors.New("incorrect device id")
+			}
+
+			target.Devices = append(target.Devices, id)
+		}
+	}
+
+	if r, ok := req.(interface{ GetGroup() string }); ok {
+		target.Group = r.GetGroup()
+	}
+
+	return target, nil
+}
+
+// bearerToken returns the token of the authorization metadata.
+func bearerToken(ctx context.Context) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+
+	for _, value := range md.Get("authorization") {
+		if token, ok := strings.CutPrefix(value, "Bearer "); ok {
+			return token
+		}
+	}
+
+	return ""
+}
+
+// peerName returns the subject common name of the verified client
+// certificate, empty if the client has not presented one.
+func peerName(ctx context.Context) string {
+	var info credentials.TLSInfo
+	if p, ok := peer.FromContext(ctx); ok {
+		info, _ = p.AuthInfo.(credentials.TLSInfo)
+	}
+
+	if len(info.State.VerifiedChains) == 0 {
+		return ""
+	}
+
+	return info.State.VerifiedChains[0][0].Subject.CommonName
+}
@@ -0,0 +1,402 @@
+package controlgrpc
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"io"
+	"log/slog"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"reflect"
+	"slices"
+	"testing"
+	"time"
+
+	controlv1 "github.com/dvaxert/mdm/api/gen/go/control"
+	"github.com/dvaxert/mdm/internal/domain/models"
+	"github.com/dvaxert/mdm/internal/server/ca"
+	authsrv "github.com/dvaxert/mdm/internal/server/services/auth"
+	"github.com/dvaxert/mdm/internal/server/storage"
+	"github.com/google/uuid"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+// testCA is an enrollment CA in a temporary directory. Devices get their
+// certificates from it as on the server, operator and server certificates
+// are signed with its key directly.
+type testCA struct {
+	ca   *ca.CA
+	cert *x509.Certificate
+	key  crypto.Signer
+	pool *x509.CertPool
+}
+
+func newTestCA(t *testing.T) *testCA {
+	t.Helper()
+
+	dir := t.TempDir()
+	certPath, keyPath := filepath.Join(dir, "ca.crt"), filepath.Join(dir, "ca.key")
+
+	c, err := ca.Load(certPath, keyPath, time.Hour)
+	if err != nil {
+		t.Fatalf("load ca: %v", err)
+	}
+
+	block, _ := pem.Decode(c.CertificatePEM())
+	cert, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		t.Fatalf("parse ca certificate: %v", err)
+	}
+
+	keyPEM, err := os.ReadFile(keyPath)
+	if err != nil {
+		t.Fatalf("read ca key: %v", err)
+	}
+
+	block, _ = pem.Decode(keyPEM)
+	key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		t.Fatalf("parse ca key: %v", err)
+	}
+
+	pool := x509.NewCertPool()
+	pool.AddCert(cert)
+
+	return &testCA{ca: c, cert: cert, key: key.(crypto.Signer), pool: pool}
+}
+
+// issue signs a certificate with the common name.
+func (c *testCA) issue(t *testing.T, name string, usage x509.ExtKeyUsage) tls.Certificate {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("generate key: %v", err)
+	}
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(time.Now().UnixNano()),
+		Subject:      pkix.Name{CommonName: name},
+		DNSNames:     []string{name},
+		NotBefore:    time.Now().Add(-time.Minute),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{usage},
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, c.cert, key.Public(), c.key)
+	if err != nil {
+		t.Fatalf("create certificate: %v", err)
+	}
+
+	return tls.Certificate{Certificate: [][]byte{der}, PrivateKey: key}
+}
+
+// enroll returns the certificate the CA issues to the device.
+func (c *testCA) enroll(t *testing.T, device_uuid uuid.UUID) tls.Certificate {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("generate key: %v", err)
+	}
+
+	der, err := x509.CreateCertificateRequest(rand.Reader, &x509.CertificateRequest{}, key)
+	if err != nil {
+		t.Fatalf("create csr: %v", err)
+	}
+
+	cert, err := c.ca.Sign(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE REQUEST", Bytes: der}), device_uuid)
+	if err != nil {
+		t.Fatalf("sign csr: %v", err)
+	}
+
+	block, _ := pem.Decode(cert.Pem)
+
+	return tls.Certificate{Certificate: [][]byte{block.Bytes}, PrivateKey: key}
+}
+
+type fakeOperators struct {
+	authsrv.StorageProvider
+	operators map[string]models.Operator
+	roles     []models.Role
+}
+
+func (s *fakeOperators) Operator(ctx context.Context, name string) (models.Operator, error) {
+	operator, ok := s.operators[name]
+	if !ok {
+		return models.Operator{}, storage.ErrOperatorNotFound
+	}
+
+	return operator, nil
+}
+
+func (s *fakeOperators) OperatorByToken(ctx context.Context, token_hash string) (models.Operator, error) {
+	for _, operator := range s.operators {
+		if operator.TokenHash != "" && operator.TokenHash == token_hash {
+			return operator, nil
+		}
+	}
+
+	return models.Operator{}, storage.ErrOperatorNotFound
+}
+
+func (s *fakeOperators) Roles(ctx context.Context, names []string) ([]models.Role, error) {
+	var result []models.Role
+	for _, role := range s.roles {
+		if slices.Contains(names, role.Name) {
+			result = append(result, role)
+		}
+	}
+
+	return result, nil
+}
+
+// serve starts a server that requests client certificates of the CA, as
+// the server does with client_ca set, and authorizes every call as a
+// DeviceList call. The names of the verified clients are sent to names.
+func serve(t *testing.T, c *testCA, auth Auth, names chan<- string) *bufconn.Listener {
+	t.Helper()
+
+	creds := credentials.NewTLS(&tls.Config{
+		Certificates: []tls.Certificate{c.issue(t, "mdm", x509.ExtKeyUsageServerAuth)},
+		ClientCAs:    c.pool,
+		ClientAuth:   tls.VerifyClientCertIfGiven,
+	})
+
+	intercept := func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		names <- peerName(ctx)
+
+		ctx, err := authorize(ctx, auth, controlv1.Control_DeviceList_FullMethodName, &controlv1.DeviceListRequest{})
+		if err != nil {
+			return nil, err
+		}
+
+		return handler(ctx, req)
+	}
+
+	lis := bufconn.Listen(1 << 20)
+	srv := grpc.NewServer(grpc.Creds(creds), grpc.UnaryInterceptor(intercept))
+	healthpb.RegisterHealthServer(srv, health.NewServer())
+
+	go srv.Serve(lis)
+	t.Cleanup(srv.Stop)
+
+	return lis
+}
+
+func TestOperatorCertificate(t *testing.T) {
+	c := newTestCA(t)
+	device := uuid.New()
+
+	auth := authsrv.New(slog.New(slog.NewTextHandler(io.Discard, nil)), &fakeOperators{
+		operators: map[string]models.Operator{
+			"alice": {Name: "alice", Roles: []string{"read-only"}},
+			"bob":   {Name: "bob"},
+		},
+	}, true)
+
+	names := make(chan string, 1)
+	lis := serve(t, c, auth, names)
+
+	tests := []struct {
+		name     string
+		cert     []tls.Certificate
+		wantName string
+		want     codes.Code
+	}{
+		{
+			name: "no certificate",
+			want: codes.Unauthenticated,
+		},
+		{
+			name:     "operator certificate",
+			cert:     []tls.Certificate{c.issue(t, "alice", x509.ExtKeyUsageClientAuth)},
+			wantName: "alice",
+			want:     codes.OK,
+		},
+		{
+			name:     "operator without roles",
+			cert:     []tls.Certificate{c.issue(t, "bob", x509.ExtKeyUsageClientAuth)},
+			wantName: "bob",
+			want:     codes.PermissionDenied,
+		},
+		{
+			name:     "unknown operator",
+			cert:     []tls.Certificate{c.issue(t, "mallory", x509.ExtKeyUsageClientAuth)},
+			wantName: "mallory",
+			want:     codes.Unauthenticated,
+		},
+		{
+			name:     "device certificate",
+			cert:     []tls.Certificate{c.enroll(t, device)},
+			wantName: device.String(),
+			want:     codes.Unauthenticated,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			conn, err := grpc.NewClient(
+				"passthrough:///mdm",
+				grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+					return lis.DialContext(ctx)
+				}),
+				grpc.WithTransportCredentials(credentials.NewTLS(&tls.Config{
+					Certificates: tt.cert,
+					RootCAs:      c.pool,
+					ServerName:   "mdm",
+				})),
+			)
+			if err != nil {
+				t.Fatalf("dial: %v", err)
+			}
+			defer conn.Close()
+
+			_, err = healthpb.NewHealthClient(conn).Check(context.Background(), &healthpb.HealthCheckRequest{})
+			if got := status.Code(err); got != tt.want {
+				t.Errorf("call code = %s, want %s (%v)", got, tt.want, err)
+			}
+
+			if name := <-names; name != tt.wantName {
+				t.Errorf("peerName() = %q, want %q", name, tt.wantName)
+			}
+		})
+	}
+}
+
+func TestOperatorCertificateNotTrusted(t *testing.T) {
+	c := newTestCA(t)
+	other := newTestCA(t)
+
+	auth := authsrv.New(slog.New(slog.NewTextHandler(io.Discard, nil)), &fakeOperators{
+		operators: map[string]models.Operator{"alice": {Name: "alice", Roles: []string{"full-admin"}}},
+	}, true)
+
+	names := make(chan string, 1)
+	lis := serve(t, c, auth, names)
+
+	// сертификат другого CA не проходит рукопожатие
+	conn, err := grpc.NewClient(
+		"passthrough:///mdm",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return lis.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(credentials.NewTLS(&tls.Config{
+			Certificates: []tls.Certificate{other.issue(t, "alice", x509.ExtKeyUsageClientAuth)},
+			RootCAs:      c.pool,
+			ServerName:   "mdm",
+		})),
+	)
+	if err != nil {
+		t.Fatalf("dial: %v", err)
+	}
+	defer conn.Close()
+
+	_, err = healthpb.NewHealthClient(conn).Check(context.Background(), &healthpb.HealthCheckRequest{})
+	if got := status.Code(err); got != codes.Unavailable {
+		t.Errorf("call code = %s, want %s (%v)", got, codes.Unavailable, err)
+	}
+
+	select {
+	case name := <-names:
+		t.Errorf("call reached the server as %q", name)
+	default:
+	}
+}
+
+func TestAuthorizeList(t *testing.T) {
+	auth := authsrv.New(slog.New(slog.NewTextHandler(io.Discard, nil)), &fakeOperators{
+		operators: map[string]models.Operator{
+			"alice": {Name: "alice", Roles: []string{"oslo"}, TokenHash: models.TokenHash("alice-token")},
+			"bob":   {Name: "bob", Roles: []string{"oslo", "read-only"}, TokenHash: models.TokenHash("bob-token")},
+		},
+		roles: []models.Role{{
+			Name:        "oslo",
+			Permissions: []models.Permission{models.PermissionRead, models.PermissionFeatures},
+			Groups:      []string{"oslo"},
+			Selector:    "site=oslo",
+		}},
+	}, true)
+
+	selector, err := models.ParseSelector("site=oslo")
+	if err != nil {
+		t.Fatalf("ParseSelector() error = %v", err)
+	}
+	scope := &models.DeviceScope{Groups: []string{"oslo"}, Selectors: []models.Selector{selector}}
+
+	tests := []struct {
+		name      string
+		token     string
+		method    string
+		req       any
+		want      codes.Code
+		wantScope *models.DeviceScope
+	}{
+		{
+			name:      "scoped list",
+			token:     "alice-token",
+			method:    controlv1.Control_DeviceList_FullMethodName,
+			req:       &controlv1.DeviceListRequest{},
+			want:      codes.OK,
+			wantScope: scope,
+		},
+		{
+			name:      "scoped command list",
+			token:     "alice-token",
+			method:    controlv1.Control_CommandList_FullMethodName,
+			req:       &controlv1.CommandListRequest{},
+			want:      codes.OK,
+			wantScope: scope,
+		},
+		{
+			name:   "list with a role on every device",
+			token:  "bob-token",
+			method: controlv1.Control_DeviceList_FullMethodName,
+			req:    &controlv1.DeviceListRequest{},
+			want:   codes.OK,
+		},
+		{
+			name:   "scoped call without a target",
+			token:  "alice-token",
+			method: controlv1.Control_WatchEvents_FullMethodName,
+			req:    &controlv1.WatchEventsRequest{},
+			want:   codes.PermissionDenied,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "Bearer "+tt.token))
+
+			ctx, err := authorize(ctx, auth, tt.method, tt.req)
+			if got := status.Code(err); got != tt.want {
+				t.Fatalf("authorize() code = %s, want %s (%v)", got, tt.want, err)
+			}
+
+			if err != nil {
+				return
+			}
+
+			if got := authsrv.ScopeFromContext(ctx); !reflect.DeepEqual(got, tt.wantScope) {
+				t.Errorf("ScopeFromContext() = %+v, want %+v", got, tt.wantScope)
+			}
+		})
+	}
+}
@@ -0,0 +1,125 @@
+package controlgrpc
+
+import (
+	"context"
+	"errors"
+
+	controlv1 "github.com/dvaxert/mdm/api/gen/go/control"
+	"github.com/dvaxert/mdm/internal/domain/models"
+	authsrv "github.com/dvaxert/mdm/internal/server/services/auth"
+	controlsrv "github.com/dvaxert/mdm/internal/server/services/control"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *serverApi) CreateCommand(
+	ctx context.Context,
+	req *controlv1.CreateCommandRequest,
+) (*controlv1.CreateCommandResponse, error) {
+	if req.DeviceId == "" {
+		return nil, status.Error(codes.InvalidArgument, "device id is required")
+	}
+
+	device_uuid, err := uuid.Parse(req.GetDeviceId())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "incorrect device id")
+	}
+
+	ttl := req.GetTtl().AsDuration()
+	if ttl < 0 {
+		return nil, status.Error(codes.InvalidArgument, "negative ttl")
+	}
+
+	id, err := s.control.CreateCommand(ctx, models.Command{
+		DeviceUuid: device_uuid,
+		Type:       models.CommandType(req.GetType()),
+		Payload:    req.GetPayload(),
+	}, ttl)
+	if err != nil {
+		return nil, commandStatus(err)
+	}
+
+	return &controlv1.CreateCommandResponse{Id: id}, nil
+}
+
+func (s *serverApi) CommandInfo(
+	ctx context.Context,
+	req *controlv1.CommandInfoRequest,
+) (*controlv1.CommandInfoResponse, error) {
+	if req.Id <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "command id is required")
+	}
+
+	command, err := s.control.Command(ctx, req.GetId())
+	if err != nil {
+		return nil, commandStatus(err)
+	}
+
+	return &controlv1.CommandInfoResponse{Command: commandToProto(command)}, nil
+}
+
+func (s *serverApi) CommandList(
+	ctx context.Context,
+	req *controlv1.CommandListRequest,
+) (*controlv1.CommandListResponse, error) {
+	if req.Limit < 0 {
+		return nil, status.Error(codes.InvalidArgument, "negative limit")
+	}
+
+	filter := models.CommandFilter{
+		PendingOnly: req.GetPendingOnly(),
+		Limit:       int(req.GetLimit()),
+		Scope:       authsrv.ScopeFromContext(ctx),
+	}
+
+	if req.DeviceId != "" {
+		id, err := uuid.Parse(req.GetDeviceId())
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, "incorrect device id")
+		}
+
+		filter.Device = id
+	}
+
+	list, err := s.control.CommandList(ctx, filter)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	result := make([]*controlv1.Command, 0, len(list))
+	for _, item := range list {
+		result = append(result, commandToProto(item))
+	}
+
+	return &controlv1.CommandListResponse{Items: result}, nil
+}
+
+func commandStatus(err error) error {
+	switch {
+	case errors.Is(err, models.ErrInvalidCommand):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, controlsrv.ErrCommandNotFound):
+		return status.Error(codes.NotFound, "command not found")
+	case errors.Is(err, controlsrv.ErrDeviceNotFound):
+		return status.Error(codes.NotFound, "device not found")
+	}
+
+	return status.Error(codes.Internal, err.Error())
+}
+
+func commandToProto(command models.Command) *controlv1.Command {
+	return &controlv1.Command{
+		Id:             command.Id,
+		DeviceId:       command.DeviceUuid.String(),
+		Type:           controlv1.CommandType(command.Type),
+		Payload:        command.Payload,
+		Status:         controlv1.CommandStatus(command.Status),
+		Result:         command.Result,
+		CreatedAt:      timeToProto(command.CreatedAt),
+		DeliveredAt:    timeToProto(command.DeliveredAt),
+		AcknowledgedAt: timeToProto(command.AcknowledgedAt),
+		CompletedAt:    timeToProto(command.CompletedAt),
+		ExpiresAt:      timeToProto(command.ExpiresAt),
+	}
+}
@@ -0,0 +1,198 @@
+package controlgrpc
+
+import (
+	"context"
+	"errors"
+
+	controlv1 "github.com/dvaxert/mdm/api/gen/go/control"
+	"github.com/dvaxert/mdm/internal/domain/models"
+	controlsrv "github.com/dvaxert/mdm/internal/server/services/control"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+func (s *serverApi) CreateComplianceRule(
+	ctx context.Context,
+	req *controlv1.CreateComplianceRuleRequest,
+) (*controlv1.CreateComplianceRuleResponse, error) {
+	rule := req.GetRule()
+	if rule == nil {
+		return nil, status.Error(codes.InvalidArgument, "compliance rule is required")
+	}
+
+	err := s.control.CreateComplianceRule(ctx, models.ComplianceRule{
+		Name:      rule.GetName(),
+		Condition: models.ComplianceCondition(rule.GetCondition()),
+		OsName:    rule.GetOsName(),
+		Version:   rule.GetVersion(),
+		Feature:   rule.GetFeature(),
+		Threshold: int(rule.GetThreshold()),
+		Duration:  rule.GetDuration().AsDuration(),
+		Selector:  rule.GetSelector(),
+	})
+	if err != nil {
+		return nil, complianceStatus(err)
+	}
+
+	return &controlv1.CreateComplianceRuleResponse{Success: true}, nil
+}
+
+func (s *serverApi) DeleteComplianceRule(
+	ctx context.Context,
+	req *controlv1.DeleteComplianceRuleRequest,
+) (*controlv1.DeleteComplianceRuleResponse, error) {
+	if req.Name == "" {
+		return nil, status.Error(codes.InvalidArgument, "compliance rule name is required")
+	}
+
+	if err := s.control.DeleteComplianceRule(ctx, req.GetName()); err != nil {
+		return nil, complianceStatus(err)
+	}
+
+	return &controlv1.DeleteComplianceRuleResponse{Success: true}, nil
+}
+
+func (s *serverApi) ComplianceRuleList(
+	ctx context.Context,
+	req *controlv1.ComplianceRuleListRequest,
+) (*controlv1.ComplianceRuleListResponse, error) {
+	list, err := s.control.ComplianceRuleList(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	result := make([]*controlv1.ComplianceRule, 0, len(list))
+	for _, item := range list {
+		result = append(result, complianceRuleToProto(item))
+	}
+
+	return &controlv1.ComplianceRuleListResponse{Items: result}, nil
+}
+
+func (s *serverApi) DeviceCompliance(
+	ctx context.Context,
+	req *controlv1.DeviceComplianceRequest,
+) (*controlv1.DeviceComplianceResponse, error) {
+	if req.DeviceId == "" {
+		return nil, status.Error(codes.InvalidArgument, "device id is required")
+	}
+
+	id, err := uuid.Parse(req.GetDeviceId())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "incorrect device id")
+	}
+
+	if req.HistoryLimit < 0 {
+		return nil, status.Error(codes.InvalidArgument, "negative history limit")
+	}
+
+	compliance, history, err := s.control.DeviceCompliance(ctx, id, int(req.GetHistoryLimit()))
+	if err != nil {
+		return nil, complianceStatus(err)
+	}
+
+	records := make([]*controlv1.ComplianceRecord, 0, len(history))
+	for _, r := range history {
+		records = append(records, &controlv1.ComplianceRecord{
+			Status:     controlv1.ComplianceStatus(r.Status),
+			Reasons:    complianceReasonsToProto(r.Reasons),
+			RecordedAt: timeToProto(r.RecordedAt),
+		})
+	}
+
+	return &controlv1.DeviceComplianceResponse{
+		Compliance: complianceToProto(compliance),
+		History:    records,
+	}, nil
+}
+
+func (s *serverApi) ComplianceSummary(
+	ctx context.Context,
+	req *controlv1.ComplianceSummaryRequest,
+) (*controlv1.ComplianceSummaryResponse, error) {
+	filter, err := filterFromProto(ctx, req.GetFilter())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	summary, err := s.control.ComplianceSummary(ctx, filter)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	rules := make([]*controlv1.RuleViolations, 0, len(summary.Rules))
+	for _, r := range summary.Rules {
+		rules = append(rules, &controlv1.RuleViolations{
+			Rule:      r.Rule,
+			Condition: controlv1.ComplianceCondition(r.Condition),
+			Devices:   int32(r.Devices),
+		})
+	}
+
+	return &controlv1.ComplianceSummaryResponse{
+		Total:        int32(summary.Total),
+		Compliant:    int32(summary.Compliant),
+		NonCompliant: int32(summary.NonCompliant),
+		Unknown:      int32(summary.Unknown),
+		Rules:        rules,
+	}, nil
+}
+
+func complianceStatus(err error) error {
+	switch {
+	case errors.Is(err, models.ErrInvalidComplianceRule):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, controlsrv.ErrComplianceRuleNotFound):
+		return status.Error(codes.NotFound, "compliance rule not found")
+	case errors.Is(err, controlsrv.ErrComplianceRuleExists):
+		return status.Error(codes.AlreadyExists, "compliance rule already exists")
+	case errors.Is(err, controlsrv.ErrFeatureNotFound):
+		return status.Error(codes.NotFound, "feature not found")
+	case errors.Is(err, controlsrv.ErrDeviceNotFound):
+		return status.Error(codes.NotFound, "device not found")
+	}
+
+	return status.Error(codes.Internal, err.Error())
+}
+
+func complianceRuleToProto(rule models.ComplianceRule) *controlv1.ComplianceRule {
+	result := &controlv1.ComplianceRule{
+		Name:      rule.Name,
+		Condition: controlv1.ComplianceCondition(rule.Condition),
+		OsName:    rule.OsName,
+		Version:   rule.Version,
+		Feature:   rule.Feature,
+		Threshold: int32(rule.Threshold),
+		Selector:  rule.Selector,
+	}
+
+	if rule.Duration != 0 {
+		result.Duration = durationpb.New(rule.Duration)
+	}
+
+	return result
+}
+
+func complianceReasonsToProto(reasons []models.ComplianceReason) []*controlv1.ComplianceReason {
+	result := make([]*controlv1.ComplianceReason, 0, len(reasons))
+	for _, r := range reasons {
+		result = append(result, &controlv1.ComplianceReason{
+			Rule:      r.Rule,
+			Condition: controlv1.ComplianceCondition(r.Condition),
+			Message:   r.Message,
+		})
+	}
+
+	return result
+}
+
+func complianceToProto(compliance models.DeviceCompliance) *controlv1.DeviceCompliance {
+	return &controlv1.DeviceCompliance{
+		Status:      controlv1.ComplianceStatus(compliance.Status),
+		Reasons:     complianceReasonsToProto(compliance.Reasons),
+		EvaluatedAt: timeToProto(compliance.EvaluatedAt),
+		Since:       timeToProto(compliance.Since),
+	}
+}
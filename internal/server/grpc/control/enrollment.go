@@ -0,0 +1,193 @@
+package controlgrpc
+
+import (
+	"context"
+	"errors"
+
+	controlv1 "github.com/dvaxert/mdm/api/gen/go/control"
+	"github.com/dvaxert/mdm/internal/domain/models"
+	controlsrv "github.com/dvaxert/mdm/internal/server/services/control"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (s *serverApi) CreateEnrollmentToken(
+	ctx context.Context,
+	req *controlv1.CreateEnrollmentTokenRequest,
+) (*controlv1.CreateEnrollmentTokenResponse, error) {
+	ttl := req.GetTtl().AsDuration()
+	if ttl < 0 {
+		return nil, status.Error(codes.InvalidArgument, "negative ttl")
+	}
+
+	token := models.EnrollmentToken{
+		Description: req.GetDescription(),
+		MaxUses:     int(req.GetMaxUses()),
+		Group:       req.GetGroup(),
+		Profile:     req.GetProfile(),
+		Labels:      req.GetLabels(),
+	}
+	if token.MaxUses == 0 {
+		token.MaxUses = 1
+	}
+
+	for _, t := range req.GetDeviceTypes() {
+		token.DeviceTypes = append(token.DeviceTypes, models.DeviceType(t))
+	}
+
+	secret, token, err := s.control.CreateEnrollmentToken(ctx, token, ttl)
+	if err != nil {
+		return nil, enrollmentStatus(err)
+	}
+
+	return &controlv1.CreateEnrollmentTokenResponse{
+		Token:     secret,
+		ExpiresAt: timestamppb.New(token.ExpiresAt),
+		Id:        token.Id,
+	}, nil
+}
+
+func (s *serverApi) EnrollmentTokenList(
+	ctx context.Context,
+	req *controlv1.EnrollmentTokenListRequest,
+) (*controlv1.EnrollmentTokenListResponse, error) {
+	list, err := s.control.EnrollmentTokenList(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	result := make([]*controlv1.EnrollmentToken, 0, len(list))
+	for _, item := range list {
+		result = append(result, enrollmentTokenToProto(item))
+	}
+
+	return &controlv1.EnrollmentTokenListResponse{Items: result}, nil
+}
+
+func (s *serverApi) RevokeEnrollmentToken(
+	ctx context.Context,
+	req *controlv1.RevokeEnrollmentTokenRequest,
+) (*controlv1.RevokeEnrollmentTokenResponse, error) {
+	if err := s.control.RevokeEnrollmentToken(ctx, req.GetId()); err != nil {
+		return nil, enrollmentStatus(err)
+	}
+
+	return &controlv1.RevokeEnrollmentTokenResponse{Success: true}, nil
+}
+
+func (s *serverApi) CertificateList(
+	ctx context.Context,
+	req *controlv1.CertificateListRequest,
+) (*controlv1.CertificateListResponse, error) {
+	var device_uuid uuid.UUID
+	if req.DeviceId != "" {
+		id, err := uuid.Parse(req.GetDeviceId())
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, "incorrect device id")
+		}
+
+		device_uuid = id
+	}
+
+	list, err := s.control.CertificateList(ctx, device_uuid)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	result := make([]*controlv1.Certificate, 0, len(list))
+	for _, item := range list {
+		result = append(result, &controlv1.Certificate{
+			Serial:    item.Serial,
+			DeviceId:  item.DeviceUuid.String(),
+			NotBefore: timeToProto(item.NotBefore),
+			NotAfter:  timeToProto(item.NotAfter),
+			IssuedAt:  timeToProto(item.IssuedAt),
+			RevokedAt: timeToProto(item.RevokedAt),
+		})
+	}
+
+	return &controlv1.CertificateListResponse{Items: result}, nil
+}
+
+func (s *serverApi) RevokeCertificate(
+	ctx context.Context,
+	req *controlv1.RevokeCertificateRequest,
+) (*controlv1.RevokeCertificateResponse, error) {
+	switch target := req.GetTarget().(type) {
+	case *controlv1.RevokeCertificateRequest_Serial:
+		if target.Serial == "" {
+			return nil, status.Error(codes.InvalidArgument, "certificate serial is required")
+		}
+
+		if err := s.control.RevokeCertificate(ctx, target.Serial); err != nil {
+			return nil, certificateStatus(err)
+		}
+
+		return &controlv1.RevokeCertificateResponse{Revoked: 1}, nil
+
+	case *controlv1.RevokeCertificateRequest_DeviceId:
+		id, err := uuid.Parse(target.DeviceId)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, "incorrect device id")
+		}
+
+		revoked, err := s.control.RevokeDeviceCertificates(ctx, id)
+		if err != nil {
+			return nil, certificateStatus(err)
+		}
+
+		return &controlv1.RevokeCertificateResponse{Revoked: int32(revoked)}, nil
+	}
+
+	return nil, status.Error(codes.InvalidArgument, "certificate serial or device id is required")
+}
+
+func certificateStatus(err error) error {
+	switch {
+	case errors.Is(err, controlsrv.ErrCertificateNotFound):
+		return status.Error(codes.NotFound, "certificate not found")
+	case errors.Is(err, controlsrv.ErrDeviceNotFound):
+		return status.Error(codes.NotFound, "device not found")
+	}
+
+	return status.Error(codes.Internal, err.Error())
+}
+
+func enrollmentStatus(err error) error {
+	switch {
+	case errors.Is(err, models.ErrInvalidEnrollmentToken), errors.Is(err, models.ErrInvalidLabel):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, controlsrv.ErrEnrollmentTokenNotFound):
+		return status.Error(codes.NotFound, "enrollment token not found")
+	case errors.Is(err, controlsrv.ErrGroupNotFound):
+		return status.Error(codes.NotFound, "group not found")
+	case errors.Is(err, controlsrv.ErrProfileNotFound):
+		return status.Error(codes.NotFound, "profile not found")
+	}
+
+	return status.Error(codes.Internal, err.Error())
+}
+
+func enrollmentTokenToProto(token models.EnrollmentToken) *controlv1.EnrollmentToken {
+	types := make([]int32, 0, len(token.DeviceTypes))
+	for _, t := range token.DeviceTypes {
+		types = append(types, int32(t))
+	}
+
+	return &controlv1.EnrollmentToken{
+		Id:          token.Id,
+		Description: token.Description,
+		MaxUses:     int32(token.MaxUses),
+		Uses:        int32(token.Uses),
+		DeviceTypes: types,
+		Group:       token.Group,
+		Profile:     token.Profile,
+		Labels:      token.Labels,
+		CreatedAt:   timeToProto(token.CreatedAt),
+		ExpiresAt:   timeToProto(token.ExpiresAt),
+		LastUsedAt:  timeToProto(token.LastUsedAt),
+		RevokedAt:   timeToProto(token.RevokedAt),
+	}
+}
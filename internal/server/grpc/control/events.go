@@ -0,0 +1,147 @@
+package controlgrpc
+
+import (
+	"errors"
+	"slices"
+
+	controlv1 "github.com/dvaxert/mdm/api/gen/go/control"
+	"github.com/dvaxert/mdm/internal/domain/models"
+	controlsrv "github.com/dvaxert/mdm/internal/server/services/control"
+	"github.com/google/uuid"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *serverApi) WatchEvents(
+	req *controlv1.WatchEventsRequest,
+	stream grpc.ServerStreamingServer[controlv1.Event],
+) error {
+	filter := models.EventFilter{}
+
+	for _, device := range req.GetDeviceIds() {
+		id, err := uuid.Parse(device)
+		if err != nil {
+			return status.Error(codes.InvalidArgument, "incorrect device id")
+		}
+
+		filter.Devices = append(filter.Devices, id)
+	}
+
+	for _, t := range req.GetTypes() {
+		if int(t) < 0 || int(t) >= len(models.EventTypes) {
+			return status.Error(codes.InvalidArgument, "unknown event type")
+		}
+
+		filter.Types = append(filter.Types, models.EventTypes[t])
+	}
+
+	if req.AfterSeq < 0 {
+		return status.Error(codes.InvalidArgument, "negative sequence number")
+	}
+
+	events, err := s.control.WatchEvents(stream.Context(), filter, req.GetGroup(), req.GetAfterSeq())
+	if err != nil {
+		switch {
+		case errors.Is(err, controlsrv.ErrGroupNotFound):
+			return status.Error(codes.NotFound, "group not found")
+		case errors.Is(err, models.ErrEventSequenceExpired):
+			return status.Error(codes.OutOfRange, "events after the sequence are no longer kept")
+		case errors.Is(err, models.ErrEventSequenceUnknown):
+			return status.Error(codes.InvalidArgument, "sequence has not been reached")
+		}
+
+		return status.Error(codes.Internal, err.Error())
+	}
+
+	for event := range events {
+		if err = stream.Send(eventToProto(event)); err != nil {
+			return err
+		}
+	}
+
+	if err = stream.Context().Err(); err != nil {
+		return status.FromContextError(err).Err()
+	}
+
+	return status.Error(codes.Unavailable, "event stream closed by the server, resume after the last received seq")
+}
+
+func eventToProto(event models.Event) *controlv1.Event {
+	result := &controlv1.Event{
+		Seq:      event.Seq,
+		Id:       event.Id.String(),
+		Type:     controlv1.EventType(slices.Index(models.EventTypes, event.Type)),
+		DeviceId: event.DeviceUuid.String(),
+		Time:     timeToProto(event.Time),
+	}
+
+	switch data := event.Data.(type) {
+	case models.DeviceRegisteredData:
+		result.Details = &controlv1.Event_Registered{Registered: &controlv1.DeviceRegisteredEvent{
+			DeviceType: int32(data.DeviceType),
+			Labels:     data.Labels,
+			First:      data.First,
+		}}
+	case models.DevicePingedData:
+		result.Details = &controlv1.Event_Pinged{Pinged: &controlv1.DevicePingedEvent{
+			Location: data.Location,
+			Battery:  int32(data.Battery),
+		}}
+	case models.StatusChangedData:
+		result.Details = &controlv1.Event_StatusChanged{StatusChanged: &controlv1.StatusChangedEvent{
+			Location:         data.Location,
+			Battery:          int32(data.Battery),
+			PreviousLocation: data.PreviousLocation,
+			PreviousBattery:  int32(data.PreviousBattery),
+		}}
+	case models.BatteryBandChangedData:
+		result.Details = &controlv1.Event_BatteryBandChanged{BatteryBandChanged: &controlv1.BatteryBandChangedEvent{
+			Battery:      int32(data.Battery),
+			Band:         controlv1.BatteryBand(data.Band),
+			PreviousBand: controlv1.BatteryBand(data.PreviousBand),
+		}}
+	case models.PresenceChangedData:
+		result.Details = &controlv1.Event_PresenceChanged{PresenceChanged: &controlv1.PresenceChangedEvent{
+			Presence:         controlv1.Presence(data.Presence),
+			PreviousPresence: controlv1.Presence(data.PreviousPresence),
+		}}
+	case models.DeviceOfflineData:
+		result.Details = &controlv1.Event_Offline{Offline: &controlv1.DeviceOfflineEvent{
+			LastSeen: timeToProto(data.LastSeen),
+		}}
+	case models.FeatureDesiredData:
+		desired := &controlv1.FeatureDesiredEvent{
+			Feature:  data.Feature,
+			Revision: data.Revision,
+		}
+		if data.Value != nil {
+			desired.Value = valueToProto(*data.Value)
+		}
+
+		result.Details = &controlv1.Event_FeatureDesired{FeatureDesired: desired}
+	case models.StateAppliedData:
+		result.Details = &controlv1.Event_StateApplied{StateApplied: &controlv1.StateAppliedEvent{
+			Revision:        data.Revision,
+			DesiredRevision: data.DesiredRevision,
+		}}
+	case models.LifecycleChangedData:
+		result.Details = &controlv1.Event_LifecycleChanged{LifecycleChanged: &controlv1.LifecycleChangedEvent{
+			Lifecycle:         controlv1.Lifecycle(data.Lifecycle),
+			PreviousLifecycle: controlv1.Lifecycle(data.PreviousLifecycle),
+		}}
+	case models.InventoryChangedData:
+		result.Details = &controlv1.Event_InventoryChanged{InventoryChanged: &controlv1.InventoryChangedEvent{
+			Version: data.Version,
+			Changes: inventoryChangesToProto(data.Changes),
+		}}
+	case models.ComplianceChangedData:
+		result.Details = &controlv1.Event_ComplianceChanged{ComplianceChanged: &controlv1.ComplianceChangedEvent{
+			Status:         controlv1.ComplianceStatus(data.Status),
+			PreviousStatus: controlv1.ComplianceStatus(data.PreviousStatus),
+			Reasons:        complianceReasonsToProto(data.Reasons),
+		}}
+	}
+
+	return result
+}
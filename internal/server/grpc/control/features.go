@@ -0,0 +1,239 @@
+package controlgrpc
+
+import (
+	"context"
+	"errors"
+
+	controlv1 "github.com/dvaxert/mdm/api/gen/go/control"
+	"github.com/dvaxert/mdm/internal/domain/models"
+	controlsrv "github.com/dvaxert/mdm/internal/server/services/control"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *serverApi) CreateFeature(
+	ctx context.Context,
+	req *controlv1.CreateFeatureRequest,
+) (*controlv1.CreateFeatureResponse, error) {
+	if req.Name == "" {
+		return nil, status.Error(codes.InvalidArgument, "feature name is required")
+	}
+
+	types := make([]models.DeviceType, 0, len(req.GetDeviceTypes()))
+	for _, t := range req.GetDeviceTypes() {
+		if t < 0 || models.DeviceType(t) >= models.DeviceTypeCount {
+			return nil, status.Error(codes.InvalidArgument, "incorrect device type")
+		}
+
+		types = append(types, models.DeviceType(t))
+	}
+
+	feature := models.Feature{
+		Name:        req.GetName(),
+		Description: req.GetDescription(),
+		Type:        models.FeatureType(req.GetType()),
+		Min:         req.IntMin,
+		Max:         req.IntMax,
+		EnumValues:  req.GetEnumValues(),
+		DeviceTypes: types,
+	}
+
+	// без default_value значением по умолчанию считается default_state для bool
+	// функций и нулевое значение для остальных
+	feature.Default = models.FeatureValue{Type: feature.Type, Bool: req.GetDefaultState()}
+	if req.DefaultValue != nil {
+		var err error
+		if feature.Default, err = valueFromProto(req.GetDefaultValue()); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+
+	if err := s.control.CreateFeature(ctx, feature); err != nil {
+		switch {
+		case errors.Is(err, controlsrv.ErrFeatureExists):
+			return nil, status.Error(codes.AlreadyExists, "feature already exists")
+		case errors.Is(err, models.ErrInvalidFeatureValue):
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &controlv1.CreateFeatureResponse{Success: true}, nil
+}
+
+func (s *serverApi) FeatureInfo(
+	ctx context.Context,
+	req *controlv1.FeatureInfoRequest,
+) (*controlv1.FeatureInfoResponse, error) {
+	if req.Name == "" {
+		return nil, status.Error(codes.InvalidArgument, "feature name is required")
+	}
+
+	feature, err := s.control.Feature(ctx, req.GetName())
+	if err != nil {
+		if errors.Is(err, controlsrv.ErrFeatureNotFound) {
+			return nil, status.Error(codes.NotFound, "feature not found")
+		}
+
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &controlv1.FeatureInfoResponse{Feature: featureToProto(feature)}, nil
+}
+
+func (s *serverApi) FeatureList(
+	ctx context.Context,
+	req *controlv1.FeatureListRequest,
+) (*controlv1.FeatureListResponse, error) {
+	list, err := s.control.FeatureList(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	result := make([]*controlv1.Feature, 0, len(list))
+	for _, item := range list {
+		result = append(result, featureToProto(item))
+	}
+
+	return &controlv1.FeatureListResponse{Items: result}, nil
+}
+
+func (s *serverApi) DeprecateFeature(
+	ctx context.Context,
+	req *controlv1.DeprecateFeatureRequest,
+) (*controlv1.DeprecateFeatureResponse, error) {
+	if req.Name == "" {
+		return nil, status.Error(codes.InvalidArgument, "feature name is required")
+	}
+
+	if err := s.control.DeprecateFeature(ctx, req.GetName(), req.GetDeprecated()); err != nil {
+		if errors.Is(err, controlsrv.ErrFeatureNotFound) {
+			return nil, status.Error(codes.NotFound, "feature not found")
+		}
+
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &controlv1.DeprecateFeatureResponse{Success: true}, nil
+}
+
+func (s *serverApi) DeleteFeature(
+	ctx context.Context,
+	req *controlv1.DeleteFeatureRequest,
+) (*controlv1.DeleteFeatureResponse, error) {
+	if req.Name == "" {
+		return nil, status.Error(codes.InvalidArgument, "feature name is required")
+	}
+
+	if err := s.control.DeleteFeature(ctx, req.GetName()); err != nil {
+		if errors.Is(err, controlsrv.ErrFeatureNotFound) {
+			return nil, status.Error(codes.NotFound, "feature not found")
+		}
+
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &controlv1.DeleteFeatureResponse{Success: true}, nil
+}
+
+func featureToProto(feature models.Feature) *controlv1.Feature {
+	types := make([]int32, 0, len(feature.DeviceTypes))
+	for _, t := range feature.DeviceTypes {
+		types = append(types, int32(t))
+	}
+
+	return &controlv1.Feature{
+		Name:         feature.Name,
+		Description:  feature.Description,
+		DefaultState: feature.Default.Bool,
+		DeviceTypes:  types,
+		Deprecated:   feature.Deprecated,
+		Type:         controlv1.FeatureType(feature.Type),
+		DefaultValue: valueToProto(feature.Default),
+		IntMin:       feature.Min,
+		IntMax:       feature.Max,
+		EnumValues:   feature.EnumValues,
+	}
+}
+
+func (s *serverApi) BulkSetFeatureState(
+	ctx context.Context,
+	req *controlv1.BulkSetFeatureStateRequest,
+) (*controlv1.BulkSetFeatureStateResponse, error) {
+	if req.Feature == "" {
+		return nil, status.Error(codes.InvalidArgument, "feature is required")
+	}
+
+	if req.Value == nil {
+		return nil, status.Error(codes.InvalidArgument, "value is required")
+	}
+
+	value, err := valueFromProto(req.GetValue())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	target := models.BulkTarget{Group: req.GetGroup()}
+
+	for _, s := range req.GetDeviceIds() {
+		id, err := uuid.Parse(s)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, "incorrect device id")
+		}
+
+		target.Devices = append(target.Devices, id)
+	}
+
+	if target.Selector, err = models.ParseSelector(req.GetSelector()); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	result, err := s.control.BulkSetFeatureState(ctx, target, req.GetFeature(), value, req.GetDryRun())
+	if err != nil {
+		switch {
+		case errors.Is(err, models.ErrInvalidBulkTarget), errors.Is(err, models.ErrInvalidFeatureValue):
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		case errors.Is(err, controlsrv.ErrDeviceNotFound):
+			return nil, status.Error(codes.NotFound, "device not found")
+		case errors.Is(err, controlsrv.ErrGroupNotFound):
+			return nil, status.Error(codes.NotFound, "group not found")
+		case errors.Is(err, controlsrv.ErrFeatureNotFound):
+			return nil, status.Error(codes.NotFound, "feature not found")
+		case errors.Is(err, controlsrv.ErrFeatureDeprecated):
+			return nil, status.Error(codes.FailedPrecondition, "feature is deprecated")
+		}
+
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	changes := make([]*controlv1.FeatureChange, 0, len(result.Changes))
+	for _, c := range result.Changes {
+		change := &controlv1.FeatureChange{
+			DeviceId:   c.DeviceUuid.String(),
+			Overridden: c.Overridden,
+		}
+		if c.Previous != nil {
+			change.Previous = valueToProto(*c.Previous)
+		}
+
+		changes = append(changes, change)
+	}
+
+	return &controlv1.BulkSetFeatureStateResponse{
+		Changes:            changes,
+		UnchangedDeviceIds: uuidsToStrings(result.Unchanged),
+		SkippedDeviceIds:   uuidsToStrings(result.Skipped),
+		Applied:            !req.GetDryRun(),
+	}, nil
+}
+
+func uuidsToStrings(ids []uuid.UUID) []string {
+	result := make([]string, 0, len(ids))
+	for _, id := range ids {
+		result = append(result, id.String())
+	}
+
+	return result
+}
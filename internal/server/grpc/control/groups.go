@@ -0,0 +1,216 @@
+package controlgrpc
+
+import (
+	"context"
+	"errors"
+
+	controlv1 "github.com/dvaxert/mdm/api/gen/go/control"
+	"github.com/dvaxert/mdm/internal/domain/models"
+	controlsrv "github.com/dvaxert/mdm/internal/server/services/control"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *serverApi) CreateGroup(
+	ctx context.Context,
+	req *controlv1.CreateGroupRequest,
+) (*controlv1.CreateGroupResponse, error) {
+	if req.Name == "" {
+		return nil, status.Error(codes.InvalidArgument, "group name is required")
+	}
+
+	if err := s.control.CreateGroup(ctx, req.GetName(), req.GetParent()); err != nil {
+		return nil, groupStatus(err)
+	}
+
+	return &controlv1.CreateGroupResponse{Success: true}, nil
+}
+
+func (s *serverApi) DeleteGroup(
+	ctx context.Context,
+	req *controlv1.DeleteGroupRequest,
+) (*controlv1.DeleteGroupResponse, error) {
+	if req.Name == "" {
+		return nil, status.Error(codes.InvalidArgument, "group name is required")
+	}
+
+	if err := s.control.DeleteGroup(ctx, req.GetName()); err != nil {
+		return nil, groupStatus(err)
+	}
+
+	return &controlv1.DeleteGroupResponse{Success: true}, nil
+}
+
+func (s *serverApi) GroupInfo(
+	ctx context.Context,
+	req *controlv1.GroupInfoRequest,
+) (*controlv1.GroupInfoResponse, error) {
+	if req.Name == "" {
+		return nil, status.Error(codes.InvalidArgument, "group name is required")
+	}
+
+	group, err := s.control.Group(ctx, req.GetName())
+	if err != nil {
+		return nil, groupStatus(err)
+	}
+
+	return &controlv1.GroupInfoResponse{Group: groupToProto(group)}, nil
+}
+
+func (s *serverApi) GroupList(
+	ctx context.Context,
+	req *controlv1.GroupListRequest,
+) (*controlv1.GroupListResponse, error) {
+	list, err := s.control.GroupList(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	result := make([]*controlv1.Group, 0, len(list))
+	for _, item := range list {
+		result = append(result, groupToProto(item))
+	}
+
+	return &controlv1.GroupListResponse{Items: result}, nil
+}
+
+func (s *serverApi) AddGroupDevice(
+	ctx context.Context,
+	req *controlv1.AddGroupDeviceRequest,
+) (*controlv1.AddGroupDeviceResponse, error) {
+	if req.Group == "" {
+		return nil, status.Error(codes.InvalidArgument, "group name is required")
+	}
+
+	id, err := uuid.Parse(req.GetDeviceId())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "incorrect device id")
+	}
+
+	if err = s.control.AddGroupDevice(ctx, req.GetGroup(), id); err != nil {
+		return nil, groupStatus(err)
+	}
+
+	return &controlv1.AddGroupDeviceResponse{Success: true}, nil
+}
+
+func (s *serverApi) RemoveGroupDevice(
+	ctx context.Context,
+	req *controlv1.RemoveGroupDeviceRequest,
+) (*controlv1.RemoveGroupDeviceResponse, error) {
+	if req.Group == "" {
+		return nil, status.Error(codes.InvalidArgument, "group name is required")
+	}
+
+	id, err := uuid.Parse(req.GetDeviceId())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "incorrect device id")
+	}
+
+	if err = s.control.RemoveGroupDevice(ctx, req.GetGroup(), id); err != nil {
+		return nil, groupStatus(err)
+	}
+
+	return &controlv1.RemoveGroupDeviceResponse{Success: true}, nil
+}
+
+func (s *serverApi) SetGroupFeatureState(
+	ctx context.Context,
+	req *controlv1.SetGroupFeatureStateRequest,
+) (*controlv1.SetGroupFeatureStateResponse, error) {
+	if req.Group == "" {
+		return nil, status.Error(codes.InvalidArgument, "group name is required")
+	}
+
+	if req.Feature == "" {
+		return nil, status.Error(codes.InvalidArgument, "feature name is required")
+	}
+
+	value, err := valueFromProto(req.GetValue())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if err = s.control.SetGroupFeatureState(ctx, req.GetGroup(), req.GetFeature(), value); err != nil {
+		return nil, groupStatus(err)
+	}
+
+	return &controlv1.SetGroupFeatureStateResponse{Success: true}, nil
+}
+
+func (s *serverApi) ClearGroupFeatureState(
+	ctx context.Context,
+	req *controlv1.ClearGroupFeatureStateRequest,
+) (*controlv1.ClearGroupFeatureStateResponse, error) {
+	if req.Group == "" {
+		return nil, status.Error(codes.InvalidArgument, "group name is required")
+	}
+
+	if req.Feature == "" {
+		return nil, status.Error(codes.InvalidArgument, "feature name is required")
+	}
+
+	if err := s.control.ClearGroupFeatureState(ctx, req.GetGroup(), req.GetFeature()); err != nil {
+		return nil, groupStatus(err)
+	}
+
+	return &controlv1.ClearGroupFeatureStateResponse{Success: true}, nil
+}
+
+func (s *serverApi) ResetDeviceFeatureState(
+	ctx context.Context,
+	req *controlv1.ResetDeviceFeatureStateRequest,
+) (*controlv1.ResetDeviceFeatureStateResponse, error) {
+	id, err := uuid.Parse(req.GetDeviceId())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "incorrect device id")
+	}
+
+	if req.Feature == "" {
+		return nil, status.Error(codes.InvalidArgument, "feature name is required")
+	}
+
+	if err = s.control.ResetDeviceFeatureState(ctx, id, req.GetFeature()); err != nil {
+		return nil, groupStatus(err)
+	}
+
+	return &controlv1.ResetDeviceFeatureStateResponse{Success: true}, nil
+}
+
+// groupStatus translates the errors of the group operations to gRPC statuses.
+func groupStatus(err error) error {
+	switch {
+	case errors.Is(err, controlsrv.ErrGroupNotFound):
+		return status.Error(codes.NotFound, "group not found")
+	case errors.Is(err, controlsrv.ErrDeviceNotFound):
+		return status.Error(codes.NotFound, "device not found")
+	case errors.Is(err, controlsrv.ErrFeatureNotFound):
+		return status.Error(codes.NotFound, "feature not found")
+	case errors.Is(err, controlsrv.ErrGroupExists):
+		return status.Error(codes.AlreadyExists, "group already exists")
+	case errors.Is(err, controlsrv.ErrGroupHasSubgroups):
+		return status.Error(codes.FailedPrecondition, "group has subgroups")
+	case errors.Is(err, controlsrv.ErrFeatureDeprecated):
+		return status.Error(codes.FailedPrecondition, "feature is deprecated")
+	case errors.Is(err, models.ErrInvalidFeatureValue):
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return status.Error(codes.Internal, err.Error())
+}
+
+func groupToProto(group models.Group) *controlv1.Group {
+	devices := make([]string, 0, len(group.Devices))
+	for _, d := range group.Devices {
+		devices = append(devices, d.String())
+	}
+
+	return &controlv1.Group{
+		Name:      group.Name,
+		Parent:    group.Parent,
+		DeviceIds: devices,
+		Features:  valuesToProto(group.Features),
+		Profiles:  group.Profiles,
+	}
+}
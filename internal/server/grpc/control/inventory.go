@@ -0,0 +1,93 @@
+package controlgrpc
+
+import (
+	"context"
+	"errors"
+
+	controlv1 "github.com/dvaxert/mdm/api/gen/go/control"
+	"github.com/dvaxert/mdm/internal/domain/models"
+	controlsrv "github.com/dvaxert/mdm/internal/server/services/control"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *serverApi) DeviceInventory(
+	ctx context.Context,
+	req *controlv1.DeviceInventoryRequest,
+) (*controlv1.DeviceInventoryResponse, error) {
+	if req.GetDeviceId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "device id is required")
+	}
+
+	id, err := uuid.Parse(req.GetDeviceId())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "incorrect device id")
+	}
+
+	if req.GetVersion() < 0 {
+		return nil, status.Error(codes.InvalidArgument, "incorrect inventory version")
+	}
+
+	snapshot, err := s.control.DeviceInventory(ctx, id, req.GetVersion())
+	if err != nil {
+		switch {
+		case errors.Is(err, controlsrv.ErrDeviceNotFound):
+			return nil, status.Error(codes.NotFound, "device not found")
+		case errors.Is(err, controlsrv.ErrInventoryNotFound):
+			return nil, status.Error(codes.NotFound, "inventory not found")
+		}
+
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	return &controlv1.DeviceInventoryResponse{
+		Version:       snapshot.Version,
+		LatestVersion: snapshot.LatestVersion,
+		Inventory:     inventoryToProto(snapshot.Inventory),
+		Changes:       inventoryChangesToProto(snapshot.Changes),
+		ReportedAt:    timeToProto(snapshot.ReportedAt),
+		ConfirmedAt:   timeToProto(snapshot.ConfirmedAt),
+	}, nil
+}
+
+func inventoryToProto(i models.Inventory) *controlv1.Inventory {
+	result := &controlv1.Inventory{
+		OsName:       i.OsName,
+		OsVersion:    i.OsVersion,
+		Model:        i.Model,
+		Serial:       i.Serial,
+		StorageBytes: i.StorageBytes,
+	}
+
+	for _, n := range i.Interfaces {
+		result.Interfaces = append(result.Interfaces, &controlv1.NetworkInterface{
+			Name:      n.Name,
+			Mac:       n.Mac,
+			Addresses: n.Addresses,
+		})
+	}
+
+	for _, a := range i.Apps {
+		result.Apps = append(result.Apps, &controlv1.App{
+			Id:      a.Id,
+			Name:    a.Name,
+			Version: a.Version,
+		})
+	}
+
+	return result
+}
+
+func inventoryChangesToProto(changes []models.InventoryChange) []*controlv1.InventoryChange {
+	result := make([]*controlv1.InventoryChange, 0, len(changes))
+	for _, c := range changes {
+		result = append(result, &controlv1.InventoryChange{
+			Field:    c.Field,
+			Previous: c.Previous,
+			Current:  c.Current,
+		})
+	}
+
+	return result
+}
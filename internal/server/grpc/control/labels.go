@@ -0,0 +1,41 @@
+package controlgrpc
+
+import (
+	"context"
+	"errors"
+
+	controlv1 "github.com/dvaxert/mdm/api/gen/go/control"
+	"github.com/dvaxert/mdm/internal/domain/models"
+	controlsrv "github.com/dvaxert/mdm/internal/server/services/control"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *serverApi) UpdateDeviceLabels(
+	ctx context.Context,
+	req *controlv1.UpdateDeviceLabelsRequest,
+) (*controlv1.UpdateDeviceLabelsResponse, error) {
+	if req.GetDeviceId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "device id is required")
+	}
+
+	id, err := uuid.Parse(req.GetDeviceId())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "incorrect device id")
+	}
+
+	err = s.control.UpdateDeviceLabels(ctx, id, req.GetSet(), req.GetRemove())
+	if err != nil {
+		switch {
+		case errors.Is(err, models.ErrInvalidLabel):
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		case errors.Is(err, controlsrv.ErrDeviceNotFound):
+			return nil, status.Error(codes.NotFound, "device not found")
+		}
+
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	return &controlv1.UpdateDeviceLabelsResponse{Success: true}, nil
+}
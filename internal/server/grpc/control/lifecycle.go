@@ -0,0 +1,49 @@
+package controlgrpc
+
+import (
+	"context"
+	"errors"
+
+	controlv1 "github.com/dvaxert/mdm/api/gen/go/control"
+	"github.com/dvaxert/mdm/internal/domain/models"
+	controlsrv "github.com/dvaxert/mdm/internal/server/services/control"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *serverApi) SetDeviceLifecycle(
+	ctx context.Context,
+	req *controlv1.SetDeviceLifecycleRequest,
+) (*controlv1.SetDeviceLifecycleResponse, error) {
+	if req.GetDeviceId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "device id is required")
+	}
+
+	id, err := uuid.Parse(req.GetDeviceId())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "incorrect device id")
+	}
+
+	lifecycle := models.Lifecycle(req.GetLifecycle())
+	if lifecycle < 0 || lifecycle >= models.LifecycleCount {
+		return nil, status.Error(codes.InvalidArgument, "incorrect lifecycle state")
+	}
+
+	change, err := s.control.SetDeviceLifecycle(ctx, id, lifecycle)
+	if err != nil {
+		switch {
+		case errors.Is(err, controlsrv.ErrDeviceNotFound):
+			return nil, status.Error(codes.NotFound, "device not found")
+		case errors.Is(err, controlsrv.ErrLifecycleChange):
+			return nil, status.Errorf(codes.FailedPrecondition, "device cannot move to %s", lifecycle)
+		}
+
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	return &controlv1.SetDeviceLifecycleResponse{
+		PreviousLifecycle:   controlv1.Lifecycle(change.Previous),
+		RevokedCertificates: int32(change.Certificates),
+	}, nil
+}
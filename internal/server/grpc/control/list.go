@@ -0,0 +1,93 @@
+package controlgrpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	controlv1 "github.com/dvaxert/mdm/api/gen/go/control"
+	"github.com/dvaxert/mdm/internal/domain/models"
+	authsrv "github.com/dvaxert/mdm/internal/server/services/auth"
+	controlsrv "github.com/dvaxert/mdm/internal/server/services/control"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// filterFromProto converts the filter of a list request, a missing filter
+// selects every device. The list is limited to the scope of the operator,
+// see authsrv.ScopeFromContext.
+func filterFromProto(ctx context.Context, f *controlv1.DeviceFilter) (models.DeviceFilter, error) {
+	result := models.DeviceFilter{Scope: authsrv.ScopeFromContext(ctx)}
+	if f == nil {
+		return result, nil
+	}
+
+	selector, err := models.ParseSelector(f.GetSelector())
+	if err != nil {
+		return models.DeviceFilter{}, err
+	}
+	result.Selector = selector
+
+	for _, t := range f.GetDeviceTypes() {
+		if t < 0 || models.DeviceType(t) >= models.DeviceTypeCount {
+			return models.DeviceFilter{}, fmt.Errorf("incorrect device type %d", t)
+		}
+
+		result.Types = append(result.Types, models.DeviceType(t))
+	}
+
+	if f.BatteryMin != nil {
+		v := int(f.GetBatteryMin())
+		result.BatteryMin = &v
+	}
+
+	if f.BatteryMax != nil {
+		v := int(f.GetBatteryMax())
+		result.BatteryMax = &v
+	}
+
+	for _, l := range f.GetLifecycles() {
+		if l < 0 || models.Lifecycle(l) >= models.LifecycleCount {
+			return models.DeviceFilter{}, fmt.Errorf("incorrect lifecycle state %d", l)
+		}
+
+		result.Lifecycles = append(result.Lifecycles, models.Lifecycle(l))
+	}
+
+	return result, nil
+}
+
+// pageFromProto converts the paging fields of a list request, a zero size
+// stands for the default page size.
+func pageFromProto(size int32, token string, orderBy string) (models.Page, error) {
+	if size < 0 {
+		return models.Page{}, fmt.Errorf("incorrect page size %d", size)
+	}
+
+	order, err := models.ParseDeviceOrder(orderBy)
+	if err != nil {
+		return models.Page{}, err
+	}
+
+	page := models.Page{
+		Size:  int(size),
+		Token: token,
+		Order: order,
+	}
+
+	if page.Size == 0 {
+		page.Size = models.DefaultPageSize
+	}
+
+	page.Size = min(page.Size, models.MaxPageSize)
+
+	return page, nil
+}
+
+func listStatus(err error) error {
+	if errors.Is(err, controlsrv.ErrInvalidPageToken) {
+		return status.Error(codes.InvalidArgument, "invalid page token")
+	}
+
+	return status.Error(codes.Internal, err.Error())
+}
@@ -0,0 +1,192 @@
+package controlgrpc
+
+import (
+	"context"
+	"errors"
+
+	controlv1 "github.com/dvaxert/mdm/api/gen/go/control"
+	"github.com/dvaxert/mdm/internal/domain/models"
+	controlsrv "github.com/dvaxert/mdm/internal/server/services/control"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (s *serverApi) CreateOperator(
+	ctx context.Context,
+	req *controlv1.CreateOperatorRequest,
+) (*controlv1.CreateOperatorResponse, error) {
+	token, err := s.control.CreateOperator(ctx, req.GetName(), req.GetRoles())
+	if err != nil {
+		return nil, operatorStatus(err)
+	}
+
+	return &controlv1.CreateOperatorResponse{Token: token}, nil
+}
+
+func (s *serverApi) DeleteOperator(
+	ctx context.Context,
+	req *controlv1.DeleteOperatorRequest,
+) (*controlv1.DeleteOperatorResponse, error) {
+	if req.Name == "" {
+		return nil, status.Error(codes.InvalidArgument, "operator name is required")
+	}
+
+	if err := s.control.DeleteOperator(ctx, req.GetName()); err != nil {
+		return nil, operatorStatus(err)
+	}
+
+	return &controlv1.DeleteOperatorResponse{Success: true}, nil
+}
+
+func (s *serverApi) OperatorList(
+	ctx context.Context,
+	req *controlv1.OperatorListRequest,
+) (*controlv1.OperatorListResponse, error) {
+	list, err := s.control.OperatorList(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	result := make([]*controlv1.Operator, 0, len(list))
+	for _, item := range list {
+		result = append(result, &controlv1.Operator{
+			Name:       item.Name,
+			Roles:      item.Roles,
+			FromConfig: item.FromConfig,
+			CreatedAt:  timestamppb.New(item.CreatedAt),
+		})
+	}
+
+	return &controlv1.OperatorListResponse{Items: result}, nil
+}
+
+func (s *serverApi) SetOperatorRoles(
+	ctx context.Context,
+	req *controlv1.SetOperatorRolesRequest,
+) (*controlv1.SetOperatorRolesResponse, error) {
+	if req.Name == "" {
+		return nil, status.Error(codes.InvalidArgument, "operator name is required")
+	}
+
+	if err := s.control.SetOperatorRoles(ctx, req.GetName(), req.GetRoles()); err != nil {
+		return nil, operatorStatus(err)
+	}
+
+	return &controlv1.SetOperatorRolesResponse{Success: true}, nil
+}
+
+func (s *serverApi) ResetOperatorToken(
+	ctx context.Context,
+	req *controlv1.ResetOperatorTokenRequest,
+) (*controlv1.ResetOperatorTokenResponse, error) {
+	if req.Name == "" {
+		return nil, status.Error(codes.InvalidArgument, "operator name is required")
+	}
+
+	token, err := s.control.ResetOperatorToken(ctx, req.GetName())
+	if err != nil {
+		return nil, operatorStatus(err)
+	}
+
+	return &controlv1.ResetOperatorTokenResponse{Token: token}, nil
+}
+
+func (s *serverApi) CreateRole(
+	ctx context.Context,
+	req *controlv1.CreateRoleRequest,
+) (*controlv1.CreateRoleResponse, error) {
+	role := req.GetRole()
+	if role == nil {
+		return nil, status.Error(codes.InvalidArgument, "role is required")
+	}
+
+	permissions := make([]models.Permission, 0, len(role.GetPermissions()))
+	for _, p := range role.GetPermissions() {
+		permissions = append(permissions, models.Permission(p))
+	}
+
+	err := s.control.CreateRole(ctx, models.Role{
+		Name:        role.GetName(),
+		Description: role.GetDescription(),
+		Permissions: permissions,
+		Groups:      role.GetGroups(),
+		Selector:    role.GetSelector(),
+	})
+	if err != nil {
+		return nil, operatorStatus(err)
+	}
+
+	return &controlv1.CreateRoleResponse{Success: true}, nil
+}
+
+func (s *serverApi) DeleteRole(
+	ctx context.Context,
+	req *controlv1.DeleteRoleRequest,
+) (*controlv1.DeleteRoleResponse, error) {
+	if req.Name == "" {
+		return nil, status.Error(codes.InvalidArgument, "role name is required")
+	}
+
+	if err := s.control.DeleteRole(ctx, req.GetName()); err != nil {
+		return nil, operatorStatus(err)
+	}
+
+	return &controlv1.DeleteRoleResponse{Success: true}, nil
+}
+
+func (s *serverApi) RoleList(
+	ctx context.Context,
+	req *controlv1.RoleListRequest,
+) (*controlv1.RoleListResponse, error) {
+	list, err := s.control.RoleList(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	result := make([]*controlv1.Role, 0, len(list))
+	for _, item := range list {
+		permissions := make([]controlv1.Permission, 0, len(item.Permissions))
+		for _, p := range item.Permissions {
+			permissions = append(permissions, controlv1.Permission(p))
+		}
+
+		result = append(result, &controlv1.Role{
+			Name:        item.Name,
+			Description: item.Description,
+			Permissions: permissions,
+			Groups:      item.Groups,
+			Selector:    item.Selector,
+			Builtin:     item.Builtin,
+		})
+	}
+
+	return &controlv1.RoleListResponse{Items: result}, nil
+}
+
+func operatorStatus(err error) error {
+	switch {
+	case errors.Is(err, models.ErrInvalidOperator),
+		errors.Is(err, models.ErrInvalidRole),
+		errors.Is(err, models.ErrInvalidSelector):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, controlsrv.ErrOperatorNotFound):
+		return status.Error(codes.NotFound, "operator not found")
+	case errors.Is(err, controlsrv.ErrOperatorExists):
+		return status.Error(codes.AlreadyExists, "operator already exists")
+	case errors.Is(err, controlsrv.ErrOperatorReadOnly):
+		return status.Error(codes.FailedPrecondition, "operator is defined in the server config")
+	case errors.Is(err, controlsrv.ErrRoleNotFound):
+		return status.Error(codes.NotFound, "role not found")
+	case errors.Is(err, controlsrv.ErrRoleExists):
+		return status.Error(codes.AlreadyExists, "role already exists")
+	case errors.Is(err, controlsrv.ErrRoleReadOnly):
+		return status.Error(codes.FailedPrecondition, "role is built in")
+	case errors.Is(err, controlsrv.ErrRoleInUse):
+		return status.Error(codes.FailedPrecondition, "role is granted to operators")
+	case errors.Is(err, controlsrv.ErrGroupNotFound):
+		return status.Error(codes.NotFound, "group not found")
+	}
+
+	return status.Error(codes.Internal, err.Error())
+}
@@ -0,0 +1,194 @@
+package controlgrpc
+
+import (
+	"context"
+	"errors"
+
+	controlv1 "github.com/dvaxert/mdm/api/gen/go/control"
+	"github.com/dvaxert/mdm/internal/domain/models"
+	controlsrv "github.com/dvaxert/mdm/internal/server/services/control"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *serverApi) CreateProfile(
+	ctx context.Context,
+	req *controlv1.CreateProfileRequest,
+) (*controlv1.CreateProfileResponse, error) {
+	if req.Name == "" {
+		return nil, status.Error(codes.InvalidArgument, "profile name is required")
+	}
+
+	features, err := valuesFromProto(req.GetFeatures())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	err = s.control.CreateProfile(ctx, models.Profile{
+		Name:        req.GetName(),
+		Description: req.GetDescription(),
+		Features:    features,
+	})
+	if err != nil {
+		return nil, profileStatus(err)
+	}
+
+	return &controlv1.CreateProfileResponse{Success: true}, nil
+}
+
+func (s *serverApi) UpdateProfile(
+	ctx context.Context,
+	req *controlv1.UpdateProfileRequest,
+) (*controlv1.UpdateProfileResponse, error) {
+	if req.Name == "" {
+		return nil, status.Error(codes.InvalidArgument, "profile name is required")
+	}
+
+	features, err := valuesFromProto(req.GetFeatures())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	err = s.control.UpdateProfile(ctx, models.Profile{
+		Name:        req.GetName(),
+		Description: req.GetDescription(),
+		Features:    features,
+	})
+	if err != nil {
+		return nil, profileStatus(err)
+	}
+
+	return &controlv1.UpdateProfileResponse{Success: true}, nil
+}
+
+func (s *serverApi) DeleteProfile(
+	ctx context.Context,
+	req *controlv1.DeleteProfileRequest,
+) (*controlv1.DeleteProfileResponse, error) {
+	if req.Name == "" {
+		return nil, status.Error(codes.InvalidArgument, "profile name is required")
+	}
+
+	if err := s.control.DeleteProfile(ctx, req.GetName()); err != nil {
+		return nil, profileStatus(err)
+	}
+
+	return &controlv1.DeleteProfileResponse{Success: true}, nil
+}
+
+func (s *serverApi) ProfileInfo(
+	ctx context.Context,
+	req *controlv1.ProfileInfoRequest,
+) (*controlv1.ProfileInfoResponse, error) {
+	if req.Name == "" {
+		return nil, status.Error(codes.InvalidArgument, "profile name is required")
+	}
+
+	profile, err := s.control.Profile(ctx, req.GetName())
+	if err != nil {
+		return nil, profileStatus(err)
+	}
+
+	return &controlv1.ProfileInfoResponse{Profile: profileToProto(profile)}, nil
+}
+
+func (s *serverApi) ProfileList(
+	ctx context.Context,
+	req *controlv1.ProfileListRequest,
+) (*controlv1.ProfileListResponse, error) {
+	list, err := s.control.ProfileList(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	result := make([]*controlv1.Profile, 0, len(list))
+	for _, item := range list {
+		result = append(result, profileToProto(item))
+	}
+
+	return &controlv1.ProfileListResponse{Items: result}, nil
+}
+
+func (s *serverApi) AssignProfile(
+	ctx context.Context,
+	req *controlv1.AssignProfileRequest,
+) (*controlv1.AssignProfileResponse, error) {
+	if err := s.assignProfile(ctx, req.GetProfile(), req.GetDeviceId(), req.GetGroup(), true); err != nil {
+		return nil, err
+	}
+
+	return &controlv1.AssignProfileResponse{Success: true}, nil
+}
+
+func (s *serverApi) UnassignProfile(
+	ctx context.Context,
+	req *controlv1.UnassignProfileRequest,
+) (*controlv1.UnassignProfileResponse, error) {
+	if err := s.assignProfile(ctx, req.GetProfile(), req.GetDeviceId(), req.GetGroup(), false); err != nil {
+		return nil, err
+	}
+
+	return &controlv1.UnassignProfileResponse{Success: true}, nil
+}
+
+// assignProfile changes the profile assignment of the target, a device or a
+// group, and returns a gRPC status on failure.
+func (s *serverApi) assignProfile(ctx context.Context, profile string, device string, group string, assigned bool) error {
+	if profile == "" {
+		return status.Error(codes.InvalidArgument, "profile name is required")
+	}
+
+	var err error
+	switch {
+	case device != "":
+		var id uuid.UUID
+		if id, err = uuid.Parse(device); err != nil {
+			return status.Error(codes.InvalidArgument, "incorrect device id")
+		}
+
+		err = s.control.AssignDeviceProfile(ctx, profile, id, assigned)
+	case group != "":
+		err = s.control.AssignGroupProfile(ctx, profile, group, assigned)
+	default:
+		return status.Error(codes.InvalidArgument, "device id or group is required")
+	}
+	if err != nil {
+		return profileStatus(err)
+	}
+
+	return nil
+}
+
+// profileStatus translates the errors of the profile operations to gRPC
+// statuses.
+func profileStatus(err error) error {
+	switch {
+	case errors.Is(err, controlsrv.ErrProfileNotFound):
+		return status.Error(codes.NotFound, "profile not found")
+	case errors.Is(err, controlsrv.ErrProfileExists):
+		return status.Error(codes.AlreadyExists, "profile already exists")
+	case errors.Is(err, controlsrv.ErrFeatureNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, controlsrv.ErrFeatureDeprecated):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	}
+
+	return groupStatus(err)
+}
+
+func profileToProto(profile models.Profile) *controlv1.Profile {
+	devices := make([]string, 0, len(profile.Devices))
+	for _, d := range profile.Devices {
+		devices = append(devices, d.String())
+	}
+
+	return &controlv1.Profile{
+		Name:        profile.Name,
+		Description: profile.Description,
+		Version:     profile.Version,
+		Features:    valuesToProto(profile.Features),
+		DeviceIds:   devices,
+		Groups:      profile.Groups,
+	}
+}
@@ -2,24 +2,102 @@ package controlgrpc
 
 import (
 	"context"
+	"errors"
+	"time"
 
 	controlv1 "github.com/dvaxert/mdm/api/gen/go/control"
 	"github.com/dvaxert/mdm/internal/domain/models"
+	controlsrv "github.com/dvaxert/mdm/internal/server/services/control"
 	"github.com/google/uuid"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 type Control interface {
 	DeviceFeatures(ctx context.Context, device_uuid uuid.UUID) (models.DeviceFeatures, error)
-	DeviceFeaturesList(ctx context.Context) ([]models.DeviceFeatures, error)
+	DeviceFeaturesList(ctx context.Context, filter models.DeviceFilter, page models.Page) ([]models.DeviceFeatures, string, error)
 	DeviceInfo(ctx context.Context, device_uuid uuid.UUID) (models.Device, error)
-	DeviceInfoList(ctx context.Context) ([]models.Device, error)
-	DeviceList(ctx context.Context) ([]string, error)
+	DeviceInfoList(ctx context.Context, filter models.DeviceFilter, page models.Page) ([]models.Device, string, error)
+	DeviceList(ctx context.Context, filter models.DeviceFilter, page models.Page) ([]string, string, error)
 	DeviceStatus(ctx context.Context, device_uuid uuid.UUID) (models.DeviceStatus, error)
-	DeviceStatusList(ctx context.Context) ([]models.DeviceStatus, error)
-	SetDeviceFeatureState(ctx context.Context, device_uuid uuid.UUID, feature string, state bool) error
+	DeviceStatusList(ctx context.Context, filter models.DeviceFilter, page models.Page) ([]models.DeviceStatus, string, error)
+	DeviceStatusHistory(ctx context.Context, device_uuid uuid.UUID, from time.Time, to time.Time) ([]models.StatusPoint, error)
+	SetDeviceFeatureState(ctx context.Context, device_uuid uuid.UUID, feature string, value models.FeatureValue) error
+	BulkSetFeatureState(
+		ctx context.Context,
+		target models.BulkTarget,
+		feature string,
+		value models.FeatureValue,
+		dryRun bool,
+	) (models.BulkFeatureResult, error)
+	DeviceDriftList(ctx context.Context, filter models.DeviceFilter, page models.Page) ([]models.DeviceDrift, string, error)
+	UpdateDeviceLabels(ctx context.Context, device_uuid uuid.UUID, set map[string]string, remove []string) error
+	SetDeviceLifecycle(ctx context.Context, device_uuid uuid.UUID, lifecycle models.Lifecycle) (models.LifecycleChange, error)
+	DeviceInventory(ctx context.Context, device_uuid uuid.UUID, version int64) (models.InventorySnapshot, error)
+	CreateFeature(ctx context.Context, feature models.Feature) error
+	Feature(ctx context.Context, name string) (models.Feature, error)
+	FeatureList(ctx context.Context) ([]models.Feature, error)
+	DeprecateFeature(ctx context.Context, name string, deprecated bool) error
+	DeleteFeature(ctx context.Context, name string) error
+	CreateGroup(ctx context.Context, name string, parent string) error
+	DeleteGroup(ctx context.Context, name string) error
+	Group(ctx context.Context, name string) (models.Group, error)
+	GroupList(ctx context.Context) ([]models.Group, error)
+	AddGroupDevice(ctx context.Context, group string, device_uuid uuid.UUID) error
+	RemoveGroupDevice(ctx context.Context, group string, device_uuid uuid.UUID) error
+	SetGroupFeatureState(ctx context.Context, group string, feature string, value models.FeatureValue) error
+	ClearGroupFeatureState(ctx context.Context, group string, feature string) error
+	ResetDeviceFeatureState(ctx context.Context, device_uuid uuid.UUID, feature string) error
+	CreateProfile(ctx context.Context, profile models.Profile) error
+	UpdateProfile(ctx context.Context, profile models.Profile) error
+	DeleteProfile(ctx context.Context, name string) error
+	Profile(ctx context.Context, name string) (models.Profile, error)
+	ProfileList(ctx context.Context) ([]models.Profile, error)
+	AssignDeviceProfile(ctx context.Context, profile string, device_uuid uuid.UUID, assigned bool) error
+	AssignGroupProfile(ctx context.Context, profile string, group string, assigned bool) error
+	CreateApp(ctx context.Context, app models.CatalogApp) error
+	DeleteApp(ctx context.Context, app_id string) error
+	AppList(ctx context.Context) ([]models.CatalogApp, error)
+	SetAppRule(ctx context.Context, app_id string, target models.AppRuleTarget) error
+	ClearAppRule(ctx context.Context, app_id string, target models.AppRuleTarget) error
+	DeviceAppCompliance(ctx context.Context, device_uuid uuid.UUID) (models.AppCompliance, error)
+	AppViolationList(ctx context.Context, filter models.DeviceFilter, page models.Page) ([]models.DeviceAppViolations, string, error)
+	CreateAlertRule(ctx context.Context, rule models.AlertRule) error
+	DeleteAlertRule(ctx context.Context, name string) error
+	AlertRuleList(ctx context.Context) ([]models.AlertRule, error)
+	AlertList(ctx context.Context, filter models.AlertFilter) ([]models.Alert, error)
+	AcknowledgeAlert(ctx context.Context, id int64) error
+	CreateComplianceRule(ctx context.Context, rule models.ComplianceRule) error
+	DeleteComplianceRule(ctx context.Context, name string) error
+	ComplianceRuleList(ctx context.Context) ([]models.ComplianceRule, error)
+	DeviceCompliance(ctx context.Context, device_uuid uuid.UUID, limit int) (models.DeviceCompliance, []models.ComplianceRecord, error)
+	ComplianceSummary(ctx context.Context, filter models.DeviceFilter) (models.ComplianceSummary, error)
+	CreateWebhook(ctx context.Context, webhook models.Webhook) error
+	DeleteWebhook(ctx context.Context, name string) error
+	WebhookList(ctx context.Context) ([]models.Webhook, error)
+	WatchEvents(ctx context.Context, filter models.EventFilter, group string, after int64) (<-chan models.Event, error)
+	CreateCommand(ctx context.Context, command models.Command, ttl time.Duration) (int64, error)
+	Command(ctx context.Context, id int64) (models.Command, error)
+	CommandList(ctx context.Context, filter models.CommandFilter) ([]models.Command, error)
+	CreateEnrollmentToken(ctx context.Context, token models.EnrollmentToken, ttl time.Duration) (string, models.EnrollmentToken, error)
+	EnrollmentTokenList(ctx context.Context) ([]models.EnrollmentToken, error)
+	RevokeEnrollmentToken(ctx context.Context, id int64) error
+	CertificateList(ctx context.Context, device_uuid uuid.UUID) ([]models.Certificate, error)
+	RevokeCertificate(ctx context.Context, serial string) error
+	RevokeDeviceCertificates(ctx context.Context, device_uuid uuid.UUID) (int, error)
+	CreateOperator(ctx context.Context, name string, roles []string) (string, error)
+	DeleteOperator(ctx context.Context, name string) error
+	OperatorList(ctx context.Context) ([]models.Operator, error)
+	SetOperatorRoles(ctx context.Context, name string, roles []string) error
+	ResetOperatorToken(ctx context.Context, name string) (string, error)
+	CreateRole(ctx context.Context, role models.Role) error
+	DeleteRole(ctx context.Context, name string) error
+	RoleList(ctx context.Context) ([]models.Role, error)
+	AuditLog(ctx context.Context, filter models.AuditFilter) ([]models.AuditRecord, error)
+	VerifyAuditLog(ctx context.Context) (models.AuditVerification, error)
 }
 
 type serverApi struct {
@@ -35,12 +113,25 @@ func (s *serverApi) DeviceList(
 	ctx context.Context,
 	req *controlv1.DeviceListRequest,
 ) (*controlv1.DeviceListResponse, error) {
-	list, err := s.control.DeviceList(ctx)
+	filter, err := filterFromProto(ctx, req.GetFilter())
 	if err != nil {
-		return nil, status.Error(codes.Internal, err.Error())
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	page, err := pageFromProto(req.GetPageSize(), req.GetPageToken(), req.GetOrderBy())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	list, next, err := s.control.DeviceList(ctx, filter, page)
+	if err != nil {
+		return nil, listStatus(err)
 	}
 
-	return &controlv1.DeviceListResponse{DeviceId: list}, nil
+	return &controlv1.DeviceListResponse{
+		DeviceId:      list,
+		NextPageToken: next,
+	}, nil
 }
 
 func (s *serverApi) DeviceInfo(
@@ -61,7 +152,14 @@ func (s *serverApi) DeviceInfo(
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
-	return &controlv1.DeviceInfoResponse{DeviceType: int32(device.Type)}, nil
+	return &controlv1.DeviceInfoResponse{
+		DeviceType:         int32(device.Type),
+		Labels:             device.Labels,
+		Presence:           controlv1.Presence(device.Presence),
+		Lifecycle:          controlv1.Lifecycle(device.Lifecycle),
+		LifecycleChangedAt: timeToProto(device.LifecycleChangedAt),
+		Compliance:         complianceToProto(device.Compliance),
+	}, nil
 }
 
 func (s *serverApi) DeviceStatus(
@@ -79,12 +177,20 @@ func (s *serverApi) DeviceStatus(
 
 	deviceStatus, err := s.control.DeviceStatus(ctx, id)
 	if err != nil {
+		if errors.Is(err, controlsrv.ErrDeviceNotFound) {
+			return nil, status.Error(codes.NotFound, "device not found")
+		}
+
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
 	return &controlv1.DeviceStatusResponse{
-		Location: deviceStatus.Location,
-		Battery:  int32(deviceStatus.Battery),
+		Location:       deviceStatus.Location,
+		Battery:        int32(deviceStatus.Battery),
+		Presence:       controlv1.Presence(deviceStatus.Presence),
+		LastSeen:       timeToProto(deviceStatus.LastSeen),
+		RegisteredAt:   timeToProto(deviceStatus.RegisteredAt),
+		LastStateFetch: timeToProto(deviceStatus.LastStateFetch),
 	}, nil
 }
 
@@ -107,7 +213,10 @@ func (s *serverApi) DeviceFeatures(
 	}
 
 	return &controlv1.DeviceFeaturesResponse{
-		Features: deviceFeatures.Features,
+		Features:       models.BoolFeatures(deviceFeatures.Features),
+		Reported:       models.BoolFeatures(deviceFeatures.Reported),
+		Values:         valuesToProto(deviceFeatures.Features),
+		ReportedValues: valuesToProto(deviceFeatures.Reported),
 	}, nil
 }
 
@@ -115,9 +224,19 @@ func (s *serverApi) DeviceInfoList(
 	ctx context.Context,
 	req *controlv1.DeviceInfoListRequest,
 ) (*controlv1.DeviceInfoListResponse, error) {
-	list, err := s.control.DeviceInfoList(ctx)
+	filter, err := filterFromProto(ctx, req.GetFilter())
 	if err != nil {
-		return nil, status.Error(codes.Internal, "internal error")
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	page, err := pageFromProto(req.GetPageSize(), req.GetPageToken(), req.GetOrderBy())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	list, next, err := s.control.DeviceInfoList(ctx, filter, page)
+	if err != nil {
+		return nil, listStatus(err)
 	}
 
 	result := make([]*controlv1.DeviceInfoListItem, 0, len(list))
@@ -125,11 +244,15 @@ func (s *serverApi) DeviceInfoList(
 		result = append(result, &controlv1.DeviceInfoListItem{
 			DeviceId:   item.Uuid.String(),
 			DeviceType: int32(item.Type),
+			Labels:     item.Labels,
+			Presence:   controlv1.Presence(item.Presence),
+			Lifecycle:  controlv1.Lifecycle(item.Lifecycle),
 		})
 	}
 
 	return &controlv1.DeviceInfoListResponse{
-		Items: result,
+		Items:         result,
+		NextPageToken: next,
 	}, nil
 }
 
@@ -137,22 +260,37 @@ func (s *serverApi) DeviceStatusList(
 	ctx context.Context,
 	req *controlv1.DeviceStatusListRequest,
 ) (*controlv1.DeviceStatusListResponse, error) {
-	statusList, err := s.control.DeviceStatusList(ctx)
+	filter, err := filterFromProto(ctx, req.GetFilter())
 	if err != nil {
-		return nil, status.Error(codes.Internal, err.Error())
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	page, err := pageFromProto(req.GetPageSize(), req.GetPageToken(), req.GetOrderBy())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	statusList, next, err := s.control.DeviceStatusList(ctx, filter, page)
+	if err != nil {
+		return nil, listStatus(err)
 	}
 
 	result := make([]*controlv1.DeviceStatusListItem, 0, len(statusList))
 	for _, item := range statusList {
 		result = append(result, &controlv1.DeviceStatusListItem{
-			DeviceId: item.DeviceUuid.String(),
-			Location: item.Location,
-			Battery:  int32(item.Battery),
+			DeviceId:       item.DeviceUuid.String(),
+			Location:       item.Location,
+			Battery:        int32(item.Battery),
+			Presence:       controlv1.Presence(item.Presence),
+			LastSeen:       timeToProto(item.LastSeen),
+			RegisteredAt:   timeToProto(item.RegisteredAt),
+			LastStateFetch: timeToProto(item.LastStateFetch),
 		})
 	}
 
 	return &controlv1.DeviceStatusListResponse{
-		Items: result,
+		Items:         result,
+		NextPageToken: next,
 	}, nil
 }
 
@@ -173,7 +311,28 @@ func (s *serverApi) SetDeviceFeatureState(
 		return nil, status.Error(codes.InvalidArgument, "incorrect device id")
 	}
 
-	if err = s.control.SetDeviceFeatureState(ctx, uuid, req.GetFeature(), req.GetState()); err != nil {
+	// запросы без value приходят от клиентов, знающих только bool функции
+	value := models.BoolValue(req.GetState())
+	if req.Value != nil {
+		if value, err = valueFromProto(req.GetValue()); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+
+	if err = s.control.SetDeviceFeatureState(ctx, uuid, req.GetFeature(), value); err != nil {
+		switch {
+		case errors.Is(err, controlsrv.ErrDeviceNotFound):
+			return nil, status.Error(codes.NotFound, "device not found")
+		case errors.Is(err, controlsrv.ErrFeatureNotFound):
+			return nil, status.Error(codes.NotFound, "feature not found")
+		case errors.Is(err, controlsrv.ErrFeatureDeprecated):
+			return nil, status.Error(codes.FailedPrecondition, "feature is deprecated")
+		case errors.Is(err, controlsrv.ErrFeatureNotApplicable):
+			return nil, status.Error(codes.FailedPrecondition, "feature does not apply to the device type")
+		case errors.Is(err, models.ErrInvalidFeatureValue):
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
@@ -184,20 +343,145 @@ func (s *serverApi) DeviceFeaturesList(
 	ctx context.Context,
 	req *controlv1.DeviceFeaturesListRequest,
 ) (*controlv1.DeviceFeaturesListResponse, error) {
-	featuresList, err := s.control.DeviceFeaturesList(ctx)
+	filter, err := filterFromProto(ctx, req.GetFilter())
 	if err != nil {
-		return nil, status.Error(codes.Internal, err.Error())
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	page, err := pageFromProto(req.GetPageSize(), req.GetPageToken(), req.GetOrderBy())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	featuresList, next, err := s.control.DeviceFeaturesList(ctx, filter, page)
+	if err != nil {
+		return nil, listStatus(err)
 	}
 
 	result := make([]*controlv1.DeviceFeaturesListItem, 0, len(featuresList))
 	for _, item := range featuresList {
 		result = append(result, &controlv1.DeviceFeaturesListItem{
-			DeviceId: item.DeviceUuid.String(),
-			Features: item.Features,
+			DeviceId:       item.DeviceUuid.String(),
+			Features:       models.BoolFeatures(item.Features),
+			Reported:       models.BoolFeatures(item.Reported),
+			Values:         valuesToProto(item.Features),
+			ReportedValues: valuesToProto(item.Reported),
 		})
 	}
 
 	return &controlv1.DeviceFeaturesListResponse{
-		Items: result,
+		Items:         result,
+		NextPageToken: next,
+	}, nil
+}
+
+func (s *serverApi) DeviceDriftList(
+	ctx context.Context,
+	req *controlv1.DeviceDriftListRequest,
+) (*controlv1.DeviceDriftListResponse, error) {
+	filter, err := filterFromProto(ctx, req.GetFilter())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	page, err := pageFromProto(req.GetPageSize(), req.GetPageToken(), req.GetOrderBy())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	driftList, next, err := s.control.DeviceDriftList(ctx, filter, page)
+	if err != nil {
+		return nil, listStatus(err)
+	}
+
+	now := time.Now()
+
+	result := make([]*controlv1.DeviceDriftListItem, 0, len(driftList))
+	for _, item := range driftList {
+		features := make([]*controlv1.FeatureDrift, 0, len(item.Features))
+		for _, f := range item.Features {
+			drift := &controlv1.FeatureDrift{
+				Feature:      f.Feature,
+				Desired:      f.Desired.Bool,
+				Since:        timestamppb.New(f.Since),
+				DesiredValue: valueToProto(f.Desired),
+			}
+
+			if f.Reported != nil {
+				drift.Reported = &f.Reported.Bool
+				drift.ReportedValue = valueToProto(*f.Reported)
+			}
+
+			features = append(features, drift)
+		}
+
+		result = append(result, &controlv1.DeviceDriftListItem{
+			DeviceId:  item.DeviceUuid.String(),
+			Features:  features,
+			OutOfSync: durationpb.New(now.Sub(item.Since)),
+		})
+	}
+
+	return &controlv1.DeviceDriftListResponse{
+		Items:         result,
+		NextPageToken: next,
 	}, nil
 }
+
+func (s *serverApi) DeviceStatusHistory(
+	ctx context.Context,
+	req *controlv1.DeviceStatusHistoryRequest,
+) (*controlv1.DeviceStatusHistoryResponse, error) {
+	if req.GetDeviceId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "device id is required")
+	}
+
+	id, err := uuid.Parse(req.GetDeviceId())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "incorrect device id")
+	}
+
+	to := time.Now()
+	if req.To != nil {
+		to = req.GetTo().AsTime()
+	}
+
+	from := to.Add(-24 * time.Hour)
+	if req.From != nil {
+		from = req.GetFrom().AsTime()
+	}
+
+	if from.After(to) {
+		return nil, status.Error(codes.InvalidArgument, "from is after to")
+	}
+
+	points, err := s.control.DeviceStatusHistory(ctx, id, from, to)
+	if err != nil {
+		if errors.Is(err, controlsrv.ErrDeviceNotFound) {
+			return nil, status.Error(codes.NotFound, "device not found")
+		}
+
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	result := make([]*controlv1.StatusPoint, 0, len(points))
+	for _, p := range points {
+		result = append(result, &controlv1.StatusPoint{
+			Time:     timestamppb.New(p.Time),
+			Location: p.Location,
+			Battery:  int32(p.Battery),
+		})
+	}
+
+	return &controlv1.DeviceStatusHistoryResponse{Points: result}, nil
+}
+
+// timeToProto converts the time of an event, the zero time means that the
+// event has not happened and is left unset.
+func timeToProto(t time.Time) *timestamppb.Timestamp {
+	if t.IsZero() {
+		return nil
+	}
+
+	return timestamppb.New(t)
+}
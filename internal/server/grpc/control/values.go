@@ -0,0 +1,70 @@
+package controlgrpc
+
+import (
+	"errors"
+	"fmt"
+
+	controlv1 "github.com/dvaxert/mdm/api/gen/go/control"
+	"github.com/dvaxert/mdm/internal/domain/models"
+)
+
+var errEmptyValue = errors.New("feature value is required")
+
+func valueToProto(v models.FeatureValue) *controlv1.FeatureValue {
+	switch v.Type {
+	case models.FeatureBool:
+		return &controlv1.FeatureValue{Kind: &controlv1.FeatureValue_BoolValue{BoolValue: v.Bool}}
+	case models.FeatureInt:
+		return &controlv1.FeatureValue{Kind: &controlv1.FeatureValue_IntValue{IntValue: v.Int}}
+	case models.FeatureString:
+		return &controlv1.FeatureValue{Kind: &controlv1.FeatureValue_StringValue{StringValue: v.Str}}
+	case models.FeatureStringList:
+		return &controlv1.FeatureValue{Kind: &controlv1.FeatureValue_StringListValue{
+			StringListValue: &controlv1.StringList{Values: v.List},
+		}}
+	case models.FeatureEnum:
+		return &controlv1.FeatureValue{Kind: &controlv1.FeatureValue_EnumValue{EnumValue: v.Str}}
+	}
+
+	return nil
+}
+
+func valueFromProto(v *controlv1.FeatureValue) (models.FeatureValue, error) {
+	switch kind := v.GetKind().(type) {
+	case *controlv1.FeatureValue_BoolValue:
+		return models.BoolValue(kind.BoolValue), nil
+	case *controlv1.FeatureValue_IntValue:
+		return models.FeatureValue{Type: models.FeatureInt, Int: kind.IntValue}, nil
+	case *controlv1.FeatureValue_StringValue:
+		return models.FeatureValue{Type: models.FeatureString, Str: kind.StringValue}, nil
+	case *controlv1.FeatureValue_StringListValue:
+		return models.FeatureValue{Type: models.FeatureStringList, List: kind.StringListValue.GetValues()}, nil
+	case *controlv1.FeatureValue_EnumValue:
+		return models.FeatureValue{Type: models.FeatureEnum, Str: kind.EnumValue}, nil
+	}
+
+	return models.FeatureValue{}, errEmptyValue
+}
+
+func valuesToProto(values map[string]models.FeatureValue) map[string]*controlv1.FeatureValue {
+	result := make(map[string]*controlv1.FeatureValue, len(values))
+	for name, v := range values {
+		result[name] = valueToProto(v)
+	}
+
+	return result
+}
+
+func valuesFromProto(values map[string]*controlv1.FeatureValue) (map[string]models.FeatureValue, error) {
+	result := make(map[string]models.FeatureValue, len(values))
+	for name, v := range values {
+		value, err := valueFromProto(v)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", name, err)
+		}
+
+		result[name] = value
+	}
+
+	return result, nil
+}
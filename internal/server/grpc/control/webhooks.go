@@ -0,0 +1,96 @@
+package controlgrpc
+
+import (
+	"context"
+	"errors"
+
+	controlv1 "github.com/dvaxert/mdm/api/gen/go/control"
+	"github.com/dvaxert/mdm/internal/domain/models"
+	controlsrv "github.com/dvaxert/mdm/internal/server/services/control"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *serverApi) CreateWebhook(
+	ctx context.Context,
+	req *controlv1.CreateWebhookRequest,
+) (*controlv1.CreateWebhookResponse, error) {
+	webhook := req.GetWebhook()
+	if webhook == nil {
+		return nil, status.Error(codes.InvalidArgument, "webhook is required")
+	}
+
+	events := make([]models.EventType, 0, len(webhook.GetEvents()))
+	for _, t := range webhook.GetEvents() {
+		events = append(events, models.EventType(t))
+	}
+
+	err := s.control.CreateWebhook(ctx, models.Webhook{
+		Name:   webhook.GetName(),
+		Url:    webhook.GetUrl(),
+		Secret: webhook.GetSecret(),
+		Events: events,
+	})
+	if err != nil {
+		return nil, webhookStatus(err)
+	}
+
+	return &controlv1.CreateWebhookResponse{Success: true}, nil
+}
+
+func (s *serverApi) DeleteWebhook(
+	ctx context.Context,
+	req *controlv1.DeleteWebhookRequest,
+) (*controlv1.DeleteWebhookResponse, error) {
+	if req.Name == "" {
+		return nil, status.Error(codes.InvalidArgument, "webhook name is required")
+	}
+
+	if err := s.control.DeleteWebhook(ctx, req.GetName()); err != nil {
+		return nil, webhookStatus(err)
+	}
+
+	return &controlv1.DeleteWebhookResponse{Success: true}, nil
+}
+
+func (s *serverApi) WebhookList(
+	ctx context.Context,
+	req *controlv1.WebhookListRequest,
+) (*controlv1.WebhookListResponse, error) {
+	list, err := s.control.WebhookList(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	result := make([]*controlv1.Webhook, 0, len(list))
+	for _, item := range list {
+		events := make([]string, 0, len(item.Events))
+		for _, t := range item.Events {
+			events = append(events, string(t))
+		}
+
+		result = append(result, &controlv1.Webhook{
+			Name:       item.Name,
+			Url:        item.Url,
+			Events:     events,
+			FromConfig: item.FromConfig,
+		})
+	}
+
+	return &controlv1.WebhookListResponse{Items: result}, nil
+}
+
+func webhookStatus(err error) error {
+	switch {
+	case errors.Is(err, models.ErrInvalidWebhook):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, controlsrv.ErrWebhookNotFound):
+		return status.Error(codes.NotFound, "webhook not found")
+	case errors.Is(err, controlsrv.ErrWebhookExists):
+		return status.Error(codes.AlreadyExists, "webhook already exists")
+	case errors.Is(err, controlsrv.ErrWebhookReadOnly):
+		return status.Error(codes.FailedPrecondition, "webhook is defined in the server config")
+	}
+
+	return status.Error(codes.Internal, err.Error())
+}
@@ -0,0 +1,92 @@
+package managementgrpc
+
+import (
+	"context"
+	"errors"
+
+	managementv1 "github.com/dvaxert/mdm/api/gen/go/management"
+	"github.com/dvaxert/mdm/internal/domain/models"
+	managementsrv "github.com/dvaxert/mdm/internal/server/services/management"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *serverApi) DeviceCommands(
+	ctx context.Context,
+	req *managementv1.DeviceCommandsRequest,
+) (*managementv1.DeviceCommandsResponse, error) {
+	if req.DeviceId == "" {
+		return nil, status.Error(codes.InvalidArgument, "device id is required")
+	}
+
+	id, err := uuid.Parse(req.GetDeviceId())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "incorrect device id")
+	}
+
+	if err = s.authorizeDevice(ctx, id, s.requireCert); err != nil {
+		return nil, err
+	}
+
+	commands, err := s.management.DeviceCommands(ctx, id)
+	if err != nil {
+		return nil, commandStatus(err)
+	}
+
+	result := make([]*managementv1.Command, 0, len(commands))
+	for _, item := range commands {
+		result = append(result, &managementv1.Command{
+			Id:      item.Id,
+			Type:    managementv1.CommandType(item.Type),
+			Payload: item.Payload,
+		})
+	}
+
+	return &managementv1.DeviceCommandsResponse{Commands: result}, nil
+}
+
+func (s *serverApi) DeviceReportCommand(
+	ctx context.Context,
+	req *managementv1.DeviceReportCommandRequest,
+) (*managementv1.DeviceReportCommandResponse, error) {
+	if req.DeviceId == "" {
+		return nil, status.Error(codes.InvalidArgument, "device id is required")
+	}
+
+	id, err := uuid.Parse(req.GetDeviceId())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "incorrect device id")
+	}
+
+	if err = s.authorizeDevice(ctx, id, s.requireCert); err != nil {
+		return nil, err
+	}
+
+	if req.Id <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "command id is required")
+	}
+
+	reported := models.CommandStatus(req.GetStatus())
+	if !reported.Reportable() {
+		return nil, status.Error(codes.InvalidArgument, "device can report only acknowledged, succeeded or failed")
+	}
+
+	err = s.management.DeviceReportCommand(ctx, id, req.GetId(), reported, req.GetResult())
+	if err != nil {
+		return nil, commandStatus(err)
+	}
+
+	return &managementv1.DeviceReportCommandResponse{Success: true}, nil
+}
+
+func commandStatus(err error) error {
+	switch {
+	case errors.Is(err, managementsrv.ErrCommandNotFound):
+		return status.Error(codes.NotFound, "command not found")
+	case errors.Is(err, managementsrv.ErrCommandCompleted):
+		return status.Error(codes.FailedPrecondition, "command already completed")
+	}
+
+	return deviceStatus(err)
+}
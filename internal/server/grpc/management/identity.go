@@ -0,0 +1,111 @@
+package managementgrpc
+
+import (
+	"context"
+	"crypto/x509"
+	"errors"
+
+	managementv1 "github.com/dvaxert/mdm/api/gen/go/management"
+	"github.com/dvaxert/mdm/internal/domain/models"
+	"github.com/dvaxert/mdm/internal/server/ca"
+	managementsrv "github.com/dvaxert/mdm/internal/server/services/management"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+func (s *serverApi) RenewCertificate(
+	ctx context.Context,
+	req *managementv1.RenewCertificateRequest,
+) (*managementv1.RenewCertificateResponse, error) {
+	if req.DeviceId == "" {
+		return nil, status.Error(codes.InvalidArgument, "device id is required")
+	}
+
+	id, err := uuid.Parse(req.GetDeviceId())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "incorrect device id")
+	}
+
+	// новый сертификат выдается только владельцу текущего
+	if err = s.authorizeDevice(ctx, id, true); err != nil {
+		return nil, err
+	}
+
+	cert, err := s.management.RenewCertificate(ctx, id, req.GetCsr())
+	if err != nil {
+		return nil, certificateStatus(err)
+	}
+
+	return &managementv1.RenewCertificateResponse{
+		Certificate:   cert.Pem,
+		CaCertificate: s.management.CACertificate(),
+	}, nil
+}
+
+// authorizeDevice checks that a client with a verified certificate acts as
+// the device whose UUID is the common name of the certificate subject, and
+// that the certificate has not been revoked. Clients without a verified
+// certificate are rejected only if required is set.
+func (s *serverApi) authorizeDevice(ctx context.Context, id uuid.UUID, required bool) error {
+	cert := peerCertificate(ctx)
+	if cert == nil {
+		if required {
+			return status.Error(codes.Unauthenticated, "client certificate is required")
+		}
+
+		return nil
+	}
+
+	subject, err := uuid.Parse(cert.Subject.CommonName)
+	if err != nil || subject != id {
+		return status.Error(codes.PermissionDenied, "client certificate does not belong to the device")
+	}
+
+	revoked, err := s.management.CertificateRevoked(ctx, ca.Serial(cert))
+	if err != nil {
+		return status.Error(codes.Internal, "internal error")
+	}
+
+	if revoked {
+		return status.Error(codes.Unauthenticated, "client certificate has been revoked")
+	}
+
+	return nil
+}
+
+// peerCertificate returns the verified client certificate, nil if the
+// client has not presented one.
+func peerCertificate(ctx context.Context) *x509.Certificate {
+	var info credentials.TLSInfo
+	if p, ok := peer.FromContext(ctx); ok {
+		info, _ = p.AuthInfo.(credentials.TLSInfo)
+	}
+
+	if len(info.State.VerifiedChains) == 0 {
+		return nil
+	}
+
+	return info.State.VerifiedChains[0][0]
+}
+
+func certificateStatus(err error) error {
+	switch {
+	case errors.Is(err, models.ErrInvalidCSR):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, managementsrv.ErrEnrollmentTokenRequired):
+		return status.Error(codes.Unauthenticated, "enrollment token or device certificate is required")
+	case errors.Is(err, managementsrv.ErrEnrollmentTokenInvalid):
+		return status.Error(codes.PermissionDenied, "enrollment token is invalid, expired or used")
+	case errors.Is(err, managementsrv.ErrEnrollmentTokenScope):
+		return status.Error(codes.PermissionDenied, "enrollment token does not allow the device type")
+	case errors.Is(err, managementsrv.ErrDeviceEnrolled):
+		return status.Error(codes.AlreadyExists, "device is already enrolled, it registers again with its certificate")
+	case errors.Is(err, managementsrv.ErrEnrollmentDisabled):
+		return status.Error(codes.FailedPrecondition, "enrollment CA is not configured")
+	}
+
+	return deviceStatus(err)
+}
@@ -0,0 +1,178 @@
+package managementgrpc
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"path/filepath"
+	"testing"
+	"time"
+
+	managementv1 "github.com/dvaxert/mdm/api/gen/go/management"
+	"github.com/dvaxert/mdm/internal/server/ca"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+// fakeManagement answers the calls the tests make, the other calls panic.
+type fakeManagement struct {
+	Management
+	revoked map[string]bool
+	pings   int
+}
+
+func (m *fakeManagement) CertificateRevoked(ctx context.Context, serial string) (bool, error) {
+	return m.revoked[serial], nil
+}
+
+func (m *fakeManagement) DevicePing(
+	ctx context.Context,
+	device_uuid uuid.UUID,
+	location string,
+	battery int,
+	applied_revision int64,
+) (bool, error) {
+	m.pings++
+
+	return false, nil
+}
+
+func newCA(t *testing.T) *ca.CA {
+	t.Helper()
+
+	dir := t.TempDir()
+	c, err := ca.Load(filepath.Join(dir, "ca.crt"), filepath.Join(dir, "ca.key"), time.Hour)
+	if err != nil {
+		t.Fatalf("load ca: %v", err)
+	}
+
+	return c
+}
+
+// issue returns the certificate the CA issues to the device.
+func issue(t *testing.T, c *ca.CA, device_uuid uuid.UUID) *x509.Certificate {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("generate key: %v", err)
+	}
+
+	// CA заменяет subject запроса, имя в нем ни на что не влияет
+	der, err := x509.CreateCertificateRequest(rand.Reader, &x509.CertificateRequest{
+		Subject: pkix.Name{CommonName: "someone else"},
+	}, key)
+	if err != nil {
+		t.Fatalf("create csr: %v", err)
+	}
+
+	cert, err := c.Sign(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE REQUEST", Bytes: der}), device_uuid)
+	if err != nil {
+		t.Fatalf("sign csr: %v", err)
+	}
+
+	block, _ := pem.Decode(cert.Pem)
+	parsed, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		t.Fatalf("parse certificate: %v", err)
+	}
+
+	if ca.Serial(parsed) != cert.Serial {
+		t.Fatalf("serial = %s, want %s", ca.Serial(parsed), cert.Serial)
+	}
+
+	return parsed
+}
+
+// peerContext returns the context of a call made over TLS with the verified
+// client certificate, without a certificate if cert is nil.
+func peerContext(cert *x509.Certificate) context.Context {
+	var state tls.ConnectionState
+	if cert != nil {
+		state.VerifiedChains = [][]*x509.Certificate{{cert}}
+	}
+
+	return peer.NewContext(context.Background(), &peer.Peer{AuthInfo: credentials.TLSInfo{State: state}})
+}
+
+func TestAuthorizeDevice(t *testing.T) {
+	c := newCA(t)
+
+	device := uuid.New()
+	own := issue(t, c, device)
+	other := issue(t, c, uuid.New())
+	revoked := issue(t, c, device)
+
+	s := &serverApi{management: &fakeManagement{revoked: map[string]bool{ca.Serial(revoked): true}}}
+
+	tests := []struct {
+		name     string
+		cert     *x509.Certificate
+		required bool
+		want     codes.Code
+	}{
+		{name: "own certificate", cert: own, want: codes.OK},
+		{name: "own certificate required", cert: own, required: true, want: codes.OK},
+		{name: "no certificate", want: codes.OK},
+		{name: "no certificate required", required: true, want: codes.Unauthenticated},
+		{name: "certificate of another device", cert: other, want: codes.PermissionDenied},
+		{name: "certificate of another device required", cert: other, required: true, want: codes.PermissionDenied},
+		{name: "revoked certificate", cert: revoked, want: codes.Unauthenticated},
+		{name: "revoked certificate required", cert: revoked, required: true, want: codes.Unauthenticated},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := s.authorizeDevice(peerContext(tt.cert), device, tt.required)
+			if got := status.Code(err); got != tt.want {
+				t.Errorf("authorizeDevice() code = %s, want %s (%v)", got, tt.want, err)
+			}
+		})
+	}
+}
+
+func TestRequireCert(t *testing.T) {
+	c := newCA(t)
+
+	device := uuid.New()
+	own := issue(t, c, device)
+
+	tests := []struct {
+		name        string
+		requireCert bool
+		cert        *x509.Certificate
+		want        codes.Code
+	}{
+		{name: "not required without certificate", want: codes.OK},
+		{name: "required without certificate", requireCert: true, want: codes.Unauthenticated},
+		{name: "required with certificate", requireCert: true, cert: own, want: codes.OK},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := &fakeManagement{}
+			s := &serverApi{management: m, requireCert: tt.requireCert}
+
+			_, err := s.DevicePing(peerContext(tt.cert), &managementv1.DevicePingRequest{
+				DeviceId: device.String(),
+				Location: "Oslo",
+				Battery:  50,
+			})
+			if got := status.Code(err); got != tt.want {
+				t.Fatalf("DevicePing() code = %s, want %s (%v)", got, tt.want, err)
+			}
+
+			if pinged := m.pings != 0; pinged != (tt.want == codes.OK) {
+				t.Errorf("DevicePing() reached the service = %t", pinged)
+			}
+		})
+	}
+}
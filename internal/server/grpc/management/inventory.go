@@ -0,0 +1,77 @@
+package managementgrpc
+
+import (
+	"context"
+	"errors"
+
+	managementv1 "github.com/dvaxert/mdm/api/gen/go/management"
+	"github.com/dvaxert/mdm/internal/domain/models"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *serverApi) DeviceReportInventory(
+	ctx context.Context,
+	req *managementv1.DeviceReportInventoryRequest,
+) (*managementv1.DeviceReportInventoryResponse, error) {
+	if req.DeviceId == "" {
+		return nil, status.Error(codes.InvalidArgument, "device id is required")
+	}
+
+	id, err := uuid.Parse(req.GetDeviceId())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "incorrect device id")
+	}
+
+	if err = s.authorizeDevice(ctx, id, s.requireCert); err != nil {
+		return nil, err
+	}
+
+	if req.Inventory == nil {
+		return nil, status.Error(codes.InvalidArgument, "inventory is required")
+	}
+
+	snapshot, changed, err := s.management.DeviceReportInventory(ctx, id, inventoryFromProto(req.GetInventory()))
+	if err != nil {
+		return nil, inventoryStatus(err)
+	}
+
+	return &managementv1.DeviceReportInventoryResponse{Changed: changed, Version: snapshot.Version}, nil
+}
+
+func inventoryFromProto(i *managementv1.Inventory) models.Inventory {
+	result := models.Inventory{
+		OsName:       i.GetOsName(),
+		OsVersion:    i.GetOsVersion(),
+		Model:        i.GetModel(),
+		Serial:       i.GetSerial(),
+		StorageBytes: i.GetStorageBytes(),
+	}
+
+	for _, n := range i.GetInterfaces() {
+		result.Interfaces = append(result.Interfaces, models.NetworkInterface{
+			Name:      n.GetName(),
+			Mac:       n.GetMac(),
+			Addresses: n.GetAddresses(),
+		})
+	}
+
+	for _, a := range i.GetApps() {
+		result.Apps = append(result.Apps, models.InstalledApp{
+			Id:      a.GetId(),
+			Name:    a.GetName(),
+			Version: a.GetVersion(),
+		})
+	}
+
+	return result
+}
+
+func inventoryStatus(err error) error {
+	if errors.Is(err, models.ErrInvalidInventory) {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return deviceStatus(err)
+}
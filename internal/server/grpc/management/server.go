@@ -2,9 +2,12 @@ package managementgrpc
 
 import (
 	"context"
+	"errors"
+	"io"
 
 	managementv1 "github.com/dvaxert/mdm/api/gen/go/management"
 	"github.com/dvaxert/mdm/internal/domain/models"
+	managementsrv "github.com/dvaxert/mdm/internal/server/services/management"
 	"github.com/google/uuid"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
@@ -12,18 +15,38 @@ import (
 )
 
 type Management interface {
-	DevicePing(ctx context.Context, device_uuid uuid.UUID, location string, battery int) (bool, error)
-	DeviceRegister(ctx context.Context, device_uuid uuid.UUID, device_type models.DeviceType) error
+	DevicePing(ctx context.Context, device_uuid uuid.UUID, location string, battery int, applied_revision int64) (bool, error)
+	DeviceRegister(
+		ctx context.Context,
+		device_uuid uuid.UUID,
+		device_type models.DeviceType,
+		labels map[string]string,
+		csr []byte,
+		token string,
+		authenticated bool,
+		inventory *models.Inventory,
+	) (models.Certificate, error)
 	DeviceState(ctx context.Context, device_uuid uuid.UUID) (models.DeviceFeatures, error)
+	DeviceReportState(ctx context.Context, device_uuid uuid.UUID, values map[string]models.FeatureValue, revision int64) error
+	DeviceConnect(ctx context.Context, device_uuid uuid.UUID) (<-chan models.DeviceStateUpdate, error)
+	DeviceCommands(ctx context.Context, device_uuid uuid.UUID) ([]models.Command, error)
+	DeviceReportCommand(ctx context.Context, device_uuid uuid.UUID, id int64, status models.CommandStatus, result string) error
+	RenewCertificate(ctx context.Context, device_uuid uuid.UUID, csr []byte) (models.Certificate, error)
+	DeviceReportInventory(ctx context.Context, device_uuid uuid.UUID, inventory models.Inventory) (models.InventorySnapshot, bool, error)
+	CACertificate() []byte
+	CertificateRevoked(ctx context.Context, serial string) (bool, error)
 }
 
 type serverApi struct {
 	managementv1.UnimplementedDeviceManagementServer
-	management Management
+	management  Management
+	requireCert bool
 }
 
-func Register(gRPC *grpc.Server, management Management) {
-	managementv1.RegisterDeviceManagementServer(gRPC, &serverApi{management: management})
+// Register registers the service. If requireCert is set, every call except
+// DeviceRegister must be made with the client certificate of the device.
+func Register(gRPC *grpc.Server, management Management, requireCert bool) {
+	managementv1.RegisterDeviceManagementServer(gRPC, &serverApi{management: management, requireCert: requireCert})
 }
 
 func (s *serverApi) DeviceRegister(
@@ -39,17 +62,41 @@ func (s *serverApi) DeviceRegister(
 		return nil, status.Error(codes.InvalidArgument, "incorrect device id")
 	}
 
+	// регистрирующееся устройство еще не получило сертификат
+	if err = s.authorizeDevice(ctx, id, false); err != nil {
+		return nil, err
+	}
+	authenticated := peerCertificate(ctx) != nil
+
 	dType := models.DeviceType(req.GetDeviceType())
 	if dType >= models.DeviceTypeCount {
 		return nil, status.Error(codes.InvalidArgument, "incorrect device type")
 	}
 
-	err = s.management.DeviceRegister(ctx, id, dType)
+	var inventory *models.Inventory
+	if req.Inventory != nil {
+		i := inventoryFromProto(req.GetInventory())
+		inventory = &i
+	}
+
+	cert, err := s.management.DeviceRegister(
+		ctx, id, dType, req.GetLabels(), req.GetCsr(), req.GetEnrollmentToken(), authenticated, inventory,
+	)
 	if err != nil {
-		return nil, status.Error(codes.Internal, "internal error")
+		if errors.Is(err, models.ErrInvalidLabel) || errors.Is(err, models.ErrInvalidInventory) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+
+		return nil, certificateStatus(err)
 	}
 
-	return &managementv1.DeviceRegisterResponse{Success: true}, nil
+	res := &managementv1.DeviceRegisterResponse{Success: true}
+	if cert.Pem != nil {
+		res.Certificate = cert.Pem
+		res.CaCertificate = s.management.CACertificate()
+	}
+
+	return res, nil
 }
 
 func (s *serverApi) DevicePing(
@@ -65,17 +112,23 @@ func (s *serverApi) DevicePing(
 		return nil, status.Error(codes.InvalidArgument, "incorrect device id")
 	}
 
-	if req.Location == "" {
-		return nil, status.Error(codes.InvalidArgument, "location is required")
+	if err = s.authorizeDevice(ctx, id, s.requireCert); err != nil {
+		return nil, err
 	}
 
-	if req.Battery < 0 || req.Battery > 100 {
-		return nil, status.Error(codes.InvalidArgument, "incorrect battery state")
+	if err = validateStatus(req.GetLocation(), req.GetBattery()); err != nil {
+		return nil, err
 	}
 
-	stateChanged, err := s.management.DevicePing(ctx, id, req.GetLocation(), int(req.GetBattery()))
+	stateChanged, err := s.management.DevicePing(
+		ctx,
+		id,
+		req.GetLocation(),
+		int(req.GetBattery()),
+		req.GetAppliedRevision(),
+	)
 	if err != nil {
-		return nil, status.Error(codes.Internal, "internal error")
+		return nil, deviceStatus(err)
 	}
 
 	return &managementv1.DevicePingResponse{StateChanged: stateChanged}, nil
@@ -94,10 +147,175 @@ func (s *serverApi) DeviceState(
 		return nil, status.Error(codes.InvalidArgument, "incorrect device id")
 	}
 
+	if err = s.authorizeDevice(ctx, id, s.requireCert); err != nil {
+		return nil, err
+	}
+
 	features, err := s.management.DeviceState(ctx, id)
 	if err != nil {
-		return nil, status.Error(codes.Internal, "internal error")
+		return nil, deviceStatus(err)
+	}
+
+	return &managementv1.DeviceStateResponse{
+		Features:  models.BoolFeatures(features.Features),
+		Revision:  features.Revision,
+		Values:    valuesToProto(features.Features),
+		AppPolicy: policyToProto(features.Apps),
+	}, nil
+}
+
+func (s *serverApi) DeviceReportState(
+	ctx context.Context,
+	req *managementv1.DeviceReportStateRequest,
+) (*managementv1.DeviceReportStateResponse, error) {
+	if req.DeviceId == "" {
+		return nil, status.Error(codes.InvalidArgument, "device id is required")
+	}
+
+	id, err := uuid.Parse(req.GetDeviceId())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "incorrect device id")
+	}
+
+	if err = s.authorizeDevice(ctx, id, s.requireCert); err != nil {
+		return nil, err
+	}
+
+	err = s.management.DeviceReportState(ctx, id, reportedValues(req), req.GetRevision())
+	if err != nil {
+		return nil, deviceStatus(err)
+	}
+
+	return &managementv1.DeviceReportStateResponse{Success: true}, nil
+}
+
+func (s *serverApi) Connect(stream managementv1.DeviceManagement_ConnectServer) error {
+	req, err := stream.Recv()
+	if err != nil {
+		return err
+	}
+
+	if req.DeviceId == "" {
+		return status.Error(codes.InvalidArgument, "device id is required")
+	}
+
+	id, err := uuid.Parse(req.GetDeviceId())
+	if err != nil {
+		return status.Error(codes.InvalidArgument, "incorrect device id")
+	}
+
+	if err = s.authorizeDevice(stream.Context(), id, s.requireCert); err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithCancel(stream.Context())
+	defer cancel()
+
+	if err = s.reportStatus(ctx, id, req); err != nil {
+		return err
+	}
+
+	updates, err := s.management.DeviceConnect(ctx, id)
+	if err != nil {
+		return deviceStatus(err)
+	}
+
+	errCh := make(chan error, 1)
+	go func() {
+		for {
+			req, err := stream.Recv()
+			if err != nil {
+				errCh <- err
+				return
+			}
+
+			if req.DeviceId != "" && req.DeviceId != id.String() {
+				errCh <- status.Error(codes.InvalidArgument, "device id cannot change within a session")
+				return
+			}
+
+			if err = s.reportStatus(ctx, id, req); err != nil {
+				errCh <- err
+				return
+			}
+		}
+	}()
+
+	for {
+		select {
+		case update, ok := <-updates:
+			if !ok {
+				return status.Error(codes.Aborted, "session closed by the server")
+			}
+
+			res := &managementv1.ConnectResponse{
+				Features: models.BoolFeatures(update.Features.Features),
+				Revision: update.Features.Revision,
+				Full:     update.Full,
+				Values:   valuesToProto(update.Features.Features),
+			}
+			if update.Full {
+				res.AppPolicy = policyToProto(update.Features.Apps)
+			}
+
+			if err = stream.Send(res); err != nil {
+				return err
+			}
+
+		case err = <-errCh:
+			if errors.Is(err, io.EOF) {
+				return nil
+			}
+
+			return err
+		}
+	}
+}
+
+func (s *serverApi) reportStatus(ctx context.Context, id uuid.UUID, req *managementv1.ConnectRequest) error {
+	if err := validateStatus(req.GetLocation(), req.GetBattery()); err != nil {
+		return err
+	}
+
+	_, err := s.management.DevicePing(
+		ctx,
+		id,
+		req.GetLocation(),
+		int(req.GetBattery()),
+		req.GetAppliedRevision(),
+	)
+	if err != nil {
+		return deviceStatus(err)
+	}
+
+	return nil
+}
+
+func validateStatus(location string, battery int32) error {
+	if location == "" {
+		return status.Error(codes.InvalidArgument, "location is required")
+	}
+
+	if battery < 0 || battery > 100 {
+		return status.Error(codes.InvalidArgument, "incorrect battery state")
+	}
+
+	return nil
+}
+
+// deviceStatus reports the errors that any call of a device can fail with:
+// the device is unknown or is not enrolled.
+func deviceStatus(err error) error {
+	switch {
+	case errors.Is(err, managementsrv.ErrDeviceNotFound):
+		return status.Error(codes.NotFound, "device not found")
+	case errors.Is(err, managementsrv.ErrDeviceSuspended):
+		return status.Error(codes.PermissionDenied, "device is suspended")
+	case errors.Is(err, managementsrv.ErrDevicePending):
+		return status.Error(codes.FailedPrecondition, "device must enroll again with an enrollment token")
+	case errors.Is(err, managementsrv.ErrDeviceDecommissioned):
+		return status.Error(codes.FailedPrecondition, "device is retired or wiped")
 	}
 
-	return &managementv1.DeviceStateResponse{Features: features.Features}, nil
+	return status.Error(codes.Internal, "internal error")
 }
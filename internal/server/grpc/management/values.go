@@ -0,0 +1,83 @@
+package managementgrpc
+
+import (
+	managementv1 "github.com/dvaxert/mdm/api/gen/go/management"
+	"github.com/dvaxert/mdm/internal/domain/models"
+)
+
+func valueToProto(v models.FeatureValue) *managementv1.FeatureValue {
+	switch v.Type {
+	case models.FeatureBool:
+		return &managementv1.FeatureValue{Kind: &managementv1.FeatureValue_BoolValue{BoolValue: v.Bool}}
+	case models.FeatureInt:
+		return &managementv1.FeatureValue{Kind: &managementv1.FeatureValue_IntValue{IntValue: v.Int}}
+	case models.FeatureString:
+		return &managementv1.FeatureValue{Kind: &managementv1.FeatureValue_StringValue{StringValue: v.Str}}
+	case models.FeatureStringList:
+		return &managementv1.FeatureValue{Kind: &managementv1.FeatureValue_StringListValue{
+			StringListValue: &managementv1.StringList{Values: v.List},
+		}}
+	case models.FeatureEnum:
+		return &managementv1.FeatureValue{Kind: &managementv1.FeatureValue_EnumValue{EnumValue: v.Str}}
+	}
+
+	return nil
+}
+
+// valueFromProto reports false if the value is not set.
+func valueFromProto(v *managementv1.FeatureValue) (models.FeatureValue, bool) {
+	switch kind := v.GetKind().(type) {
+	case *managementv1.FeatureValue_BoolValue:
+		return models.BoolValue(kind.BoolValue), true
+	case *managementv1.FeatureValue_IntValue:
+		return models.FeatureValue{Type: models.FeatureInt, Int: kind.IntValue}, true
+	case *managementv1.FeatureValue_StringValue:
+		return models.FeatureValue{Type: models.FeatureString, Str: kind.StringValue}, true
+	case *managementv1.FeatureValue_StringListValue:
+		return models.FeatureValue{Type: models.FeatureStringList, List: kind.StringListValue.GetValues()}, true
+	case *managementv1.FeatureValue_EnumValue:
+		return models.FeatureValue{Type: models.FeatureEnum, Str: kind.EnumValue}, true
+	}
+
+	return models.FeatureValue{}, false
+}
+
+func valuesToProto(values map[string]models.FeatureValue) map[string]*managementv1.FeatureValue {
+	result := make(map[string]*managementv1.FeatureValue, len(values))
+	for name, v := range values {
+		result[name] = valueToProto(v)
+	}
+
+	return result
+}
+
+// reportedValues merges the typed values reported by the device with the
+// legacy bool map sent by devices that predate typed values.
+func reportedValues(req *managementv1.DeviceReportStateRequest) map[string]models.FeatureValue {
+	result := make(map[string]models.FeatureValue, len(req.GetFeatures())+len(req.GetValues()))
+	for name, state := range req.GetFeatures() {
+		result[name] = models.BoolValue(state)
+	}
+
+	for name, v := range req.GetValues() {
+		if value, ok := valueFromProto(v); ok {
+			result[name] = value
+		}
+	}
+
+	return result
+}
+
+func policyToProto(policy models.AppPolicy) *managementv1.AppPolicy {
+	result := &managementv1.AppPolicy{Apps: make([]*managementv1.AppPolicyEntry, 0, len(policy))}
+	for _, e := range policy {
+		result.Apps = append(result.Apps, &managementv1.AppPolicyEntry{
+			Id:      e.Id,
+			Name:    e.Name,
+			Version: e.Version,
+			Rule:    managementv1.AppRule(e.Rule),
+		})
+	}
+
+	return result
+}
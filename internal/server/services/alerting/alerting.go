@@ -0,0 +1,272 @@
+package alertsrv
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/dvaxert/mdm/internal/domain/models"
+	"github.com/google/uuid"
+)
+
+// Alerting evaluates the alert rules. Battery and location rules are
+// evaluated on every ping, offline and drift rules by a sweep that runs
+// every period.
+type Alerting struct {
+	log     *slog.Logger
+	storage StorageProvider
+	period  time.Duration
+
+	stop chan struct{}
+	done chan struct{}
+}
+
+type StorageProvider interface {
+	AlertRules(ctx context.Context) ([]models.AlertRule, error)
+	AlertList(ctx context.Context, filter models.AlertFilter) ([]models.Alert, error)
+	FireAlert(ctx context.Context, rule_id int64, device_uuid uuid.UUID, message string) (bool, error)
+	ResolveAlert(ctx context.Context, rule_id int64, device_uuid uuid.UUID) (bool, error)
+	DeviceStatusList(ctx context.Context, filter models.DeviceFilter, page models.Page) ([]models.DeviceStatus, string, error)
+	DeviceDriftList(ctx context.Context, filter models.DeviceFilter, page models.Page) ([]models.DeviceDrift, string, error)
+}
+
+func New(log *slog.Logger, storage StorageProvider, period time.Duration) *Alerting {
+	return &Alerting{
+		log:     log,
+		storage: storage,
+		period:  period,
+		stop:    make(chan struct{}),
+		done:    make(chan struct{}),
+	}
+}
+
+// Run sweeps the devices every period until Stop is called.
+func (a *Alerting) Run() {
+	defer close(a.done)
+
+	ticker := time.NewTicker(a.period)
+	defer ticker.Stop()
+
+	for {
+		if err := a.Sweep(context.Background()); err != nil {
+			a.log.Error("failed to sweep alert rules", slog.Any("error", err))
+		}
+
+		select {
+		case <-ticker.C:
+		case <-a.stop:
+			return
+		}
+	}
+}
+
+// Stop stops Run and waits for the running sweep to finish.
+func (a *Alerting) Stop() {
+	close(a.stop)
+	<-a.done
+}
+
+// DevicePinged evaluates the rules that depend on the status reported with
+// a ping. previous is the status before the ping. Errors are logged, they
+// must not fail the ping.
+func (a *Alerting) DevicePinged(ctx context.Context, previous models.DeviceStatus, current models.DeviceStatus) {
+	const op = "Alerting.DevicePinged"
+
+	log := a.log.With(
+		slog.String("op", op),
+		slog.String("uuid", current.DeviceUuid.String()),
+	)
+
+	rules, err := a.storage.AlertRules(ctx)
+	if err != nil {
+		log.Error("failed to get alert rules", slog.Any("error", err))
+		return
+	}
+
+	for _, rule := range rules {
+		var (
+			firing  bool
+			message string
+		)
+
+		switch rule.Condition {
+		case models.AlertBatteryBelow:
+			firing = current.Battery < rule.Threshold
+			message = fmt.Sprintf("battery %d%% is below %d%%", current.Battery, rule.Threshold)
+		case models.AlertLocationChanged:
+			firing = previous.Reported && previous.Location != current.Location
+			message = fmt.Sprintf("location changed from %q to %q", previous.Location, current.Location)
+		case models.AlertOffline:
+			// устройство только что вышло на связь
+		default:
+			continue
+		}
+
+		if err = a.apply(ctx, log, rule, current.DeviceUuid, firing, message); err != nil {
+			log.Error("failed to apply alert rule", slog.String("rule", rule.Name), slog.Any("error", err))
+		}
+	}
+}
+
+// Sweep evaluates the offline and drift rules for all devices.
+func (a *Alerting) Sweep(ctx context.Context) error {
+	const op = "Alerting.Sweep"
+
+	log := a.log.With(slog.String("op", op))
+
+	rules, err := a.storage.AlertRules(ctx)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	var (
+		statuses []models.DeviceStatus
+		drifts   []models.DeviceDrift
+	)
+	for _, rule := range rules {
+		switch {
+		case rule.Condition == models.AlertOffline && statuses == nil:
+			if statuses, err = a.statuses(ctx); err != nil {
+				return fmt.Errorf("%s: %w", op, err)
+			}
+		case rule.Condition == models.AlertFeatureDrift && drifts == nil:
+			if drifts, err = a.drifts(ctx); err != nil {
+				return fmt.Errorf("%s: %w", op, err)
+			}
+		}
+	}
+
+	firing, err := a.storage.AlertList(ctx, models.AlertFilter{FiringOnly: true})
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	now := time.Now()
+
+	for _, rule := range rules {
+		// устройства, для которых условие правила выполняется, с сообщениями
+		matched := make(map[uuid.UUID]string)
+
+		switch rule.Condition {
+		case models.AlertOffline:
+			for _, s := range statuses {
+				if s.LastSeen.IsZero() {
+					matched[s.DeviceUuid] = "device has never been seen"
+				} else if silent := now.Sub(s.LastSeen); silent >= rule.Duration {
+					matched[s.DeviceUuid] = fmt.Sprintf("device has been offline for %s", silent.Round(time.Second))
+				}
+			}
+		case models.AlertFeatureDrift:
+			for _, d := range drifts {
+				for _, f := range d.Features {
+					if rule.Feature != "" && f.Feature != rule.Feature {
+						continue
+					}
+
+					if drifted := now.Sub(f.Since); drifted >= rule.Duration {
+						matched[d.DeviceUuid] = fmt.Sprintf("feature %s has drifted for %s", f.Feature, drifted.Round(time.Second))
+						break
+					}
+				}
+			}
+		default:
+			continue
+		}
+
+		for device_uuid, message := range matched {
+			if err = a.apply(ctx, log, rule, device_uuid, true, message); err != nil {
+				return fmt.Errorf("%s: %w", op, err)
+			}
+		}
+
+		for _, alert := range firing {
+			if _, ok := matched[alert.DeviceUuid]; ok || alert.Rule != rule.Name {
+				continue
+			}
+
+			if err = a.apply(ctx, log, rule, alert.DeviceUuid, false, ""); err != nil {
+				return fmt.Errorf("%s: %w", op, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// apply fires or resolves the alert of the rule for the device.
+func (a *Alerting) apply(
+	ctx context.Context,
+	log *slog.Logger,
+	rule models.AlertRule,
+	device_uuid uuid.UUID,
+	firing bool,
+	message string,
+) error {
+	log = log.With(
+		slog.String("rule", rule.Name),
+		slog.String("device", device_uuid.String()),
+	)
+
+	if !firing {
+		resolved, err := a.storage.ResolveAlert(ctx, rule.Id, device_uuid)
+		if err != nil {
+			return err
+		}
+
+		if resolved {
+			log.Info("alert resolved")
+		}
+
+		return nil
+	}
+
+	raised, err := a.storage.FireAlert(ctx, rule.Id, device_uuid, message)
+	if err != nil {
+		return err
+	}
+
+	if raised {
+		log.Warn("alert fired", slog.String("message", message))
+	}
+
+	return nil
+}
+
+func (a *Alerting) statuses(ctx context.Context) ([]models.DeviceStatus, error) {
+	result := []models.DeviceStatus{}
+
+	page := models.Page{Size: models.MaxPageSize}
+	for {
+		list, next, err := a.storage.DeviceStatusList(ctx, models.DeviceFilter{}, page)
+		if err != nil {
+			return nil, err
+		}
+
+		result = append(result, list...)
+
+		if next == "" {
+			return result, nil
+		}
+		page.Token = next
+	}
+}
+
+func (a *Alerting) drifts(ctx context.Context) ([]models.DeviceDrift, error) {
+	result := []models.DeviceDrift{}
+
+	page := models.Page{Size: models.MaxPageSize}
+	for {
+		list, next, err := a.storage.DeviceDriftList(ctx, models.DeviceFilter{}, page)
+		if err != nil {
+			return nil, err
+		}
+
+		result = append(result, list...)
+
+		if next == "" {
+			return result, nil
+		}
+		page.Token = next
+	}
+}
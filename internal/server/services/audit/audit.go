@@ -0,0 +1,143 @@
+package auditsrv
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/dvaxert/mdm/internal/domain/models"
+)
+
+// verifyBatch ограничивает число записей, читаемых за раз при проверке
+const verifyBatch = 1000
+
+// Audit writes the audit log of the Control calls and checks its chain.
+type Audit struct {
+	log     *slog.Logger
+	storage StorageProvider
+}
+
+type StorageProvider interface {
+	AppendAudit(ctx context.Context, record models.AuditRecord) (models.AuditRecord, error)
+	AuditRecords(ctx context.Context, after int64, limit int) ([]models.AuditRecord, error)
+}
+
+func New(log *slog.Logger, storage StorageProvider) *Audit {
+	return &Audit{
+		log:     log,
+		storage: storage,
+	}
+}
+
+// Record appends the record to the audit log.
+func (a *Audit) Record(ctx context.Context, record models.AuditRecord) error {
+	const op = "Audit.Record"
+
+	record, err := a.storage.AppendAudit(ctx, record)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	a.log.Debug(
+		"audit record appended",
+		slog.String("op", op),
+		slog.Int64("id", record.Id),
+		slog.String("actor", record.Actor),
+		slog.String("method", record.Method),
+	)
+
+	return nil
+}
+
+// Verify walks the audit log from the first record and checks that every
+// record is chained to the previous one and matches its hash.
+func (a *Audit) Verify(ctx context.Context) (models.AuditVerification, error) {
+	const op = "Audit.Verify"
+
+	log := a.log.With(
+		slog.String("op", op),
+	)
+
+	log.Info("attempting to verify audit log")
+
+	var (
+		result = models.AuditVerification{Valid: true}
+		last   int64
+	)
+
+	for {
+		batch, err := a.storage.AuditRecords(ctx, last, verifyBatch)
+		if err != nil {
+			return models.AuditVerification{}, fmt.Errorf("%s: %w", op, err)
+		}
+
+		for _, record := range batch {
+			reason := ""
+			switch {
+			case record.Id != last+1:
+				reason = fmt.Sprintf("record %d is missing", last+1)
+			case record.PrevHash != result.Head:
+				reason = "record is not chained to the previous one"
+			case record.Hash != record.ComputeHash():
+				reason = "record does not match its hash"
+			}
+
+			if reason != "" {
+				result.Valid = false
+				result.BrokenAt = record.Id
+				result.Reason = reason
+
+				log.Warn("audit log chain is broken", slog.Int64("id", record.Id), slog.String("reason", reason))
+
+				return result, nil
+			}
+
+			result.Records++
+			result.Head = record.Hash
+			last = record.Id
+		}
+
+		if len(batch) < verifyBatch {
+			break
+		}
+	}
+
+	log.Info("audit log verified successfully", slog.Int64("records", result.Records))
+
+	return result, nil
+}
+
+type callKey struct{}
+
+// Call collects what the audit record of a call needs from the handlers:
+// the operator making it and the value it changes.
+type Call struct {
+	Actor    string
+	Previous string
+	New      string
+}
+
+// WithCall returns a copy of ctx where the handlers of the call can report
+// the operator with SetActor and the changed value with SetChange.
+func WithCall(ctx context.Context) (context.Context, *Call) {
+	call := &Call{}
+
+	return context.WithValue(ctx, callKey{}, call), call
+}
+
+// SetActor reports the operator making the call, it does nothing if the
+// call is not audited.
+func SetActor(ctx context.Context, actor string) {
+	if call, ok := ctx.Value(callKey{}).(*Call); ok {
+		call.Actor = actor
+	}
+}
+
+// SetChange reports the value changed by the call, it does nothing if the
+// call is not audited.
+func SetChange(ctx context.Context, previous string, new string) {
+	if call, ok := ctx.Value(callKey{}).(*Call); ok {
+		call.Previous = previous
+		call.New = new
+	}
+}
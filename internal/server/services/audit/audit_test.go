@@ -0,0 +1,185 @@
+package auditsrv
+
+import (
+	"context"
+	"database/sql"
+	"io"
+	"log/slog"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/dvaxert/mdm/internal/domain/models"
+	"github.com/dvaxert/mdm/internal/server/storage/sqlite"
+	"github.com/google/uuid"
+)
+
+var (
+	deviceA = uuid.MustParse("11111111-1111-1111-1111-111111111111")
+	deviceB = uuid.MustParse("22222222-2222-2222-2222-222222222222")
+)
+
+// newAudit returns the audit writing to a new database and the database
+// itself opened a second time, to change the log as an attacker with
+// access to the file would.
+func newAudit(t *testing.T, records int) (*Audit, *sql.DB) {
+	t.Helper()
+
+	path := filepath.Join(t.TempDir(), "storage.db")
+
+	s, err := sqlite.New(path)
+	if err != nil {
+		t.Fatalf("open storage: %v", err)
+	}
+	t.Cleanup(func() { s.Close() })
+
+	a := New(slog.New(slog.NewTextHandler(io.Discard, nil)), s)
+
+	for i := 0; i < records; i++ {
+		err = a.Record(context.Background(), models.AuditRecord{
+			Time:    time.Now(),
+			Actor:   "alice",
+			Peer:    "127.0.0.1:5000",
+			Method:  "/control.Control/BulkSetFeatureState",
+			Devices: []uuid.UUID{deviceA, deviceB},
+			Payload: `{"feature":"camera"}`,
+			New:     "true",
+			Result:  "OK",
+		})
+		if err != nil {
+			t.Fatalf("record: %v", err)
+		}
+	}
+
+	db, err := sql.Open("sqlite", path)
+	if err != nil {
+		t.Fatalf("open database: %v", err)
+	}
+	t.Cleanup(func() { db.Close() })
+
+	for _, trigger := range []string{"audit_log_no_update", "audit_log_no_delete", "audit_devices_no_update", "audit_devices_no_delete"} {
+		if _, err = db.Exec("DROP TRIGGER " + trigger + ";"); err != nil {
+			t.Fatalf("drop trigger: %v", err)
+		}
+	}
+
+	return a, db
+}
+
+func TestVerify(t *testing.T) {
+	a, _ := newAudit(t, 5)
+
+	result, err := a.Verify(context.Background())
+	if err != nil {
+		t.Fatalf("Verify() error = %v", err)
+	}
+
+	if !result.Valid || result.Records != 5 || result.BrokenAt != 0 || result.Head == "" {
+		t.Errorf("Verify() = %+v, want 5 valid records", result)
+	}
+}
+
+func TestVerifyEmpty(t *testing.T) {
+	a, _ := newAudit(t, 0)
+
+	result, err := a.Verify(context.Background())
+	if err != nil {
+		t.Fatalf("Verify() error = %v", err)
+	}
+
+	if !result.Valid || result.Records != 0 || result.Head != "" {
+		t.Errorf("Verify() = %+v, want an empty valid log", result)
+	}
+}
+
+func TestVerifyTampered(t *testing.T) {
+	tests := []struct {
+		name     string
+		tamper   []string
+		brokenAt int64
+		records  int64
+	}{
+		{
+			name:     "field edited",
+			tamper:   []string{"UPDATE audit_log SET actor = 'mallory' WHERE id = 3;"},
+			brokenAt: 3,
+			records:  2,
+		},
+		{
+			name:     "field and hash edited",
+			tamper:   []string{"UPDATE audit_log SET result = 'PermissionDenied', hash = 'ffff' WHERE id = 2;"},
+			brokenAt: 2,
+			records:  1,
+		},
+		{
+			name: "middle record deleted",
+			tamper: []string{
+				"DELETE FROM audit_devices WHERE record_id = 3;",
+				"DELETE FROM audit_log WHERE id = 3;",
+			},
+			brokenAt: 4,
+			records:  2,
+		},
+		{
+			name: "middle record deleted and renumbered",
+			tamper: []string{
+				"DELETE FROM audit_devices WHERE record_id = 3;",
+				"DELETE FROM audit_log WHERE id = 3;",
+				"UPDATE audit_devices SET record_id = record_id - 1 WHERE record_id > 3;",
+				"UPDATE audit_log SET id = id - 1 WHERE id > 3;",
+			},
+			brokenAt: 3,
+			records:  2,
+		},
+		{
+			name: "devices reordered",
+			tamper: []string{
+				"DELETE FROM audit_devices WHERE record_id = 2;",
+				"INSERT INTO audit_devices(record_id, device_uuid) VALUES(2, '" + deviceB.String() + "'), (2, '" + deviceA.String() + "');",
+			},
+			brokenAt: 2,
+			records:  1,
+		},
+		{
+			name:     "device removed",
+			tamper:   []string{"DELETE FROM audit_devices WHERE record_id = 4 AND device_uuid = '" + deviceB.String() + "';"},
+			brokenAt: 4,
+			records:  3,
+		},
+		{
+			name:     "first record edited",
+			tamper:   []string{"UPDATE audit_log SET payload = '{}' WHERE id = 1;"},
+			brokenAt: 1,
+			records:  0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a, db := newAudit(t, 5)
+
+			for _, query := range tt.tamper {
+				if _, err := db.Exec(query); err != nil {
+					t.Fatalf("tamper: %v", err)
+				}
+			}
+
+			result, err := a.Verify(context.Background())
+			if err != nil {
+				t.Fatalf("Verify() error = %v", err)
+			}
+
+			if result.Valid {
+				t.Fatalf("Verify() = %+v, want the chain broken", result)
+			}
+
+			if result.BrokenAt != tt.brokenAt {
+				t.Errorf("Verify() BrokenAt = %d, want %d (%s)", result.BrokenAt, tt.brokenAt, result.Reason)
+			}
+
+			if result.Records != tt.records {
+				t.Errorf("Verify() Records = %d, want %d", result.Records, tt.records)
+			}
+		})
+	}
+}
@@ -0,0 +1,282 @@
+package authsrv
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"github.com/dvaxert/mdm/internal/domain/models"
+	"github.com/dvaxert/mdm/internal/server/storage"
+	"github.com/google/uuid"
+)
+
+var (
+	ErrUnauthenticated  = errors.New("operator is not authenticated")
+	ErrPermissionDenied = errors.New("permission denied")
+)
+
+// Auth authenticates the operators of the Control API and checks their
+// roles. When it is disabled every call is allowed.
+type Auth struct {
+	log     *slog.Logger
+	storage StorageProvider
+	enabled bool
+}
+
+type StorageProvider interface {
+	Operator(ctx context.Context, name string) (models.Operator, error)
+	OperatorByToken(ctx context.Context, token_hash string) (models.Operator, error)
+	Roles(ctx context.Context, names []string) ([]models.Role, error)
+	DeviceInScope(ctx context.Context, device_uuid uuid.UUID, groups []string, selector models.Selector) (bool, error)
+	GroupInScope(ctx context.Context, group string, groups []string) (bool, error)
+}
+
+func New(log *slog.Logger, storage StorageProvider, enabled bool) *Auth {
+	return &Auth{
+		log:     log,
+		storage: storage,
+		enabled: enabled,
+	}
+}
+
+func (a *Auth) Enabled() bool {
+	return a.enabled
+}
+
+// Authenticate returns the operator with the API token, or, without a
+// token, the operator named by the common name of the verified client
+// certificate. An empty certName means the client has not presented a
+// certificate.
+func (a *Auth) Authenticate(ctx context.Context, token string, certName string) (models.Operator, error) {
+	const op = "Auth.Authenticate"
+
+	var (
+		operator models.Operator
+		err      error
+	)
+
+	switch {
+	case token != "":
+		operator, err = a.storage.OperatorByToken(ctx, models.TokenHash(token))
+	case certName != "":
+		// сертификаты устройств не подходят: имя оператора не может быть UUID
+		if (models.Operator{Name: certName}).Validate() != nil {
+			return models.Operator{}, fmt.Errorf("%s: %w", op, ErrUnauthenticated)
+		}
+
+		operator, err = a.storage.Operator(ctx, certName)
+	default:
+		return models.Operator{}, fmt.Errorf("%s: %w", op, ErrUnauthenticated)
+	}
+
+	if errors.Is(err, storage.ErrOperatorNotFound) {
+		return models.Operator{}, fmt.Errorf("%s: %w", op, ErrUnauthenticated)
+	}
+	if err != nil {
+		return models.Operator{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return operator, nil
+}
+
+// Authorize checks that the roles of the operator grant the permission for
+// the target. A catalog call needs any role granting the permission, a
+// call without a target needs such a role that is not scoped, unless it
+// lists devices (see Scope), otherwise every device and the group of the
+// target must be in the scope of a role granting the permission.
+func (a *Auth) Authorize(
+	ctx context.Context,
+	operator models.Operator,
+	permission models.Permission,
+	target models.AccessTarget,
+) error {
+	const op = "Auth.Authorize"
+
+	log := a.log.With(
+		slog.String("op", op),
+		slog.String("operator", operator.Name),
+		slog.String("permission", permission.String()),
+	)
+
+	roles, err := a.roles(ctx, operator.Roles)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	var scoped []models.Role
+	for _, role := range roles {
+		if !role.Allows(permission) {
+			continue
+		}
+
+		if !role.Scoped() || target.Catalog {
+			return nil
+		}
+
+		scoped = append(scoped, role)
+	}
+
+	if len(scoped) == 0 || target.Empty() && !target.List {
+		log.Warn("access denied")
+
+		return fmt.Errorf("%s: %w", op, ErrPermissionDenied)
+	}
+
+	for _, device := range target.Devices {
+		ok, err := a.deviceInScope(ctx, device, scoped)
+		if err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+
+		if !ok {
+			log.Warn("access denied", slog.String("device_id", device.String()))
+
+			return fmt.Errorf("%s: %w", op, ErrPermissionDenied)
+		}
+	}
+
+	if target.Group != "" {
+		ok, err := a.groupInScope(ctx, target.Group, scoped)
+		if err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+
+		if !ok {
+			log.Warn("access denied", slog.String("group", target.Group))
+
+			return fmt.Errorf("%s: %w", op, ErrPermissionDenied)
+		}
+	}
+
+	return nil
+}
+
+// Scope returns the devices the roles of the operator grant the permission
+// on, nil if a role grants it on every device. A list made by the operator
+// must be limited to the scope.
+func (a *Auth) Scope(ctx context.Context, operator models.Operator, permission models.Permission) (*models.DeviceScope, error) {
+	const op = "Auth.Scope"
+
+	roles, err := a.roles(ctx, operator.Roles)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	scope := &models.DeviceScope{}
+	for _, role := range roles {
+		if !role.Allows(permission) {
+			continue
+		}
+
+		if !role.Scoped() {
+			return nil, nil
+		}
+
+		// селектор проверен при создании роли
+		if err = scope.Add(role); err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+	}
+
+	return scope, nil
+}
+
+// roles returns the roles with the names, built-in roles included. Roles
+// that no longer exist grant nothing.
+func (a *Auth) roles(ctx context.Context, names []string) ([]models.Role, error) {
+	var (
+		result []models.Role
+		custom []string
+	)
+
+	for _, name := range names {
+		if role, ok := models.BuiltinRole(name); ok {
+			result = append(result, role)
+		} else {
+			custom = append(custom, name)
+		}
+	}
+
+	if len(custom) == 0 {
+		return result, nil
+	}
+
+	stored, err := a.storage.Roles(ctx, custom)
+	if err != nil {
+		return nil, err
+	}
+
+	return append(result, stored...), nil
+}
+
+func (a *Auth) deviceInScope(ctx context.Context, device uuid.UUID, roles []models.Role) (bool, error) {
+	for _, role := range roles {
+		// селектор проверен при создании роли
+		selector, err := models.ParseSelector(role.Selector)
+		if err != nil {
+			return false, err
+		}
+
+		ok, err := a.storage.DeviceInScope(ctx, device, role.Groups, selector)
+		if errors.Is(err, storage.ErrDeviceNotFound) {
+			// неизвестное устройство не входит ни в одну область
+			return false, nil
+		}
+		if err != nil {
+			return false, err
+		}
+
+		if ok {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+func (a *Auth) groupInScope(ctx context.Context, group string, roles []models.Role) (bool, error) {
+	for _, role := range roles {
+		ok, err := a.storage.GroupInScope(ctx, group, role.Groups)
+		if err != nil {
+			return false, err
+		}
+
+		if ok {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+type operatorKey struct{}
+
+// WithOperator returns a copy of ctx carrying the authenticated operator.
+func WithOperator(ctx context.Context, operator models.Operator) context.Context {
+	return context.WithValue(ctx, operatorKey{}, operator)
+}
+
+// OperatorFromContext returns the operator the call is made by, false if
+// authentication is disabled.
+func OperatorFromContext(ctx context.Context) (models.Operator, bool) {
+	operator, ok := ctx.Value(operatorKey{}).(models.Operator)
+
+	return operator, ok
+}
+
+type scopeKey struct{}
+
+// WithScope returns a copy of ctx carrying the scope the lists of the call
+// are limited to.
+func WithScope(ctx context.Context, scope *models.DeviceScope) context.Context {
+	return context.WithValue(ctx, scopeKey{}, scope)
+}
+
+// ScopeFromContext returns the scope the lists of the call are limited to,
+// nil if they are not limited.
+func ScopeFromContext(ctx context.Context) *models.DeviceScope {
+	scope, _ := ctx.Value(scopeKey{}).(*models.DeviceScope)
+
+	return scope
+}
@@ -0,0 +1,356 @@
+package authsrv
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"reflect"
+	"slices"
+	"testing"
+
+	"github.com/dvaxert/mdm/internal/domain/models"
+	"github.com/dvaxert/mdm/internal/server/storage"
+	"github.com/google/uuid"
+)
+
+type fakeStorage struct {
+	StorageProvider
+	operators []models.Operator
+	roles     []models.Role
+	devices   map[uuid.UUID]fakeDevice
+	parents   map[string]string
+}
+
+// fakeDevice lists the groups of the device together with the groups they
+// are nested in.
+type fakeDevice struct {
+	groups []string
+	labels map[string]string
+}
+
+func (s *fakeStorage) Operator(ctx context.Context, name string) (models.Operator, error) {
+	for _, operator := range s.operators {
+		if operator.Name == name {
+			return operator, nil
+		}
+	}
+
+	return models.Operator{}, storage.ErrOperatorNotFound
+}
+
+func (s *fakeStorage) OperatorByToken(ctx context.Context, token_hash string) (models.Operator, error) {
+	for _, operator := range s.operators {
+		if operator.TokenHash != "" && operator.TokenHash == token_hash {
+			return operator, nil
+		}
+	}
+
+	return models.Operator{}, storage.ErrOperatorNotFound
+}
+
+func (s *fakeStorage) Roles(ctx context.Context, names []string) ([]models.Role, error) {
+	var result []models.Role
+	for _, role := range s.roles {
+		if slices.Contains(names, role.Name) {
+			result = append(result, role)
+		}
+	}
+
+	return result, nil
+}
+
+func (s *fakeStorage) DeviceInScope(
+	ctx context.Context,
+	device_uuid uuid.UUID,
+	groups []string,
+	selector models.Selector,
+) (bool, error) {
+	device, ok := s.devices[device_uuid]
+	if !ok {
+		return false, storage.ErrDeviceNotFound
+	}
+
+	for _, group := range groups {
+		if slices.Contains(device.groups, group) {
+			return true, nil
+		}
+	}
+
+	return len(selector) != 0 && selector.Matches(device.labels), nil
+}
+
+func (s *fakeStorage) GroupInScope(ctx context.Context, group string, groups []string) (bool, error) {
+	for g := group; g != ""; g = s.parents[g] {
+		if slices.Contains(groups, g) {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+func TestAuthenticate(t *testing.T) {
+	device := uuid.New()
+
+	a := New(slog.New(slog.NewTextHandler(io.Discard, nil)), &fakeStorage{
+		operators: []models.Operator{
+			{Name: "alice", TokenHash: models.TokenHash("alice-token")},
+			{Name: "bob"},
+			// такого оператора не создать, но и из хранилища его не принять
+			{Name: device.String()},
+		},
+	}, true)
+
+	tests := []struct {
+		name     string
+		token    string
+		certName string
+		want     string
+		wantErr  error
+	}{
+		{name: "token", token: "alice-token", want: "alice"},
+		{name: "token before certificate", token: "alice-token", certName: "bob", want: "alice"},
+		{name: "unknown token", token: "bob-token", certName: "bob", wantErr: ErrUnauthenticated},
+		{name: "certificate", certName: "bob", want: "bob"},
+		{name: "certificate of unknown operator", certName: "mallory", wantErr: ErrUnauthenticated},
+		{name: "device certificate", certName: device.String(), wantErr: ErrUnauthenticated},
+		{name: "no credentials", wantErr: ErrUnauthenticated},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			operator, err := a.Authenticate(context.Background(), tt.token, tt.certName)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Authenticate() error = %v, want %v", err, tt.wantErr)
+			}
+
+			if operator.Name != tt.want {
+				t.Errorf("Authenticate() operator = %q, want %q", operator.Name, tt.want)
+			}
+		})
+	}
+}
+
+func TestAuthorize(t *testing.T) {
+	oslo, bergen, kiosk, unknown := uuid.New(), uuid.New(), uuid.New(), uuid.New()
+
+	a := New(slog.New(slog.NewTextHandler(io.Discard, nil)), &fakeStorage{
+		roles: []models.Role{
+			{Name: "oslo", Permissions: []models.Permission{models.PermissionRead}, Groups: []string{"oslo"}},
+			{
+				Name:        "kiosk",
+				Permissions: []models.Permission{models.PermissionRead, models.PermissionFeatures},
+				Selector:    "tier=kiosk",
+			},
+			{Name: "bergen-site", Permissions: []models.Permission{models.PermissionRead}, Selector: "site=bergen"},
+		},
+		devices: map[uuid.UUID]fakeDevice{
+			oslo:   {groups: []string{"oslo-floor", "oslo"}, labels: map[string]string{"site": "oslo"}},
+			bergen: {groups: []string{"bergen"}, labels: map[string]string{"site": "bergen"}},
+			kiosk:  {labels: map[string]string{"tier": "kiosk"}},
+		},
+		parents: map[string]string{"oslo-floor": "oslo"},
+	}, true)
+
+	tests := []struct {
+		name       string
+		roles      []string
+		permission models.Permission
+		target     models.AccessTarget
+		wantErr    error
+	}{
+		{name: "fleet role without target", roles: []string{"read-only"}, permission: models.PermissionRead},
+		{
+			name:       "fleet role on a device",
+			roles:      []string{"oslo", "feature-admin"},
+			permission: models.PermissionFeatures,
+			target:     models.AccessTarget{Devices: []uuid.UUID{bergen}},
+		},
+		{
+			name:       "permission not granted",
+			roles:      []string{"read-only"},
+			permission: models.PermissionFeatures,
+			target:     models.AccessTarget{Devices: []uuid.UUID{oslo}},
+			wantErr:    ErrPermissionDenied,
+		},
+		{name: "no roles", permission: models.PermissionRead, wantErr: ErrPermissionDenied},
+		{
+			name:       "unknown role",
+			roles:      []string{"deleted"},
+			permission: models.PermissionRead,
+			target:     models.AccessTarget{Catalog: true},
+			wantErr:    ErrPermissionDenied,
+		},
+		{
+			name:       "scoped role without target",
+			roles:      []string{"oslo"},
+			permission: models.PermissionRead,
+			wantErr:    ErrPermissionDenied,
+		},
+		{
+			name:       "scoped role on a list",
+			roles:      []string{"oslo"},
+			permission: models.PermissionRead,
+			target:     models.AccessTarget{List: true},
+		},
+		{
+			name:       "scoped role on a catalog",
+			roles:      []string{"oslo"},
+			permission: models.PermissionRead,
+			target:     models.AccessTarget{Catalog: true},
+		},
+		{
+			name:       "catalog without the permission",
+			roles:      []string{"oslo"},
+			permission: models.PermissionFeatures,
+			target:     models.AccessTarget{Catalog: true},
+			wantErr:    ErrPermissionDenied,
+		},
+		{
+			name:       "device in a subgroup",
+			roles:      []string{"oslo"},
+			permission: models.PermissionRead,
+			target:     models.AccessTarget{Devices: []uuid.UUID{oslo}},
+		},
+		{
+			name:       "device out of the groups",
+			roles:      []string{"oslo"},
+			permission: models.PermissionRead,
+			target:     models.AccessTarget{Devices: []uuid.UUID{bergen}},
+			wantErr:    ErrPermissionDenied,
+		},
+		{
+			name:       "device matching the selector",
+			roles:      []string{"kiosk"},
+			permission: models.PermissionFeatures,
+			target:     models.AccessTarget{Devices: []uuid.UUID{kiosk}},
+		},
+		{
+			name:       "device not matching the selector",
+			roles:      []string{"kiosk"},
+			permission: models.PermissionFeatures,
+			target:     models.AccessTarget{Devices: []uuid.UUID{oslo}},
+			wantErr:    ErrPermissionDenied,
+		},
+		{
+			name:       "devices in the scopes of different roles",
+			roles:      []string{"oslo", "kiosk"},
+			permission: models.PermissionRead,
+			target:     models.AccessTarget{Devices: []uuid.UUID{oslo, kiosk}},
+		},
+		{
+			name:       "one device out of the scope",
+			roles:      []string{"oslo", "kiosk"},
+			permission: models.PermissionRead,
+			target:     models.AccessTarget{Devices: []uuid.UUID{oslo, bergen, kiosk}},
+			wantErr:    ErrPermissionDenied,
+		},
+		{
+			name:       "scope of a role without the permission",
+			roles:      []string{"oslo", "kiosk"},
+			permission: models.PermissionFeatures,
+			target:     models.AccessTarget{Devices: []uuid.UUID{oslo}},
+			wantErr:    ErrPermissionDenied,
+		},
+		{
+			name:       "unknown device",
+			roles:      []string{"oslo"},
+			permission: models.PermissionRead,
+			target:     models.AccessTarget{Devices: []uuid.UUID{unknown}},
+			wantErr:    ErrPermissionDenied,
+		},
+		{
+			name:       "subgroup",
+			roles:      []string{"oslo"},
+			permission: models.PermissionRead,
+			target:     models.AccessTarget{Group: "oslo-floor"},
+		},
+		{
+			name:       "group out of the scope",
+			roles:      []string{"oslo"},
+			permission: models.PermissionRead,
+			target:     models.AccessTarget{Group: "bergen"},
+			wantErr:    ErrPermissionDenied,
+		},
+		{
+			name:       "group with a selector role",
+			roles:      []string{"bergen-site"},
+			permission: models.PermissionRead,
+			target:     models.AccessTarget{Group: "bergen"},
+			wantErr:    ErrPermissionDenied,
+		},
+		{
+			name:       "device in the scope, group out of it",
+			roles:      []string{"oslo"},
+			permission: models.PermissionRead,
+			target:     models.AccessTarget{Devices: []uuid.UUID{oslo}, Group: "bergen"},
+			wantErr:    ErrPermissionDenied,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			operator := models.Operator{Name: "alice", Roles: tt.roles}
+
+			err := a.Authorize(context.Background(), operator, tt.permission, tt.target)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("Authorize() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestScope(t *testing.T) {
+	a := New(slog.New(slog.NewTextHandler(io.Discard, nil)), &fakeStorage{
+		roles: []models.Role{
+			{Name: "oslo", Permissions: []models.Permission{models.PermissionRead}, Groups: []string{"oslo"}},
+			{
+				Name:        "kiosk",
+				Permissions: []models.Permission{models.PermissionRead, models.PermissionFeatures},
+				Groups:      []string{"lobby"},
+				Selector:    "tier=kiosk",
+			},
+		},
+	}, true)
+
+	kiosk, err := models.ParseSelector("tier=kiosk")
+	if err != nil {
+		t.Fatalf("ParseSelector() error = %v", err)
+	}
+
+	tests := []struct {
+		name       string
+		roles      []string
+		permission models.Permission
+		want       *models.DeviceScope
+	}{
+		{name: "fleet role", roles: []string{"oslo", "read-only"}, permission: models.PermissionRead},
+		{
+			name:       "scoped roles",
+			roles:      []string{"oslo", "kiosk"},
+			permission: models.PermissionRead,
+			want:       &models.DeviceScope{Groups: []string{"oslo", "lobby"}, Selectors: []models.Selector{kiosk}},
+		},
+		{
+			name:       "role without the permission",
+			roles:      []string{"oslo", "kiosk"},
+			permission: models.PermissionFeatures,
+			want:       &models.DeviceScope{Groups: []string{"lobby"}, Selectors: []models.Selector{kiosk}},
+		},
+		{name: "no roles", permission: models.PermissionRead, want: &models.DeviceScope{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := a.Scope(context.Background(), models.Operator{Name: "alice", Roles: tt.roles}, tt.permission)
+			if err != nil {
+				t.Fatalf("Scope() error = %v", err)
+			}
+
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Scope() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
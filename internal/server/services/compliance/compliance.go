@@ -0,0 +1,192 @@
+package compliancesrv
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/dvaxert/mdm/internal/domain/models"
+	"github.com/google/uuid"
+)
+
+// Compliance evaluates the compliance rules. A device is evaluated on every
+// ping, all devices are evaluated when the rules change and by a sweep that
+// runs every period, which catches the devices that stop checking in.
+type Compliance struct {
+	log     *slog.Logger
+	storage StorageProvider
+	events  EventNotifier
+	period  time.Duration
+
+	stop chan struct{}
+	done chan struct{}
+}
+
+type StorageProvider interface {
+	ComplianceRules(ctx context.Context) ([]models.ComplianceRule, error)
+	DeviceComplianceInput(ctx context.Context, device_uuid uuid.UUID) (models.ComplianceInput, error)
+	ComplianceInputs(ctx context.Context) ([]models.ComplianceInput, error)
+	SaveDeviceCompliance(
+		ctx context.Context,
+		device_uuid uuid.UUID,
+		status models.ComplianceStatus,
+		reasons []models.ComplianceReason,
+	) (models.DeviceCompliance, bool, error)
+}
+
+// EventNotifier delivers the lifecycle events of devices. Errors are handled
+// by the notifier.
+type EventNotifier interface {
+	Notify(ctx context.Context, event models.Event)
+}
+
+func New(log *slog.Logger, storage StorageProvider, events EventNotifier, period time.Duration) *Compliance {
+	return &Compliance{
+		log:     log,
+		storage: storage,
+		events:  events,
+		period:  period,
+		stop:    make(chan struct{}),
+		done:    make(chan struct{}),
+	}
+}
+
+// Run sweeps the devices every period until Stop is called.
+func (c *Compliance) Run() {
+	defer close(c.done)
+
+	ticker := time.NewTicker(c.period)
+	defer ticker.Stop()
+
+	for {
+		if err := c.Sweep(context.Background()); err != nil {
+			c.log.Error("failed to sweep compliance rules", slog.Any("error", err))
+		}
+
+		select {
+		case <-ticker.C:
+		case <-c.stop:
+			return
+		}
+	}
+}
+
+// Stop stops Run and waits for the running sweep to finish.
+func (c *Compliance) Stop() {
+	close(c.stop)
+	<-c.done
+}
+
+// DevicePinged evaluates the device that has just checked in. Errors are
+// logged, they must not fail the ping.
+func (c *Compliance) DevicePinged(ctx context.Context, device_uuid uuid.UUID) {
+	const op = "Compliance.DevicePinged"
+
+	log := c.log.With(
+		slog.String("op", op),
+		slog.String("uuid", device_uuid.String()),
+	)
+
+	rules, err := c.storage.ComplianceRules(ctx)
+	if err != nil {
+		log.Error("failed to get compliance rules", slog.Any("error", err))
+		return
+	}
+
+	input, err := c.storage.DeviceComplianceInput(ctx, device_uuid)
+	if err != nil {
+		log.Error("failed to get device compliance data", slog.Any("error", err))
+		return
+	}
+
+	if err = c.evaluate(ctx, log, rules, input, time.Now()); err != nil {
+		log.Error("failed to evaluate device compliance", slog.Any("error", err))
+	}
+}
+
+// RulesChanged evaluates all devices against the changed rules. Errors are
+// logged, the rules are already stored.
+func (c *Compliance) RulesChanged(ctx context.Context) {
+	if err := c.Sweep(ctx); err != nil {
+		c.log.Error("failed to evaluate compliance rules", slog.Any("error", err))
+	}
+}
+
+// Sweep evaluates all devices in service. A device that fails to evaluate
+// does not stop the sweep, the errors of all such devices are returned
+// together once every device has been evaluated.
+func (c *Compliance) Sweep(ctx context.Context) error {
+	const op = "Compliance.Sweep"
+
+	log := c.log.With(slog.String("op", op))
+
+	rules, err := c.storage.ComplianceRules(ctx)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	inputs, err := c.storage.ComplianceInputs(ctx)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	now := time.Now()
+
+	// ошибка на одном устройстве не должна оставлять остальные без проверки
+	var errs []error
+	for _, input := range inputs {
+		if err = c.evaluate(ctx, log, rules, input, now); err != nil {
+			log.Error(
+				"failed to evaluate device compliance",
+				slog.String("device", input.DeviceUuid.String()),
+				slog.Any("error", err),
+			)
+
+			errs = append(errs, fmt.Errorf("device %s: %w", input.DeviceUuid, err))
+		}
+	}
+
+	if err = errors.Join(errs...); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+// evaluate stores the compliance of the device and raises the compliance
+// changed event if its status has changed.
+func (c *Compliance) evaluate(
+	ctx context.Context,
+	log *slog.Logger,
+	rules []models.ComplianceRule,
+	input models.ComplianceInput,
+	now time.Time,
+) error {
+	status, reasons := models.EvaluateCompliance(rules, input, now)
+
+	previous, changed, err := c.storage.SaveDeviceCompliance(ctx, input.DeviceUuid, status, reasons)
+	if err != nil {
+		return err
+	}
+
+	if !changed || previous.Status == status {
+		return nil
+	}
+
+	log.Info(
+		"device compliance changed",
+		slog.String("device", input.DeviceUuid.String()),
+		slog.String("status", status.String()),
+		slog.Int("reasons", len(reasons)),
+	)
+
+	c.events.Notify(ctx, models.NewEvent(models.EventComplianceChanged, input.DeviceUuid, models.ComplianceChangedData{
+		Status:         status,
+		PreviousStatus: previous.Status,
+		Reasons:        reasons,
+	}))
+
+	return nil
+}
@@ -0,0 +1,68 @@
+package compliancesrv
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/dvaxert/mdm/internal/domain/models"
+	"github.com/google/uuid"
+)
+
+type fakeStorage struct {
+	StorageProvider
+	inputs []models.ComplianceInput
+	failed map[uuid.UUID]error
+	saved  []uuid.UUID
+}
+
+func (s *fakeStorage) ComplianceRules(ctx context.Context) ([]models.ComplianceRule, error) {
+	return nil, nil
+}
+
+func (s *fakeStorage) ComplianceInputs(ctx context.Context) ([]models.ComplianceInput, error) {
+	return s.inputs, nil
+}
+
+func (s *fakeStorage) SaveDeviceCompliance(
+	ctx context.Context,
+	device_uuid uuid.UUID,
+	status models.ComplianceStatus,
+	reasons []models.ComplianceReason,
+) (models.DeviceCompliance, bool, error) {
+	if err := s.failed[device_uuid]; err != nil {
+		return models.DeviceCompliance{}, false, err
+	}
+
+	s.saved = append(s.saved, device_uuid)
+
+	return models.DeviceCompliance{}, false, nil
+}
+
+type discardEvents struct{}
+
+func (discardEvents) Notify(ctx context.Context, event models.Event) {}
+
+func TestSweepContinuesAfterFailure(t *testing.T) {
+	devices := []uuid.UUID{uuid.New(), uuid.New(), uuid.New(), uuid.New()}
+	errFirst, errThird := errors.New("first failed"), errors.New("third failed")
+
+	s := &fakeStorage{failed: map[uuid.UUID]error{devices[0]: errFirst, devices[2]: errThird}}
+	for _, d := range devices {
+		s.inputs = append(s.inputs, models.ComplianceInput{DeviceUuid: d})
+	}
+
+	c := New(slog.New(slog.NewTextHandler(io.Discard, nil)), s, discardEvents{}, time.Hour)
+
+	err := c.Sweep(context.Background())
+	if !errors.Is(err, errFirst) || !errors.Is(err, errThird) {
+		t.Errorf("Sweep() error = %v, want both device errors", err)
+	}
+
+	if len(s.saved) != 2 || s.saved[0] != devices[1] || s.saved[1] != devices[3] {
+		t.Errorf("Sweep() evaluated %v, want %v", s.saved, []uuid.UUID{devices[1], devices[3]})
+	}
+}
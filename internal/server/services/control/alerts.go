@@ -0,0 +1,150 @@
+package controlsrv
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"github.com/dvaxert/mdm/internal/domain/models"
+	"github.com/dvaxert/mdm/internal/server/storage"
+)
+
+// CreateAlertRule validates the rule and stores it. An invalid rule is
+// reported with an error wrapping models.ErrInvalidAlertRule.
+func (c *Control) CreateAlertRule(ctx context.Context, rule models.AlertRule) error {
+	const op = "Control.CreateAlertRule"
+
+	log := c.log.With(
+		slog.String("op", op),
+		slog.String("rule", rule.Name),
+	)
+
+	log.Info("attempting to create alert rule")
+
+	rule.FromConfig = false
+	if err := rule.Validate(); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if rule.Feature != "" {
+		if _, err := c.storage.Feature(ctx, rule.Feature); err != nil {
+			if errors.Is(err, storage.ErrFeatureNotFound) {
+				return fmt.Errorf("%s: %w", op, ErrFeatureNotFound)
+			}
+
+			return fmt.Errorf("%s: %w", op, err)
+		}
+	}
+
+	if err := c.storage.CreateAlertRule(ctx, rule); err != nil {
+		return fmt.Errorf("%s: %w", op, alertError(err))
+	}
+
+	log.Info("alert rule created successfully")
+
+	return nil
+}
+
+// DeleteAlertRule removes the rule and its alerts. Rules from the server
+// config can not be deleted.
+func (c *Control) DeleteAlertRule(ctx context.Context, name string) error {
+	const op = "Control.DeleteAlertRule"
+
+	log := c.log.With(
+		slog.String("op", op),
+		slog.String("rule", name),
+	)
+
+	log.Info("attempting to delete alert rule")
+
+	rule, err := c.storage.AlertRule(ctx, name)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, alertError(err))
+	}
+
+	if rule.FromConfig {
+		return fmt.Errorf("%s: %w", op, ErrAlertRuleReadOnly)
+	}
+
+	if err = c.storage.DeleteAlertRule(ctx, name); err != nil {
+		return fmt.Errorf("%s: %w", op, alertError(err))
+	}
+
+	log.Info("alert rule deleted successfully")
+
+	return nil
+}
+
+func (c *Control) AlertRuleList(ctx context.Context) ([]models.AlertRule, error) {
+	const op = "Control.AlertRuleList"
+
+	log := c.log.With(
+		slog.String("op", op),
+	)
+
+	log.Info("attempting to prepare alert rule list")
+
+	list, err := c.storage.AlertRules(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	log.Info("alert rule list prepared successfully")
+
+	return list, nil
+}
+
+func (c *Control) AlertList(ctx context.Context, filter models.AlertFilter) ([]models.Alert, error) {
+	const op = "Control.AlertList"
+
+	log := c.log.With(
+		slog.String("op", op),
+		slog.Bool("firing_only", filter.FiringOnly),
+	)
+
+	log.Info("attempting to prepare alert list")
+
+	list, err := c.storage.AlertList(ctx, filter)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	log.Info("alert list prepared successfully", slog.Int("alerts", len(list)))
+
+	return list, nil
+}
+
+// AcknowledgeAlert marks the alert as seen by an operator, the alert keeps
+// firing until its condition is gone.
+func (c *Control) AcknowledgeAlert(ctx context.Context, id int64) error {
+	const op = "Control.AcknowledgeAlert"
+
+	log := c.log.With(
+		slog.String("op", op),
+		slog.Int64("alert", id),
+	)
+
+	log.Info("attempting to acknowledge alert")
+
+	if err := c.storage.AcknowledgeAlert(ctx, id); err != nil {
+		return fmt.Errorf("%s: %w", op, alertError(err))
+	}
+
+	log.Info("alert acknowledged successfully")
+
+	return nil
+}
+
+func alertError(err error) error {
+	switch {
+	case errors.Is(err, storage.ErrAlertRuleNotFound):
+		return ErrAlertRuleNotFound
+	case errors.Is(err, storage.ErrAlertRuleExists):
+		return ErrAlertRuleExists
+	case errors.Is(err, storage.ErrAlertNotFound):
+		return ErrAlertNotFound
+	}
+
+	return err
+}
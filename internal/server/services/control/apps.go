@@ -0,0 +1,201 @@
+package controlsrv
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"github.com/dvaxert/mdm/internal/domain/models"
+	"github.com/dvaxert/mdm/internal/server/storage"
+	"github.com/google/uuid"
+)
+
+// CreateApp adds the app to the catalog, an invalid app is reported with an
+// error wrapping models.ErrInvalidApp.
+func (c *Control) CreateApp(ctx context.Context, app models.CatalogApp) error {
+	const op = "Control.CreateApp"
+
+	log := c.log.With(
+		slog.String("op", op),
+		slog.String("app", app.Id),
+	)
+
+	log.Info("attempting to create app")
+
+	if err := models.ValidateCatalogApp(app); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := c.storage.CreateApp(ctx, app); err != nil {
+		return fmt.Errorf("%s: %w", op, appError(err))
+	}
+
+	log.Info("app created successfully")
+
+	return nil
+}
+
+// DeleteApp removes the app from the catalog together with its rules, the
+// devices whose app policy changed are marked as changed.
+func (c *Control) DeleteApp(ctx context.Context, app_id string) error {
+	const op = "Control.DeleteApp"
+
+	log := c.log.With(
+		slog.String("op", op),
+		slog.String("app", app_id),
+	)
+
+	log.Info("attempting to delete app")
+
+	devices, err := c.storage.DeleteApp(ctx, app_id)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, appError(err))
+	}
+
+	if err = c.management.DevicesChanged(ctx, devices); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	log.Info("app deleted successfully", slog.Int("devices", len(devices)))
+
+	return nil
+}
+
+func (c *Control) AppList(ctx context.Context) ([]models.CatalogApp, error) {
+	const op = "Control.AppList"
+
+	log := c.log.With(
+		slog.String("op", op),
+	)
+
+	log.Info("attempting to prepare app list")
+
+	list, err := c.storage.AppList(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	log.Info("app list prepared successfully", slog.Int("apps", len(list)))
+
+	return list, nil
+}
+
+// SetAppRule sets the rule for the app on the profile or on the group of the
+// target, the devices whose app policy changed are marked as changed.
+func (c *Control) SetAppRule(ctx context.Context, app_id string, target models.AppRuleTarget) error {
+	const op = "Control.SetAppRule"
+
+	log := c.log.With(
+		slog.String("op", op),
+		slog.String("app", app_id),
+		slog.String("rule", target.Rule.String()),
+		slog.String("profile", target.Profile),
+		slog.String("group", target.Group),
+	)
+
+	log.Info("attempting to set app rule")
+
+	devices, err := c.storage.SetAppRule(ctx, app_id, target)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, appError(err))
+	}
+
+	if err = c.management.DevicesChanged(ctx, devices); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	log.Info("app rule set successfully", slog.Int("devices", len(devices)))
+
+	return nil
+}
+
+// ClearAppRule removes the rule for the app from the profile or the group of
+// the target, the devices whose app policy changed are marked as changed.
+func (c *Control) ClearAppRule(ctx context.Context, app_id string, target models.AppRuleTarget) error {
+	const op = "Control.ClearAppRule"
+
+	log := c.log.With(
+		slog.String("op", op),
+		slog.String("app", app_id),
+		slog.String("profile", target.Profile),
+		slog.String("group", target.Group),
+	)
+
+	log.Info("attempting to clear app rule")
+
+	devices, err := c.storage.ClearAppRule(ctx, app_id, target)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, appError(err))
+	}
+
+	if err = c.management.DevicesChanged(ctx, devices); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	log.Info("app rule cleared successfully", slog.Int("devices", len(devices)))
+
+	return nil
+}
+
+// DeviceAppCompliance checks the app policy of the device against the apps
+// it reported in its latest inventory.
+func (c *Control) DeviceAppCompliance(ctx context.Context, device_uuid uuid.UUID) (models.AppCompliance, error) {
+	const op = "Control.DeviceAppCompliance"
+
+	log := c.log.With(
+		slog.String("op", op),
+		slog.String("uuid", device_uuid.String()),
+	)
+
+	log.Info("attempting to prepare device app compliance")
+
+	compliance, err := c.storage.DeviceAppCompliance(ctx, device_uuid)
+	if err != nil {
+		return models.AppCompliance{}, fmt.Errorf("%s: %w", op, appError(err))
+	}
+
+	log.Info("device app compliance prepared successfully", slog.Int("violations", len(compliance.Violations)))
+
+	return compliance, nil
+}
+
+// AppViolationList returns a page of the devices matching the filter whose
+// installed apps break their app policy.
+func (c *Control) AppViolationList(
+	ctx context.Context,
+	filter models.DeviceFilter,
+	page models.Page,
+) ([]models.DeviceAppViolations, string, error) {
+	const op = "Control.AppViolationList"
+
+	log := c.log.With(
+		slog.String("op", op),
+	)
+
+	log.Info("attempting to prepare app violation list")
+
+	list, next, err := c.storage.AppViolationList(ctx, filter, page)
+	if err != nil {
+		return nil, "", fmt.Errorf("%s: %w", op, pageError(err))
+	}
+
+	log.Info("app violation list prepared successfully", slog.Int("count", len(list)))
+
+	return list, next, nil
+}
+
+func appError(err error) error {
+	switch {
+	case errors.Is(err, storage.ErrAppNotFound):
+		return ErrAppNotFound
+	case errors.Is(err, storage.ErrAppExists):
+		return ErrAppExists
+	case errors.Is(err, storage.ErrAppRuleNotFound):
+		return ErrAppRuleNotFound
+	case errors.Is(err, storage.ErrDeviceNotFound):
+		return ErrDeviceNotFound
+	}
+
+	return profileError(err)
+}
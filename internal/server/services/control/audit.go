@@ -0,0 +1,81 @@
+package controlsrv
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strings"
+
+	"github.com/dvaxert/mdm/internal/domain/models"
+)
+
+// AuditLog returns the audit records matching the filter, newest first.
+func (c *Control) AuditLog(ctx context.Context, filter models.AuditFilter) ([]models.AuditRecord, error) {
+	const op = "Control.AuditLog"
+
+	log := c.log.With(
+		slog.String("op", op),
+	)
+
+	log.Info("attempting to prepare audit log")
+
+	list, err := c.storage.AuditList(ctx, filter)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	log.Info("audit log prepared successfully", slog.Int("records", len(list)))
+
+	return list, nil
+}
+
+func (c *Control) VerifyAuditLog(ctx context.Context) (models.AuditVerification, error) {
+	const op = "Control.VerifyAuditLog"
+
+	result, err := c.audit.Verify(ctx)
+	if err != nil {
+		return models.AuditVerification{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return result, nil
+}
+
+// featureValue returns the value of the feature for the audit log, empty if
+// it is not set.
+func featureValue(features map[string]models.FeatureValue, name string) string {
+	value, ok := features[name]
+	if !ok {
+		return ""
+	}
+
+	return value.String()
+}
+
+// formatLabels returns the labels for the audit log as a selector.
+func formatLabels(labels map[string]string) string {
+	pairs := make([]string, 0, len(labels))
+	for key, value := range labels {
+		pairs = append(pairs, key+"="+value)
+	}
+	slices.Sort(pairs)
+
+	return strings.Join(pairs, ",")
+}
+
+// formatChanges returns the previous values of the changed devices for the
+// audit log as device=value pairs, the value is empty if it was not set.
+func formatChanges(changes []models.FeatureChange) string {
+	pairs := make([]string, 0, len(changes))
+	for _, c := range changes {
+		previous := ""
+		if c.Previous != nil {
+			previous = c.Previous.String()
+		}
+
+		pairs = append(pairs, c.DeviceUuid.String()+"="+previous)
+	}
+	slices.Sort(pairs)
+
+	return strings.Join(pairs, ",")
+}
@@ -0,0 +1,45 @@
+package controlsrv
+
+import (
+	"testing"
+
+	"github.com/dvaxert/mdm/internal/domain/models"
+	"github.com/google/uuid"
+)
+
+func TestFormatChanges(t *testing.T) {
+	a := uuid.MustParse("11111111-1111-1111-1111-111111111111")
+	b := uuid.MustParse("22222222-2222-2222-2222-222222222222")
+	enabled := models.BoolValue(true)
+
+	tests := []struct {
+		name    string
+		changes []models.FeatureChange
+		want    string
+	}{
+		{name: "no changes", want: ""},
+		{
+			name:    "previous value",
+			changes: []models.FeatureChange{{DeviceUuid: a, Previous: &enabled, Overridden: true}},
+			want:    a.String() + "=true",
+		},
+		{
+			name:    "no previous value",
+			changes: []models.FeatureChange{{DeviceUuid: a}},
+			want:    a.String() + "=",
+		},
+		{
+			name:    "sorted by device",
+			changes: []models.FeatureChange{{DeviceUuid: b}, {DeviceUuid: a, Previous: &enabled}},
+			want:    a.String() + "=true," + b.String() + "=",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := formatChanges(tt.changes); got != tt.want {
+				t.Errorf("formatChanges() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
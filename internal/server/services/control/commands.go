@@ -0,0 +1,97 @@
+package controlsrv
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/dvaxert/mdm/internal/domain/models"
+	"github.com/dvaxert/mdm/internal/server/storage"
+)
+
+// CreateCommand queues the command for its device and returns the command
+// id. The command expires if the device does not complete it within ttl,
+// zero ttl means the default one. An invalid command is reported with an
+// error wrapping models.ErrInvalidCommand.
+func (c *Control) CreateCommand(ctx context.Context, command models.Command, ttl time.Duration) (int64, error) {
+	const op = "Control.CreateCommand"
+
+	log := c.log.With(
+		slog.String("op", op),
+		slog.String("uuid", command.DeviceUuid.String()),
+		slog.String("type", command.Type.String()),
+	)
+
+	log.Info("attempting to queue command")
+
+	if err := command.Validate(); err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if ttl == 0 {
+		ttl = c.commandTTL
+	}
+	command.ExpiresAt = time.Now().Add(ttl)
+
+	id, err := c.storage.CreateCommand(ctx, command)
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", op, commandError(err))
+	}
+
+	log.Info("command queued successfully", slog.Int64("command", id))
+
+	return id, nil
+}
+
+func (c *Control) Command(ctx context.Context, id int64) (models.Command, error) {
+	const op = "Control.Command"
+
+	log := c.log.With(
+		slog.String("op", op),
+		slog.Int64("command", id),
+	)
+
+	log.Info("attempting to prepare command info")
+
+	command, err := c.storage.Command(ctx, id)
+	if err != nil {
+		return models.Command{}, fmt.Errorf("%s: %w", op, commandError(err))
+	}
+
+	log.Info("command info prepared successfully")
+
+	return command, nil
+}
+
+func (c *Control) CommandList(ctx context.Context, filter models.CommandFilter) ([]models.Command, error) {
+	const op = "Control.CommandList"
+
+	log := c.log.With(
+		slog.String("op", op),
+		slog.Bool("pending_only", filter.PendingOnly),
+	)
+
+	log.Info("attempting to prepare command list")
+
+	list, err := c.storage.CommandList(ctx, filter)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	log.Info("command list prepared successfully", slog.Int("commands", len(list)))
+
+	return list, nil
+}
+
+func commandError(err error) error {
+	switch {
+	case errors.Is(err, storage.ErrCommandNotFound):
+		return ErrCommandNotFound
+	case errors.Is(err, storage.ErrDeviceNotFound):
+		return ErrDeviceNotFound
+	}
+
+	return err
+}
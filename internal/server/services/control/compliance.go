@@ -0,0 +1,161 @@
+package controlsrv
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"github.com/dvaxert/mdm/internal/domain/models"
+	"github.com/dvaxert/mdm/internal/server/storage"
+	"github.com/google/uuid"
+)
+
+// CreateComplianceRule validates the rule, stores it and evaluates all
+// devices against it. An invalid rule is reported with an error wrapping
+// models.ErrInvalidComplianceRule.
+func (c *Control) CreateComplianceRule(ctx context.Context, rule models.ComplianceRule) error {
+	const op = "Control.CreateComplianceRule"
+
+	log := c.log.With(
+		slog.String("op", op),
+		slog.String("rule", rule.Name),
+	)
+
+	log.Info("attempting to create compliance rule")
+
+	if err := rule.Validate(); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if rule.Feature != "" {
+		if _, err := c.storage.Feature(ctx, rule.Feature); err != nil {
+			if errors.Is(err, storage.ErrFeatureNotFound) {
+				return fmt.Errorf("%s: %w", op, ErrFeatureNotFound)
+			}
+
+			return fmt.Errorf("%s: %w", op, err)
+		}
+	}
+
+	if err := c.storage.CreateComplianceRule(ctx, rule); err != nil {
+		return fmt.Errorf("%s: %w", op, complianceError(err))
+	}
+
+	c.compliance.RulesChanged(ctx)
+
+	log.Info("compliance rule created successfully")
+
+	return nil
+}
+
+// DeleteComplianceRule removes the rule and evaluates all devices without
+// it.
+func (c *Control) DeleteComplianceRule(ctx context.Context, name string) error {
+	const op = "Control.DeleteComplianceRule"
+
+	log := c.log.With(
+		slog.String("op", op),
+		slog.String("rule", name),
+	)
+
+	log.Info("attempting to delete compliance rule")
+
+	if err := c.storage.DeleteComplianceRule(ctx, name); err != nil {
+		return fmt.Errorf("%s: %w", op, complianceError(err))
+	}
+
+	c.compliance.RulesChanged(ctx)
+
+	log.Info("compliance rule deleted successfully")
+
+	return nil
+}
+
+func (c *Control) ComplianceRuleList(ctx context.Context) ([]models.ComplianceRule, error) {
+	const op = "Control.ComplianceRuleList"
+
+	log := c.log.With(
+		slog.String("op", op),
+	)
+
+	log.Info("attempting to prepare compliance rule list")
+
+	rules, err := c.storage.ComplianceRules(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	log.Info("compliance rule list prepared successfully", slog.Int("rules", len(rules)))
+
+	return rules, nil
+}
+
+// DeviceCompliance returns the latest compliance result of the device with
+// up to limit records of its history, newest first.
+func (c *Control) DeviceCompliance(
+	ctx context.Context,
+	device_uuid uuid.UUID,
+	limit int,
+) (models.DeviceCompliance, []models.ComplianceRecord, error) {
+	const op = "Control.DeviceCompliance"
+
+	log := c.log.With(
+		slog.String("op", op),
+		slog.String("uuid", device_uuid.String()),
+	)
+
+	log.Info("attempting to prepare device compliance")
+
+	compliance, err := c.storage.DeviceCompliance(ctx, device_uuid)
+	if err != nil {
+		return models.DeviceCompliance{}, nil, fmt.Errorf("%s: %w", op, complianceError(err))
+	}
+
+	history, err := c.storage.ComplianceHistory(ctx, device_uuid, limit)
+	if err != nil {
+		return models.DeviceCompliance{}, nil, fmt.Errorf("%s: %w", op, complianceError(err))
+	}
+
+	log.Info("device compliance prepared successfully", slog.String("status", compliance.Status.String()))
+
+	return compliance, history, nil
+}
+
+// ComplianceSummary counts the devices matching the filter by compliance
+// status and the devices failing every rule.
+func (c *Control) ComplianceSummary(ctx context.Context, filter models.DeviceFilter) (models.ComplianceSummary, error) {
+	const op = "Control.ComplianceSummary"
+
+	log := c.log.With(
+		slog.String("op", op),
+	)
+
+	log.Info("attempting to prepare compliance summary")
+
+	summary, err := c.storage.ComplianceSummary(ctx, filter)
+	if err != nil {
+		return models.ComplianceSummary{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	log.Info(
+		"compliance summary prepared successfully",
+		slog.Int("total", summary.Total),
+		slog.Int("non_compliant", summary.NonCompliant),
+	)
+
+	return summary, nil
+}
+
+func complianceError(err error) error {
+	switch {
+	case errors.Is(err, storage.ErrComplianceRuleNotFound):
+		return ErrComplianceRuleNotFound
+	case errors.Is(err, storage.ErrComplianceRuleExists):
+		return ErrComplianceRuleExists
+	case errors.Is(err, storage.ErrDeviceNotFound):
+		return ErrDeviceNotFound
+	}
+
+	return err
+}
@@ -2,51 +2,214 @@ package controlsrv
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log/slog"
+	"time"
 
 	"github.com/dvaxert/mdm/internal/domain/models"
+	auditsrv "github.com/dvaxert/mdm/internal/server/services/audit"
+	"github.com/dvaxert/mdm/internal/server/storage"
 	"github.com/google/uuid"
 )
 
+var (
+	ErrDeviceNotFound         = errors.New("device not found")
+	ErrFeatureNotFound        = errors.New("feature not found")
+	ErrFeatureExists          = errors.New("feature already exists")
+	ErrFeatureDeprecated      = errors.New("feature is deprecated")
+	ErrFeatureNotApplicable   = errors.New("feature does not apply to the device type")
+	ErrGroupNotFound          = errors.New("group not found")
+	ErrGroupExists            = errors.New("group already exists")
+	ErrGroupHasSubgroups      = errors.New("group has subgroups")
+	ErrProfileNotFound        = errors.New("profile not found")
+	ErrProfileExists          = errors.New("profile already exists")
+	ErrInvalidPageToken       = errors.New("invalid page token")
+	ErrAlertRuleNotFound      = errors.New("alert rule not found")
+	ErrAlertRuleExists        = errors.New("alert rule already exists")
+	ErrAlertRuleReadOnly      = errors.New("alert rule is defined in the server config")
+	ErrAlertNotFound          = errors.New("alert not found")
+	ErrComplianceRuleNotFound = errors.New("compliance rule not found")
+	ErrComplianceRuleExists   = errors.New("compliance rule already exists")
+	ErrWebhookNotFound        = errors.New("webhook not found")
+	ErrWebhookExists          = errors.New("webhook already exists")
+	ErrWebhookReadOnly        = errors.New("webhook is defined in the server config")
+	ErrCommandNotFound        = errors.New("command not found")
+	ErrCertificateNotFound    = errors.New("certificate not found")
+	ErrLifecycleChange        = errors.New("device cannot move to the lifecycle state")
+	ErrInventoryNotFound      = errors.New("inventory not found")
+	ErrAppNotFound            = errors.New("app not found")
+	ErrAppExists              = errors.New("app already exists")
+	ErrAppRuleNotFound        = errors.New("app rule not found")
+
+	ErrEnrollmentTokenNotFound = errors.New("enrollment token not found")
+	ErrOperatorNotFound        = errors.New("operator not found")
+	ErrOperatorExists          = errors.New("operator already exists")
+	ErrOperatorReadOnly        = errors.New("operator is defined in the server config")
+	ErrRoleNotFound            = errors.New("role not found")
+	ErrRoleExists              = errors.New("role already exists")
+	ErrRoleReadOnly            = errors.New("role is built in")
+	ErrRoleInUse               = errors.New("role is granted to operators")
+)
+
 type Control struct {
 	log        *slog.Logger
 	storage    StorageProvider
 	management ManagementProvider
+	events     EventProvider
+	audit      AuditProvider
+	compliance ComplianceProvider
+	presence   models.PresencePolicy
+	commandTTL time.Duration
+	tokenTTL   time.Duration
 }
 
 type ManagementProvider interface {
-	SetDeviceFeatureState(ctx context.Context, device_uuid uuid.UUID, feature string, state bool) error
+	SetDeviceFeatureState(ctx context.Context, device_uuid uuid.UUID, feature string, value models.FeatureValue) error
+	DevicesChanged(ctx context.Context, devices []uuid.UUID) error
+	DeviceLifecycleChanged(ctx context.Context, device_uuid uuid.UUID, change models.LifecycleChange)
+}
+
+type EventProvider interface {
+	Subscribe(ctx context.Context, filter models.EventFilter, after int64) (<-chan models.Event, error)
+}
+
+type AuditProvider interface {
+	Verify(ctx context.Context) (models.AuditVerification, error)
+}
+
+// ComplianceProvider evaluates the devices when the compliance rules change.
+type ComplianceProvider interface {
+	RulesChanged(ctx context.Context)
 }
 
 type StorageProvider interface {
-	DeviceList(ctx context.Context) ([]models.Device, error)
+	DeviceList(ctx context.Context, filter models.DeviceFilter, page models.Page) ([]models.Device, string, error)
 	Device(ctx context.Context, device_id uuid.UUID) (models.Device, error)
 	DeviceStatus(ctx context.Context, device_id uuid.UUID) (models.DeviceStatus, error)
 	DeviceFeatures(ctx context.Context, device_id uuid.UUID) (models.DeviceFeatures, error)
-	DeviceStatusList(ctx context.Context) ([]models.DeviceStatus, error)
-	DeviceFeaturesList(ctx context.Context) ([]models.DeviceFeatures, error)
+	DeviceStatusList(ctx context.Context, filter models.DeviceFilter, page models.Page) ([]models.DeviceStatus, string, error)
+	DeviceFeaturesList(ctx context.Context, filter models.DeviceFilter, page models.Page) ([]models.DeviceFeatures, string, error)
+	DeviceDriftList(ctx context.Context, filter models.DeviceFilter, page models.Page) ([]models.DeviceDrift, string, error)
+	UpdateDeviceLabels(ctx context.Context, device_uuid uuid.UUID, set map[string]string, remove []string) error
+	SetDeviceLifecycle(ctx context.Context, device_uuid uuid.UUID, lifecycle models.Lifecycle) (models.LifecycleChange, error)
+	DeviceInventory(ctx context.Context, device_uuid uuid.UUID, version int64) (models.InventorySnapshot, error)
+	BulkUpdateDeviceFeature(
+		ctx context.Context,
+		target models.BulkTarget,
+		feature string,
+		value models.FeatureValue,
+		dryRun bool,
+	) (models.BulkFeatureResult, error)
+	CreateApp(ctx context.Context, app models.CatalogApp) error
+	DeleteApp(ctx context.Context, app_id string) ([]uuid.UUID, error)
+	AppList(ctx context.Context) ([]models.CatalogApp, error)
+	SetAppRule(ctx context.Context, app_id string, target models.AppRuleTarget) ([]uuid.UUID, error)
+	ClearAppRule(ctx context.Context, app_id string, target models.AppRuleTarget) ([]uuid.UUID, error)
+	DeviceAppCompliance(ctx context.Context, device_uuid uuid.UUID) (models.AppCompliance, error)
+	AppViolationList(ctx context.Context, filter models.DeviceFilter, page models.Page) ([]models.DeviceAppViolations, string, error)
+	DeviceStatusHistory(ctx context.Context, device_uuid uuid.UUID, from time.Time, to time.Time) ([]models.StatusPoint, error)
+	CreateFeature(ctx context.Context, feature models.Feature) ([]uuid.UUID, error)
+	Feature(ctx context.Context, name string) (models.Feature, error)
+	FeatureList(ctx context.Context) ([]models.Feature, error)
+	DeprecateFeature(ctx context.Context, name string, deprecated bool) error
+	DeleteFeature(ctx context.Context, name string) ([]uuid.UUID, error)
+	CreateGroup(ctx context.Context, name string, parent string) error
+	DeleteGroup(ctx context.Context, name string) ([]uuid.UUID, error)
+	Group(ctx context.Context, name string) (models.Group, error)
+	GroupList(ctx context.Context) ([]models.Group, error)
+	AddGroupDevice(ctx context.Context, group string, device_uuid uuid.UUID) ([]uuid.UUID, error)
+	RemoveGroupDevice(ctx context.Context, group string, device_uuid uuid.UUID) ([]uuid.UUID, error)
+	SetGroupFeature(ctx context.Context, group string, feature string, value models.FeatureValue) ([]uuid.UUID, error)
+	ClearGroupFeature(ctx context.Context, group string, feature string) ([]uuid.UUID, error)
+	ResetDeviceFeature(ctx context.Context, device_uuid uuid.UUID, feature string) ([]uuid.UUID, error)
+	CreateProfile(ctx context.Context, profile models.Profile) error
+	UpdateProfile(ctx context.Context, profile models.Profile) ([]uuid.UUID, error)
+	DeleteProfile(ctx context.Context, name string) ([]uuid.UUID, error)
+	Profile(ctx context.Context, name string) (models.Profile, error)
+	ProfileList(ctx context.Context) ([]models.Profile, error)
+	AssignDeviceProfile(ctx context.Context, profile string, device_uuid uuid.UUID) ([]uuid.UUID, error)
+	UnassignDeviceProfile(ctx context.Context, profile string, device_uuid uuid.UUID) ([]uuid.UUID, error)
+	AssignGroupProfile(ctx context.Context, profile string, group string) ([]uuid.UUID, error)
+	UnassignGroupProfile(ctx context.Context, profile string, group string) ([]uuid.UUID, error)
+	GroupDevices(ctx context.Context, name string) ([]uuid.UUID, error)
+	CreateAlertRule(ctx context.Context, rule models.AlertRule) error
+	DeleteAlertRule(ctx context.Context, name string) error
+	AlertRule(ctx context.Context, name string) (models.AlertRule, error)
+	AlertRules(ctx context.Context) ([]models.AlertRule, error)
+	AlertList(ctx context.Context, filter models.AlertFilter) ([]models.Alert, error)
+	AcknowledgeAlert(ctx context.Context, id int64) error
+	CreateComplianceRule(ctx context.Context, rule models.ComplianceRule) error
+	DeleteComplianceRule(ctx context.Context, name string) error
+	ComplianceRules(ctx context.Context) ([]models.ComplianceRule, error)
+	DeviceCompliance(ctx context.Context, device_uuid uuid.UUID) (models.DeviceCompliance, error)
+	ComplianceHistory(ctx context.Context, device_uuid uuid.UUID, limit int) ([]models.ComplianceRecord, error)
+	ComplianceSummary(ctx context.Context, filter models.DeviceFilter) (models.ComplianceSummary, error)
+	CreateWebhook(ctx context.Context, webhook models.Webhook) error
+	DeleteWebhook(ctx context.Context, name string) error
+	Webhook(ctx context.Context, name string) (models.Webhook, error)
+	Webhooks(ctx context.Context) ([]models.Webhook, error)
+	CreateCommand(ctx context.Context, command models.Command) (int64, error)
+	Command(ctx context.Context, id int64) (models.Command, error)
+	CommandList(ctx context.Context, filter models.CommandFilter) ([]models.Command, error)
+	CreateEnrollmentToken(ctx context.Context, token models.EnrollmentToken, token_hash string) (int64, error)
+	EnrollmentTokenList(ctx context.Context) ([]models.EnrollmentToken, error)
+	RevokeEnrollmentToken(ctx context.Context, id int64) error
+	CertificateList(ctx context.Context, device_uuid uuid.UUID) ([]models.Certificate, error)
+	RevokeCertificate(ctx context.Context, serial string) error
+	RevokeDeviceCertificates(ctx context.Context, device_uuid uuid.UUID) (int64, error)
+	CreateOperator(ctx context.Context, operator models.Operator) error
+	DeleteOperator(ctx context.Context, name string) error
+	Operator(ctx context.Context, name string) (models.Operator, error)
+	OperatorList(ctx context.Context) ([]models.Operator, error)
+	SetOperatorRoles(ctx context.Context, name string, roles []string) error
+	SetOperatorToken(ctx context.Context, name string, token_hash string) error
+	CreateRole(ctx context.Context, role models.Role) error
+	DeleteRole(ctx context.Context, name string) error
+	Roles(ctx context.Context, names []string) ([]models.Role, error)
+	AuditList(ctx context.Context, filter models.AuditFilter) ([]models.AuditRecord, error)
 }
 
-func New(log *slog.Logger, storage StorageProvider, management ManagementProvider) *Control {
+func New(
+	log *slog.Logger,
+	storage StorageProvider,
+	management ManagementProvider,
+	events EventProvider,
+	audit AuditProvider,
+	compliance ComplianceProvider,
+	presence models.PresencePolicy,
+	commandTTL time.Duration,
+	tokenTTL time.Duration,
+) *Control {
 	return &Control{
 		log:        log,
 		management: management,
+		events:     events,
+		audit:      audit,
+		compliance: compliance,
 		storage:    storage,
+		presence:   presence,
+		commandTTL: commandTTL,
+		tokenTTL:   tokenTTL,
 	}
 }
 
-func (c *Control) DeviceList(ctx context.Context) ([]string, error) {
+// DeviceList returns a page of the list and the token of the next page, the
+// token is empty for the last page.
+func (c *Control) DeviceList(
+	ctx context.Context,
+	filter models.DeviceFilter,
+	page models.Page,
+) ([]string, string, error) {
 	const op = "Control.DeviceList"
 
 	log := c.log.With(slog.String("op", op))
 
 	log.Info("attempting to prepare device list")
 
-	log.With(slog.Any("storage", c.storage)).With(slog.Any("ctx", ctx)).Info("111")
-	devices, err := c.storage.DeviceList(ctx)
+	devices, next, err := c.storage.DeviceList(ctx, filter, page)
 	if err != nil {
-		return nil, fmt.Errorf("%s: %w", op, err)
+		return nil, "", fmt.Errorf("%s: %w", op, pageError(err))
 	}
 
 	result := make([]string, 0, len(devices))
@@ -56,7 +219,7 @@ func (c *Control) DeviceList(ctx context.Context) ([]string, error) {
 
 	log.Info("device list prepared successfully")
 
-	return result, nil
+	return result, next, nil
 }
 
 func (c *Control) DeviceInfo(ctx context.Context, device_id uuid.UUID) (models.Device, error) {
@@ -74,6 +237,12 @@ func (c *Control) DeviceInfo(ctx context.Context, device_id uuid.UUID) (models.D
 		return models.Device{}, fmt.Errorf("%s: %w", op, err)
 	}
 
+	device.Presence = c.presence.Presence(device.LastSeen, time.Now())
+
+	if device.Compliance, err = c.storage.DeviceCompliance(ctx, device_id); err != nil {
+		return models.Device{}, fmt.Errorf("%s: %w", op, err)
+	}
+
 	log.Info("device info prepared successfully")
 
 	return device, nil
@@ -91,9 +260,15 @@ func (c *Control) DeviceStatus(ctx context.Context, device_id uuid.UUID) (models
 
 	status, err := c.storage.DeviceStatus(ctx, device_id)
 	if err != nil {
+		if errors.Is(err, storage.ErrDeviceNotFound) {
+			return models.DeviceStatus{}, fmt.Errorf("%s: %w", op, ErrDeviceNotFound)
+		}
+
 		return models.DeviceStatus{}, fmt.Errorf("%s: %w", op, err)
 	}
 
+	status.Presence = c.presence.Presence(status.LastSeen, time.Now())
+
 	log.Info("device status prepared successfully")
 
 	return status, nil
@@ -119,7 +294,11 @@ func (c *Control) DeviceFeatures(ctx context.Context, device_id uuid.UUID) (mode
 	return status, nil
 }
 
-func (c *Control) DeviceInfoList(ctx context.Context) ([]models.Device, error) {
+func (c *Control) DeviceInfoList(
+	ctx context.Context,
+	filter models.DeviceFilter,
+	page models.Page,
+) ([]models.Device, string, error) {
 	const op = "Control.DeviceInfoList"
 
 	log := c.log.With(
@@ -128,17 +307,26 @@ func (c *Control) DeviceInfoList(ctx context.Context) ([]models.Device, error) {
 
 	log.Info("attempting to prepare device info list")
 
-	list, err := c.storage.DeviceList(ctx)
+	list, next, err := c.storage.DeviceList(ctx, filter, page)
 	if err != nil {
-		return nil, fmt.Errorf("%s: %w", op, err)
+		return nil, "", fmt.Errorf("%s: %w", op, pageError(err))
+	}
+
+	now := time.Now()
+	for i := range list {
+		list[i].Presence = c.presence.Presence(list[i].LastSeen, now)
 	}
 
 	log.Info("device info list prepared successfully")
 
-	return list, nil
+	return list, next, nil
 }
 
-func (c *Control) DeviceStatusList(ctx context.Context) ([]models.DeviceStatus, error) {
+func (c *Control) DeviceStatusList(
+	ctx context.Context,
+	filter models.DeviceFilter,
+	page models.Page,
+) ([]models.DeviceStatus, string, error) {
 	const op = "Control.DeviceStatusList"
 
 	log := c.log.With(
@@ -147,17 +335,26 @@ func (c *Control) DeviceStatusList(ctx context.Context) ([]models.DeviceStatus,
 
 	log.Info("attempting to prepare device status list")
 
-	list, err := c.storage.DeviceStatusList(ctx)
+	list, next, err := c.storage.DeviceStatusList(ctx, filter, page)
 	if err != nil {
-		return nil, fmt.Errorf("%s: %w", op, err)
+		return nil, "", fmt.Errorf("%s: %w", op, pageError(err))
+	}
+
+	now := time.Now()
+	for i := range list {
+		list[i].Presence = c.presence.Presence(list[i].LastSeen, now)
 	}
 
 	log.Info("device status list prepared successfully")
 
-	return list, nil
+	return list, next, nil
 }
 
-func (c *Control) DeviceFeaturesList(ctx context.Context) ([]models.DeviceFeatures, error) {
+func (c *Control) DeviceFeaturesList(
+	ctx context.Context,
+	filter models.DeviceFilter,
+	page models.Page,
+) ([]models.DeviceFeatures, string, error) {
 	const op = "Control.DeviceFeaturesList"
 
 	log := c.log.With(
@@ -166,33 +363,196 @@ func (c *Control) DeviceFeaturesList(ctx context.Context) ([]models.DeviceFeatur
 
 	log.Info("attempting to prepare device features list")
 
-	list, err := c.storage.DeviceFeaturesList(ctx)
+	list, next, err := c.storage.DeviceFeaturesList(ctx, filter, page)
 	if err != nil {
-		return nil, fmt.Errorf("%s: %w", op, err)
+		return nil, "", fmt.Errorf("%s: %w", op, pageError(err))
 	}
 
 	log.Info("device features list prepared successfully")
 
-	return list, nil
+	return list, next, nil
 }
 
-func (c *Control) SetDeviceFeatureState(ctx context.Context, device_uuid uuid.UUID, feature string, state bool) error {
+// SetDeviceFeatureState validates the value against the feature catalog and
+// sets it on the device. An invalid value is reported with an error wrapping
+// models.ErrInvalidFeatureValue.
+func (c *Control) SetDeviceFeatureState(
+	ctx context.Context,
+	device_uuid uuid.UUID,
+	feature string,
+	value models.FeatureValue,
+) error {
 	const op = "Control.SetDeviceFeatureState"
 
 	log := c.log.With(
 		slog.String("op", op),
 		slog.String("uuid", device_uuid.String()),
 		slog.String("feature", feature),
-		slog.Bool("state", state),
+		slog.String("value", value.String()),
 	)
 
 	log.Info("attempting to set device feature state")
 
-	if err := c.management.SetDeviceFeatureState(ctx, device_uuid, feature, state); err != nil {
+	device, err := c.storage.Device(ctx, device_uuid)
+	if err != nil {
+		if errors.Is(err, storage.ErrDeviceNotFound) {
+			return fmt.Errorf("%s: %w", op, ErrDeviceNotFound)
+		}
+
 		return fmt.Errorf("%s: %w", op, err)
 	}
 
+	f, err := c.storage.Feature(ctx, feature)
+	if err != nil {
+		if errors.Is(err, storage.ErrFeatureNotFound) {
+			return fmt.Errorf("%s: %w", op, ErrFeatureNotFound)
+		}
+
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if f.Deprecated {
+		return fmt.Errorf("%s: %w", op, ErrFeatureDeprecated)
+	}
+
+	if !f.AppliesTo(device.Type) {
+		return fmt.Errorf("%s: %w", op, ErrFeatureNotApplicable)
+	}
+
+	if err = f.Validate(value); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	previous, err := c.storage.DeviceFeatures(ctx, device_uuid)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err = c.management.SetDeviceFeatureState(ctx, device_uuid, feature, value); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	auditsrv.SetChange(ctx, featureValue(previous.Features, feature), value.String())
+
 	log.Info("device feature state changed successfully")
 
 	return nil
 }
+
+func (c *Control) DeviceDriftList(
+	ctx context.Context,
+	filter models.DeviceFilter,
+	page models.Page,
+) ([]models.DeviceDrift, string, error) {
+	const op = "Control.DeviceDriftList"
+
+	log := c.log.With(
+		slog.String("op", op),
+	)
+
+	log.Info("attempting to prepare device drift list")
+
+	list, next, err := c.storage.DeviceDriftList(ctx, filter, page)
+	if err != nil {
+		return nil, "", fmt.Errorf("%s: %w", op, pageError(err))
+	}
+
+	log.Info("device drift list prepared successfully", slog.Int("count", len(list)))
+
+	return list, next, nil
+}
+
+// UpdateDeviceLabels removes and then sets labels of the device. An invalid
+// label is reported with an error wrapping models.ErrInvalidLabel.
+func (c *Control) UpdateDeviceLabels(
+	ctx context.Context,
+	device_uuid uuid.UUID,
+	set map[string]string,
+	remove []string,
+) error {
+	const op = "Control.UpdateDeviceLabels"
+
+	log := c.log.With(
+		slog.String("op", op),
+		slog.String("uuid", device_uuid.String()),
+		slog.Any("set", set),
+		slog.Any("remove", remove),
+	)
+
+	log.Info("attempting to update device labels")
+
+	for key, value := range set {
+		if err := models.ValidateLabel(key, value); err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+	}
+
+	previous, err := c.storage.Device(ctx, device_uuid)
+	if err != nil {
+		if errors.Is(err, storage.ErrDeviceNotFound) {
+			return fmt.Errorf("%s: %w", op, ErrDeviceNotFound)
+		}
+
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err = c.storage.UpdateDeviceLabels(ctx, device_uuid, set, remove); err != nil {
+		if errors.Is(err, storage.ErrDeviceNotFound) {
+			return fmt.Errorf("%s: %w", op, ErrDeviceNotFound)
+		}
+
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	current, err := c.storage.Device(ctx, device_uuid)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	auditsrv.SetChange(ctx, formatLabels(previous.Labels), formatLabels(current.Labels))
+
+	log.Info("device labels updated successfully")
+
+	return nil
+}
+
+func pageError(err error) error {
+	if errors.Is(err, storage.ErrInvalidPageToken) {
+		return ErrInvalidPageToken
+	}
+
+	return err
+}
+
+// DeviceStatusHistory returns the statuses reported by the device in the
+// time range, oldest first.
+func (c *Control) DeviceStatusHistory(
+	ctx context.Context,
+	device_uuid uuid.UUID,
+	from time.Time,
+	to time.Time,
+) ([]models.StatusPoint, error) {
+	const op = "Control.DeviceStatusHistory"
+
+	log := c.log.With(
+		slog.String("op", op),
+		slog.String("uuid", device_uuid.String()),
+		slog.Time("from", from),
+		slog.Time("to", to),
+	)
+
+	log.Info("attempting to prepare device status history")
+
+	points, err := c.storage.DeviceStatusHistory(ctx, device_uuid, from, to)
+	if err != nil {
+		if errors.Is(err, storage.ErrDeviceNotFound) {
+			return nil, fmt.Errorf("%s: %w", op, ErrDeviceNotFound)
+		}
+
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	log.Info("device status history prepared successfully", slog.Int("count", len(points)))
+
+	return points, nil
+}
@@ -0,0 +1,189 @@
+package controlsrv
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/dvaxert/mdm/internal/domain/models"
+	"github.com/dvaxert/mdm/internal/server/storage"
+	"github.com/google/uuid"
+)
+
+// CreateEnrollmentToken mints an enrollment token with the scope and the
+// usage limit of token. The token expires after ttl, zero ttl means the
+// default one. Only the hash of the token is stored, so the token is
+// returned only here, together with the stored token.
+func (c *Control) CreateEnrollmentToken(
+	ctx context.Context,
+	token models.EnrollmentToken,
+	ttl time.Duration,
+) (string, models.EnrollmentToken, error) {
+	const op = "Control.CreateEnrollmentToken"
+
+	log := c.log.With(
+		slog.String("op", op),
+		slog.Int("max_uses", token.MaxUses),
+		slog.String("group", token.Group),
+		slog.String("profile", token.Profile),
+	)
+
+	log.Info("attempting to create enrollment token")
+
+	if err := token.Validate(); err != nil {
+		return "", models.EnrollmentToken{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if ttl == 0 {
+		ttl = c.tokenTTL
+	}
+	token.CreatedAt = time.Now()
+	token.ExpiresAt = token.CreatedAt.Add(ttl)
+
+	secret, err := models.NewToken()
+	if err != nil {
+		return "", models.EnrollmentToken{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	token.Id, err = c.storage.CreateEnrollmentToken(ctx, token, models.TokenHash(secret))
+	if err != nil {
+		return "", models.EnrollmentToken{}, fmt.Errorf("%s: %w", op, enrollmentError(err))
+	}
+
+	log.Info("enrollment token created successfully", slog.Int64("id", token.Id), slog.Time("expires_at", token.ExpiresAt))
+
+	return secret, token, nil
+}
+
+// EnrollmentTokenList returns all enrollment tokens, including the used up,
+// expired and revoked ones.
+func (c *Control) EnrollmentTokenList(ctx context.Context) ([]models.EnrollmentToken, error) {
+	const op = "Control.EnrollmentTokenList"
+
+	log := c.log.With(
+		slog.String("op", op),
+	)
+
+	log.Info("attempting to prepare enrollment token list")
+
+	list, err := c.storage.EnrollmentTokenList(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	log.Info("enrollment token list prepared successfully", slog.Int("tokens", len(list)))
+
+	return list, nil
+}
+
+// RevokeEnrollmentToken revokes the token, devices can no longer register
+// with it. Devices that have already registered are not affected.
+func (c *Control) RevokeEnrollmentToken(ctx context.Context, id int64) error {
+	const op = "Control.RevokeEnrollmentToken"
+
+	log := c.log.With(
+		slog.String("op", op),
+		slog.Int64("id", id),
+	)
+
+	log.Info("attempting to revoke enrollment token")
+
+	if err := c.storage.RevokeEnrollmentToken(ctx, id); err != nil {
+		return fmt.Errorf("%s: %w", op, enrollmentError(err))
+	}
+
+	log.Info("enrollment token revoked successfully")
+
+	return nil
+}
+
+// CertificateList returns the certificates issued to the device, or to all
+// devices if device_uuid is uuid.Nil.
+func (c *Control) CertificateList(ctx context.Context, device_uuid uuid.UUID) ([]models.Certificate, error) {
+	const op = "Control.CertificateList"
+
+	log := c.log.With(
+		slog.String("op", op),
+		slog.String("uuid", device_uuid.String()),
+	)
+
+	log.Info("attempting to prepare certificate list")
+
+	list, err := c.storage.CertificateList(ctx, device_uuid)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	log.Info("certificate list prepared successfully", slog.Int("certificates", len(list)))
+
+	return list, nil
+}
+
+// RevokeCertificate revokes the certificate, the device can no longer
+// authenticate with it.
+func (c *Control) RevokeCertificate(ctx context.Context, serial string) error {
+	const op = "Control.RevokeCertificate"
+
+	log := c.log.With(
+		slog.String("op", op),
+		slog.String("serial", serial),
+	)
+
+	log.Info("attempting to revoke certificate")
+
+	if err := c.storage.RevokeCertificate(ctx, serial); err != nil {
+		return fmt.Errorf("%s: %w", op, certificateError(err))
+	}
+
+	log.Info("certificate revoked successfully")
+
+	return nil
+}
+
+// RevokeDeviceCertificates revokes all certificates of the device and
+// returns the number of revoked certificates.
+func (c *Control) RevokeDeviceCertificates(ctx context.Context, device_uuid uuid.UUID) (int, error) {
+	const op = "Control.RevokeDeviceCertificates"
+
+	log := c.log.With(
+		slog.String("op", op),
+		slog.String("uuid", device_uuid.String()),
+	)
+
+	log.Info("attempting to revoke device certificates")
+
+	revoked, err := c.storage.RevokeDeviceCertificates(ctx, device_uuid)
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", op, certificateError(err))
+	}
+
+	log.Info("device certificates revoked successfully", slog.Int64("revoked", revoked))
+
+	return int(revoked), nil
+}
+
+func certificateError(err error) error {
+	switch {
+	case errors.Is(err, storage.ErrCertificateNotFound):
+		return ErrCertificateNotFound
+	case errors.Is(err, storage.ErrDeviceNotFound):
+		return ErrDeviceNotFound
+	}
+
+	return err
+}
+
+func enrollmentError(err error) error {
+	switch {
+	case errors.Is(err, storage.ErrEnrollmentTokenNotFound):
+		return ErrEnrollmentTokenNotFound
+	case errors.Is(err, storage.ErrGroupNotFound):
+		return ErrGroupNotFound
+	case errors.Is(err, storage.ErrProfileNotFound):
+		return ErrProfileNotFound
+	}
+
+	return err
+}
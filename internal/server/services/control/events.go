@@ -0,0 +1,50 @@
+package controlsrv
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/dvaxert/mdm/internal/domain/models"
+	"github.com/google/uuid"
+)
+
+// WatchEvents streams the device events matching the filter until ctx is
+// done, see eventsrv.Events.Subscribe. If the group is set, the events of
+// its devices and of the devices of its subgroups are streamed in addition
+// to the devices of the filter; the membership is resolved once, when the
+// stream starts.
+func (c *Control) WatchEvents(
+	ctx context.Context,
+	filter models.EventFilter,
+	group string,
+	after int64,
+) (<-chan models.Event, error) {
+	const op = "Control.WatchEvents"
+
+	log := c.log.With(
+		slog.String("op", op),
+		slog.String("group", group),
+		slog.Int64("after", after),
+	)
+
+	log.Info("attempting to subscribe to events")
+
+	if group != "" {
+		devices, err := c.storage.GroupDevices(ctx, group)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", op, groupError(err))
+		}
+
+		filter.Devices = append(append([]uuid.UUID{}, filter.Devices...), devices...)
+	}
+
+	events, err := c.events.Subscribe(ctx, filter, after)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	log.Info("subscribed to events successfully")
+
+	return events, nil
+}
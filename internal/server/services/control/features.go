@@ -0,0 +1,212 @@
+package controlsrv
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"github.com/dvaxert/mdm/internal/domain/models"
+	auditsrv "github.com/dvaxert/mdm/internal/server/services/audit"
+	"github.com/dvaxert/mdm/internal/server/storage"
+)
+
+// CreateFeature adds the feature to the catalog. Existing devices it applies
+// to receive its default value. An inconsistent definition is reported with
+// an error wrapping models.ErrInvalidFeatureValue.
+func (c *Control) CreateFeature(ctx context.Context, feature models.Feature) error {
+	const op = "Control.CreateFeature"
+
+	log := c.log.With(
+		slog.String("op", op),
+		slog.String("feature", feature.Name),
+		slog.String("type", feature.Type.String()),
+	)
+
+	log.Info("attempting to create feature")
+
+	if err := feature.ValidateDefinition(); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	devices, err := c.storage.CreateFeature(ctx, feature)
+	if err != nil {
+		if errors.Is(err, storage.ErrFeatureExists) {
+			return fmt.Errorf("%s: %w", op, ErrFeatureExists)
+		}
+
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err = c.management.DevicesChanged(ctx, devices); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	log.Info("feature created successfully", slog.Int("devices", len(devices)))
+
+	return nil
+}
+
+func (c *Control) Feature(ctx context.Context, name string) (models.Feature, error) {
+	const op = "Control.Feature"
+
+	log := c.log.With(
+		slog.String("op", op),
+		slog.String("feature", name),
+	)
+
+	log.Info("attempting to prepare feature description")
+
+	feature, err := c.storage.Feature(ctx, name)
+	if err != nil {
+		if errors.Is(err, storage.ErrFeatureNotFound) {
+			return models.Feature{}, fmt.Errorf("%s: %w", op, ErrFeatureNotFound)
+		}
+
+		return models.Feature{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	log.Info("feature description prepared successfully")
+
+	return feature, nil
+}
+
+func (c *Control) FeatureList(ctx context.Context) ([]models.Feature, error) {
+	const op = "Control.FeatureList"
+
+	log := c.log.With(
+		slog.String("op", op),
+	)
+
+	log.Info("attempting to prepare feature list")
+
+	list, err := c.storage.FeatureList(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	log.Info("feature list prepared successfully")
+
+	return list, nil
+}
+
+func (c *Control) DeprecateFeature(ctx context.Context, name string, deprecated bool) error {
+	const op = "Control.DeprecateFeature"
+
+	log := c.log.With(
+		slog.String("op", op),
+		slog.String("feature", name),
+		slog.Bool("deprecated", deprecated),
+	)
+
+	log.Info("attempting to change feature deprecation")
+
+	if err := c.storage.DeprecateFeature(ctx, name, deprecated); err != nil {
+		if errors.Is(err, storage.ErrFeatureNotFound) {
+			return fmt.Errorf("%s: %w", op, ErrFeatureNotFound)
+		}
+
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	log.Info("feature deprecation changed successfully")
+
+	return nil
+}
+
+// DeleteFeature removes the feature from the catalog and from every device.
+func (c *Control) DeleteFeature(ctx context.Context, name string) error {
+	const op = "Control.DeleteFeature"
+
+	log := c.log.With(
+		slog.String("op", op),
+		slog.String("feature", name),
+	)
+
+	log.Info("attempting to delete feature")
+
+	devices, err := c.storage.DeleteFeature(ctx, name)
+	if err != nil {
+		if errors.Is(err, storage.ErrFeatureNotFound) {
+			return fmt.Errorf("%s: %w", op, ErrFeatureNotFound)
+		}
+
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err = c.management.DevicesChanged(ctx, devices); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	log.Info("feature deleted successfully", slog.Int("devices", len(devices)))
+
+	return nil
+}
+
+// BulkSetFeatureState sets the feature value on every device of the target
+// in a single transaction and pushes the change to the changed devices.
+// With dryRun nothing is changed, the result is what the call would do.
+// An invalid target is reported with an error wrapping
+// models.ErrInvalidBulkTarget.
+func (c *Control) BulkSetFeatureState(
+	ctx context.Context,
+	target models.BulkTarget,
+	feature string,
+	value models.FeatureValue,
+	dryRun bool,
+) (models.BulkFeatureResult, error) {
+	const op = "Control.BulkSetFeatureState"
+
+	log := c.log.With(
+		slog.String("op", op),
+		slog.String("feature", feature),
+		slog.String("value", value.String()),
+		slog.Bool("dry_run", dryRun),
+	)
+
+	log.Info("attempting to set feature state of devices")
+
+	if err := target.Validate(); err != nil {
+		return models.BulkFeatureResult{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	f, err := c.storage.Feature(ctx, feature)
+	if err != nil {
+		return models.BulkFeatureResult{}, fmt.Errorf("%s: %w", op, groupError(err))
+	}
+
+	if f.Deprecated {
+		return models.BulkFeatureResult{}, fmt.Errorf("%s: %w", op, ErrFeatureDeprecated)
+	}
+
+	if err = f.Validate(value); err != nil {
+		return models.BulkFeatureResult{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	result, err := c.storage.BulkUpdateDeviceFeature(ctx, target, feature, value, dryRun)
+	if err != nil {
+		return models.BulkFeatureResult{}, fmt.Errorf("%s: %w", op, groupError(err))
+	}
+
+	if dryRun {
+		log.Info("feature state change of devices prepared", slog.Int("devices", len(result.Changes)))
+
+		return result, nil
+	}
+
+	// прежние значения у устройств разные, в журнал попадает каждое
+	auditsrv.SetChange(ctx, formatChanges(result.Changes), value.String())
+
+	if err = c.management.DevicesChanged(ctx, result.Changed()); err != nil {
+		return models.BulkFeatureResult{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	log.Info(
+		"feature state of devices changed successfully",
+		slog.Int("devices", len(result.Changes)),
+		slog.Int("unchanged", len(result.Unchanged)),
+		slog.Int("skipped", len(result.Skipped)),
+	)
+
+	return result, nil
+}
@@ -0,0 +1,293 @@
+package controlsrv
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"github.com/dvaxert/mdm/internal/domain/models"
+	auditsrv "github.com/dvaxert/mdm/internal/server/services/audit"
+	"github.com/dvaxert/mdm/internal/server/storage"
+	"github.com/google/uuid"
+)
+
+// CreateGroup creates an empty group, an empty parent creates a root group.
+func (c *Control) CreateGroup(ctx context.Context, name string, parent string) error {
+	const op = "Control.CreateGroup"
+
+	log := c.log.With(
+		slog.String("op", op),
+		slog.String("group", name),
+		slog.String("parent", parent),
+	)
+
+	log.Info("attempting to create group")
+
+	if err := c.storage.CreateGroup(ctx, name, parent); err != nil {
+		return fmt.Errorf("%s: %w", op, groupError(err))
+	}
+
+	log.Info("group created successfully")
+
+	return nil
+}
+
+// DeleteGroup removes a group without subgroups, its members fall back to the
+// values of their other groups.
+func (c *Control) DeleteGroup(ctx context.Context, name string) error {
+	const op = "Control.DeleteGroup"
+
+	log := c.log.With(
+		slog.String("op", op),
+		slog.String("group", name),
+	)
+
+	log.Info("attempting to delete group")
+
+	devices, err := c.storage.DeleteGroup(ctx, name)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, groupError(err))
+	}
+
+	if err = c.management.DevicesChanged(ctx, devices); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	log.Info("group deleted successfully", slog.Int("devices", len(devices)))
+
+	return nil
+}
+
+func (c *Control) Group(ctx context.Context, name string) (models.Group, error) {
+	const op = "Control.Group"
+
+	log := c.log.With(
+		slog.String("op", op),
+		slog.String("group", name),
+	)
+
+	log.Info("attempting to prepare group description")
+
+	group, err := c.storage.Group(ctx, name)
+	if err != nil {
+		return models.Group{}, fmt.Errorf("%s: %w", op, groupError(err))
+	}
+
+	log.Info("group description prepared successfully")
+
+	return group, nil
+}
+
+func (c *Control) GroupList(ctx context.Context) ([]models.Group, error) {
+	const op = "Control.GroupList"
+
+	log := c.log.With(
+		slog.String("op", op),
+	)
+
+	log.Info("attempting to prepare group list")
+
+	list, err := c.storage.GroupList(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	log.Info("group list prepared successfully")
+
+	return list, nil
+}
+
+func (c *Control) AddGroupDevice(ctx context.Context, group string, device_uuid uuid.UUID) error {
+	const op = "Control.AddGroupDevice"
+
+	log := c.log.With(
+		slog.String("op", op),
+		slog.String("group", group),
+		slog.String("uuid", device_uuid.String()),
+	)
+
+	log.Info("attempting to add device to group")
+
+	devices, err := c.storage.AddGroupDevice(ctx, group, device_uuid)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, groupError(err))
+	}
+
+	if err = c.management.DevicesChanged(ctx, devices); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	log.Info("device added to group successfully")
+
+	return nil
+}
+
+func (c *Control) RemoveGroupDevice(ctx context.Context, group string, device_uuid uuid.UUID) error {
+	const op = "Control.RemoveGroupDevice"
+
+	log := c.log.With(
+		slog.String("op", op),
+		slog.String("group", group),
+		slog.String("uuid", device_uuid.String()),
+	)
+
+	log.Info("attempting to remove device from group")
+
+	devices, err := c.storage.RemoveGroupDevice(ctx, group, device_uuid)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, groupError(err))
+	}
+
+	if err = c.management.DevicesChanged(ctx, devices); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	log.Info("device removed from group successfully")
+
+	return nil
+}
+
+// SetGroupFeatureState validates the value against the feature catalog and
+// sets it on the group. Every member of the group and its subgroups the
+// feature applies to inherits the value unless a closer group or the device
+// itself sets another one.
+func (c *Control) SetGroupFeatureState(
+	ctx context.Context,
+	group string,
+	feature string,
+	value models.FeatureValue,
+) error {
+	const op = "Control.SetGroupFeatureState"
+
+	log := c.log.With(
+		slog.String("op", op),
+		slog.String("group", group),
+		slog.String("feature", feature),
+		slog.String("value", value.String()),
+	)
+
+	log.Info("attempting to set group feature state")
+
+	f, err := c.storage.Feature(ctx, feature)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, groupError(err))
+	}
+
+	if f.Deprecated {
+		return fmt.Errorf("%s: %w", op, ErrFeatureDeprecated)
+	}
+
+	if err = f.Validate(value); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	previous, err := c.storage.Group(ctx, group)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, groupError(err))
+	}
+
+	devices, err := c.storage.SetGroupFeature(ctx, group, feature, value)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, groupError(err))
+	}
+
+	auditsrv.SetChange(ctx, featureValue(previous.Features, feature), value.String())
+
+	if err = c.management.DevicesChanged(ctx, devices); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	log.Info("group feature state changed successfully", slog.Int("devices", len(devices)))
+
+	return nil
+}
+
+func (c *Control) ClearGroupFeatureState(ctx context.Context, group string, feature string) error {
+	const op = "Control.ClearGroupFeatureState"
+
+	log := c.log.With(
+		slog.String("op", op),
+		slog.String("group", group),
+		slog.String("feature", feature),
+	)
+
+	log.Info("attempting to clear group feature state")
+
+	previous, err := c.storage.Group(ctx, group)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, groupError(err))
+	}
+
+	devices, err := c.storage.ClearGroupFeature(ctx, group, feature)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, groupError(err))
+	}
+
+	auditsrv.SetChange(ctx, featureValue(previous.Features, feature), "")
+
+	if err = c.management.DevicesChanged(ctx, devices); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	log.Info("group feature state cleared successfully", slog.Int("devices", len(devices)))
+
+	return nil
+}
+
+// ResetDeviceFeatureState drops the value set on the device itself, the
+// device inherits the feature value from its groups again.
+func (c *Control) ResetDeviceFeatureState(ctx context.Context, device_uuid uuid.UUID, feature string) error {
+	const op = "Control.ResetDeviceFeatureState"
+
+	log := c.log.With(
+		slog.String("op", op),
+		slog.String("uuid", device_uuid.String()),
+		slog.String("feature", feature),
+	)
+
+	log.Info("attempting to reset device feature state")
+
+	previous, err := c.storage.DeviceFeatures(ctx, device_uuid)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, groupError(err))
+	}
+
+	devices, err := c.storage.ResetDeviceFeature(ctx, device_uuid, feature)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, groupError(err))
+	}
+
+	current, err := c.storage.DeviceFeatures(ctx, device_uuid)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	auditsrv.SetChange(ctx, featureValue(previous.Features, feature), featureValue(current.Features, feature))
+
+	if err = c.management.DevicesChanged(ctx, devices); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	log.Info("device feature state reset successfully")
+
+	return nil
+}
+
+// groupError translates the storage errors of the group operations.
+func groupError(err error) error {
+	switch {
+	case errors.Is(err, storage.ErrGroupNotFound):
+		return ErrGroupNotFound
+	case errors.Is(err, storage.ErrGroupExists):
+		return ErrGroupExists
+	case errors.Is(err, storage.ErrGroupHasSubgroups):
+		return ErrGroupHasSubgroups
+	case errors.Is(err, storage.ErrDeviceNotFound):
+		return ErrDeviceNotFound
+	case errors.Is(err, storage.ErrFeatureNotFound):
+		return ErrFeatureNotFound
+	}
+
+	return err
+}
@@ -0,0 +1,51 @@
+package controlsrv
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"github.com/dvaxert/mdm/internal/domain/models"
+	"github.com/dvaxert/mdm/internal/server/storage"
+	"github.com/google/uuid"
+)
+
+// DeviceInventory returns the version of the inventory reported by the
+// device with its differences from the previous version, zero version is
+// the latest one.
+func (c *Control) DeviceInventory(
+	ctx context.Context,
+	device_uuid uuid.UUID,
+	version int64,
+) (models.InventorySnapshot, error) {
+	const op = "Control.DeviceInventory"
+
+	log := c.log.With(
+		slog.String("op", op),
+		slog.String("uuid", device_uuid.String()),
+		slog.Int64("version", version),
+	)
+
+	log.Info("attempting to prepare device inventory")
+
+	snapshot, err := c.storage.DeviceInventory(ctx, device_uuid, version)
+	if err != nil {
+		return models.InventorySnapshot{}, fmt.Errorf("%s: %w", op, inventoryError(err))
+	}
+
+	log.Info("device inventory prepared successfully", slog.Int64("latest_version", snapshot.LatestVersion))
+
+	return snapshot, nil
+}
+
+func inventoryError(err error) error {
+	switch {
+	case errors.Is(err, storage.ErrDeviceNotFound):
+		return ErrDeviceNotFound
+	case errors.Is(err, storage.ErrInventoryNotFound):
+		return ErrInventoryNotFound
+	}
+
+	return err
+}
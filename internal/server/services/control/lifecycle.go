@@ -0,0 +1,62 @@
+package controlsrv
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"github.com/dvaxert/mdm/internal/domain/models"
+	auditsrv "github.com/dvaxert/mdm/internal/server/services/audit"
+	"github.com/dvaxert/mdm/internal/server/storage"
+	"github.com/google/uuid"
+)
+
+// SetDeviceLifecycle moves the device to the lifecycle state, see
+// models.Lifecycle.CanChange for the changes an operator can make. A device
+// moved to pending enrolls again with an enrollment token and gets a fresh
+// identity, a retired device is kept with its history but hidden from the
+// device lists.
+func (c *Control) SetDeviceLifecycle(
+	ctx context.Context,
+	device_uuid uuid.UUID,
+	lifecycle models.Lifecycle,
+) (models.LifecycleChange, error) {
+	const op = "Control.SetDeviceLifecycle"
+
+	log := c.log.With(
+		slog.String("op", op),
+		slog.String("uuid", device_uuid.String()),
+		slog.String("lifecycle", lifecycle.String()),
+	)
+
+	log.Info("attempting to change device lifecycle")
+
+	change, err := c.storage.SetDeviceLifecycle(ctx, device_uuid, lifecycle)
+	if err != nil {
+		return models.LifecycleChange{}, fmt.Errorf("%s: %w", op, lifecycleError(err))
+	}
+
+	auditsrv.SetChange(ctx, change.Previous.String(), change.Current.String())
+
+	c.management.DeviceLifecycleChanged(ctx, device_uuid, change)
+
+	log.Info(
+		"device lifecycle changed successfully",
+		slog.String("previous", change.Previous.String()),
+		slog.Int("revoked_certificates", change.Certificates),
+	)
+
+	return change, nil
+}
+
+func lifecycleError(err error) error {
+	switch {
+	case errors.Is(err, storage.ErrDeviceNotFound):
+		return ErrDeviceNotFound
+	case errors.Is(err, storage.ErrLifecycleChange):
+		return ErrLifecycleChange
+	}
+
+	return err
+}
@@ -0,0 +1,254 @@
+package controlsrv
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"github.com/dvaxert/mdm/internal/domain/models"
+	"github.com/dvaxert/mdm/internal/server/storage"
+)
+
+// CreateOperator stores the operator with the roles and returns its API
+// token. Only the hash of the token is stored, so the token is returned
+// only here and by ResetOperatorToken. An invalid operator is reported with
+// an error wrapping models.ErrInvalidOperator.
+func (c *Control) CreateOperator(ctx context.Context, name string, roles []string) (string, error) {
+	const op = "Control.CreateOperator"
+
+	log := c.log.With(
+		slog.String("op", op),
+		slog.String("operator", name),
+		slog.Any("roles", roles),
+	)
+
+	log.Info("attempting to create operator")
+
+	operator := models.Operator{Name: name, Roles: roles}
+	if err := operator.Validate(); err != nil {
+		return "", fmt.Errorf("%s: %w", op, err)
+	}
+
+	token, err := models.NewToken()
+	if err != nil {
+		return "", fmt.Errorf("%s: %w", op, err)
+	}
+	operator.TokenHash = models.TokenHash(token)
+
+	if err = c.storage.CreateOperator(ctx, operator); err != nil {
+		return "", fmt.Errorf("%s: %w", op, operatorError(err))
+	}
+
+	log.Info("operator created successfully")
+
+	return token, nil
+}
+
+// DeleteOperator removes the operator, its token stops working at once.
+// Operators from the server config can not be deleted.
+func (c *Control) DeleteOperator(ctx context.Context, name string) error {
+	const op = "Control.DeleteOperator"
+
+	log := c.log.With(
+		slog.String("op", op),
+		slog.String("operator", name),
+	)
+
+	log.Info("attempting to delete operator")
+
+	if err := c.writableOperator(ctx, name); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := c.storage.DeleteOperator(ctx, name); err != nil {
+		return fmt.Errorf("%s: %w", op, operatorError(err))
+	}
+
+	log.Info("operator deleted successfully")
+
+	return nil
+}
+
+func (c *Control) OperatorList(ctx context.Context) ([]models.Operator, error) {
+	const op = "Control.OperatorList"
+
+	log := c.log.With(
+		slog.String("op", op),
+	)
+
+	log.Info("attempting to prepare operator list")
+
+	list, err := c.storage.OperatorList(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	log.Info("operator list prepared successfully")
+
+	return list, nil
+}
+
+// SetOperatorRoles replaces the roles of the operator.
+func (c *Control) SetOperatorRoles(ctx context.Context, name string, roles []string) error {
+	const op = "Control.SetOperatorRoles"
+
+	log := c.log.With(
+		slog.String("op", op),
+		slog.String("operator", name),
+		slog.Any("roles", roles),
+	)
+
+	log.Info("attempting to set operator roles")
+
+	if err := c.writableOperator(ctx, name); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := c.storage.SetOperatorRoles(ctx, name, roles); err != nil {
+		return fmt.Errorf("%s: %w", op, operatorError(err))
+	}
+
+	log.Info("operator roles set successfully")
+
+	return nil
+}
+
+// ResetOperatorToken replaces the API token of the operator and returns the
+// new one, the previous token stops working.
+func (c *Control) ResetOperatorToken(ctx context.Context, name string) (string, error) {
+	const op = "Control.ResetOperatorToken"
+
+	log := c.log.With(
+		slog.String("op", op),
+		slog.String("operator", name),
+	)
+
+	log.Info("attempting to reset operator token")
+
+	if err := c.writableOperator(ctx, name); err != nil {
+		return "", fmt.Errorf("%s: %w", op, err)
+	}
+
+	token, err := models.NewToken()
+	if err != nil {
+		return "", fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err = c.storage.SetOperatorToken(ctx, name, models.TokenHash(token)); err != nil {
+		return "", fmt.Errorf("%s: %w", op, operatorError(err))
+	}
+
+	log.Info("operator token reset successfully")
+
+	return token, nil
+}
+
+// CreateRole validates the role and stores it. An invalid role is reported
+// with an error wrapping models.ErrInvalidRole or models.ErrInvalidSelector.
+func (c *Control) CreateRole(ctx context.Context, role models.Role) error {
+	const op = "Control.CreateRole"
+
+	log := c.log.With(
+		slog.String("op", op),
+		slog.String("role", role.Name),
+	)
+
+	log.Info("attempting to create role")
+
+	if _, ok := models.BuiltinRole(role.Name); ok {
+		return fmt.Errorf("%s: %w", op, ErrRoleExists)
+	}
+
+	role.Builtin = false
+	if err := role.Validate(); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := c.storage.CreateRole(ctx, role); err != nil {
+		return fmt.Errorf("%s: %w", op, operatorError(err))
+	}
+
+	log.Info("role created successfully")
+
+	return nil
+}
+
+// DeleteRole removes the role. Built-in roles and roles granted to
+// operators can not be deleted.
+func (c *Control) DeleteRole(ctx context.Context, name string) error {
+	const op = "Control.DeleteRole"
+
+	log := c.log.With(
+		slog.String("op", op),
+		slog.String("role", name),
+	)
+
+	log.Info("attempting to delete role")
+
+	if _, ok := models.BuiltinRole(name); ok {
+		return fmt.Errorf("%s: %w", op, ErrRoleReadOnly)
+	}
+
+	if err := c.storage.DeleteRole(ctx, name); err != nil {
+		return fmt.Errorf("%s: %w", op, operatorError(err))
+	}
+
+	log.Info("role deleted successfully")
+
+	return nil
+}
+
+// RoleList returns the built-in roles followed by the custom ones.
+func (c *Control) RoleList(ctx context.Context) ([]models.Role, error) {
+	const op = "Control.RoleList"
+
+	log := c.log.With(
+		slog.String("op", op),
+	)
+
+	log.Info("attempting to prepare role list")
+
+	list, err := c.storage.Roles(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	log.Info("role list prepared successfully")
+
+	return append(append([]models.Role{}, models.BuiltinRoles...), list...), nil
+}
+
+// writableOperator checks that the operator exists and is not defined in
+// the server config.
+func (c *Control) writableOperator(ctx context.Context, name string) error {
+	operator, err := c.storage.Operator(ctx, name)
+	if err != nil {
+		return operatorError(err)
+	}
+
+	if operator.FromConfig {
+		return ErrOperatorReadOnly
+	}
+
+	return nil
+}
+
+func operatorError(err error) error {
+	switch {
+	case errors.Is(err, storage.ErrOperatorNotFound):
+		return ErrOperatorNotFound
+	case errors.Is(err, storage.ErrOperatorExists):
+		return ErrOperatorExists
+	case errors.Is(err, storage.ErrRoleNotFound):
+		return ErrRoleNotFound
+	case errors.Is(err, storage.ErrRoleExists):
+		return ErrRoleExists
+	case errors.Is(err, storage.ErrRoleInUse):
+		return ErrRoleInUse
+	case errors.Is(err, storage.ErrGroupNotFound):
+		return ErrGroupNotFound
+	}
+
+	return err
+}
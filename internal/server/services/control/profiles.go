@@ -0,0 +1,229 @@
+package controlsrv
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"github.com/dvaxert/mdm/internal/domain/models"
+	"github.com/dvaxert/mdm/internal/server/storage"
+	"github.com/google/uuid"
+)
+
+// CreateProfile validates the feature values of the profile against the
+// catalog and stores the profile unassigned.
+func (c *Control) CreateProfile(ctx context.Context, profile models.Profile) error {
+	const op = "Control.CreateProfile"
+
+	log := c.log.With(
+		slog.String("op", op),
+		slog.String("profile", profile.Name),
+	)
+
+	log.Info("attempting to create profile")
+
+	if err := c.validateFeatures(ctx, profile.Features); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := c.storage.CreateProfile(ctx, profile); err != nil {
+		return fmt.Errorf("%s: %w", op, profileError(err))
+	}
+
+	log.Info("profile created successfully")
+
+	return nil
+}
+
+// UpdateProfile replaces the description and the feature values of the
+// profile, every device the profile is assigned to is marked as changed.
+func (c *Control) UpdateProfile(ctx context.Context, profile models.Profile) error {
+	const op = "Control.UpdateProfile"
+
+	log := c.log.With(
+		slog.String("op", op),
+		slog.String("profile", profile.Name),
+	)
+
+	log.Info("attempting to update profile")
+
+	if err := c.validateFeatures(ctx, profile.Features); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	devices, err := c.storage.UpdateProfile(ctx, profile)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, profileError(err))
+	}
+
+	if err = c.management.DevicesChanged(ctx, devices); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	log.Info("profile updated successfully", slog.Int("devices", len(devices)))
+
+	return nil
+}
+
+func (c *Control) DeleteProfile(ctx context.Context, name string) error {
+	const op = "Control.DeleteProfile"
+
+	log := c.log.With(
+		slog.String("op", op),
+		slog.String("profile", name),
+	)
+
+	log.Info("attempting to delete profile")
+
+	devices, err := c.storage.DeleteProfile(ctx, name)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, profileError(err))
+	}
+
+	if err = c.management.DevicesChanged(ctx, devices); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	log.Info("profile deleted successfully", slog.Int("devices", len(devices)))
+
+	return nil
+}
+
+func (c *Control) Profile(ctx context.Context, name string) (models.Profile, error) {
+	const op = "Control.Profile"
+
+	log := c.log.With(
+		slog.String("op", op),
+		slog.String("profile", name),
+	)
+
+	log.Info("attempting to prepare profile description")
+
+	profile, err := c.storage.Profile(ctx, name)
+	if err != nil {
+		return models.Profile{}, fmt.Errorf("%s: %w", op, profileError(err))
+	}
+
+	log.Info("profile description prepared successfully")
+
+	return profile, nil
+}
+
+func (c *Control) ProfileList(ctx context.Context) ([]models.Profile, error) {
+	const op = "Control.ProfileList"
+
+	log := c.log.With(
+		slog.String("op", op),
+	)
+
+	log.Info("attempting to prepare profile list")
+
+	list, err := c.storage.ProfileList(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	log.Info("profile list prepared successfully")
+
+	return list, nil
+}
+
+func (c *Control) AssignDeviceProfile(ctx context.Context, profile string, device_uuid uuid.UUID, assigned bool) error {
+	const op = "Control.AssignDeviceProfile"
+
+	log := c.log.With(
+		slog.String("op", op),
+		slog.String("profile", profile),
+		slog.String("uuid", device_uuid.String()),
+		slog.Bool("assigned", assigned),
+	)
+
+	log.Info("attempting to change device profile assignment")
+
+	assign := c.storage.AssignDeviceProfile
+	if !assigned {
+		assign = c.storage.UnassignDeviceProfile
+	}
+
+	devices, err := assign(ctx, profile, device_uuid)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, profileError(err))
+	}
+
+	if err = c.management.DevicesChanged(ctx, devices); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	log.Info("device profile assignment changed successfully")
+
+	return nil
+}
+
+func (c *Control) AssignGroupProfile(ctx context.Context, profile string, group string, assigned bool) error {
+	const op = "Control.AssignGroupProfile"
+
+	log := c.log.With(
+		slog.String("op", op),
+		slog.String("profile", profile),
+		slog.String("group", group),
+		slog.Bool("assigned", assigned),
+	)
+
+	log.Info("attempting to change group profile assignment")
+
+	assign := c.storage.AssignGroupProfile
+	if !assigned {
+		assign = c.storage.UnassignGroupProfile
+	}
+
+	devices, err := assign(ctx, profile, group)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, profileError(err))
+	}
+
+	if err = c.management.DevicesChanged(ctx, devices); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	log.Info("group profile assignment changed successfully", slog.Int("devices", len(devices)))
+
+	return nil
+}
+
+// validateFeatures checks that the features exist in the catalog, are not
+// deprecated and the values satisfy their definitions.
+func (c *Control) validateFeatures(ctx context.Context, features map[string]models.FeatureValue) error {
+	for name, value := range features {
+		f, err := c.storage.Feature(ctx, name)
+		if err != nil {
+			if errors.Is(err, storage.ErrFeatureNotFound) {
+				return fmt.Errorf("%w: %s", ErrFeatureNotFound, name)
+			}
+
+			return err
+		}
+
+		if f.Deprecated {
+			return fmt.Errorf("%w: %s", ErrFeatureDeprecated, name)
+		}
+
+		if err = f.Validate(value); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// profileError translates the storage errors of the profile operations.
+func profileError(err error) error {
+	switch {
+	case errors.Is(err, storage.ErrProfileNotFound):
+		return ErrProfileNotFound
+	case errors.Is(err, storage.ErrProfileExists):
+		return ErrProfileExists
+	}
+
+	return groupError(err)
+}
@@ -0,0 +1,98 @@
+package controlsrv
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"github.com/dvaxert/mdm/internal/domain/models"
+	"github.com/dvaxert/mdm/internal/server/storage"
+)
+
+// CreateWebhook validates the webhook and stores it. An invalid webhook is
+// reported with an error wrapping models.ErrInvalidWebhook.
+func (c *Control) CreateWebhook(ctx context.Context, webhook models.Webhook) error {
+	const op = "Control.CreateWebhook"
+
+	log := c.log.With(
+		slog.String("op", op),
+		slog.String("webhook", webhook.Name),
+		slog.String("url", webhook.Url),
+	)
+
+	log.Info("attempting to create webhook")
+
+	webhook.FromConfig = false
+	if err := webhook.Validate(); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := c.storage.CreateWebhook(ctx, webhook); err != nil {
+		return fmt.Errorf("%s: %w", op, webhookError(err))
+	}
+
+	log.Info("webhook created successfully")
+
+	return nil
+}
+
+// DeleteWebhook removes the webhook and drops its pending deliveries.
+// Webhooks from the server config can not be deleted.
+func (c *Control) DeleteWebhook(ctx context.Context, name string) error {
+	const op = "Control.DeleteWebhook"
+
+	log := c.log.With(
+		slog.String("op", op),
+		slog.String("webhook", name),
+	)
+
+	log.Info("attempting to delete webhook")
+
+	webhook, err := c.storage.Webhook(ctx, name)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, webhookError(err))
+	}
+
+	if webhook.FromConfig {
+		return fmt.Errorf("%s: %w", op, ErrWebhookReadOnly)
+	}
+
+	if err = c.storage.DeleteWebhook(ctx, name); err != nil {
+		return fmt.Errorf("%s: %w", op, webhookError(err))
+	}
+
+	log.Info("webhook deleted successfully")
+
+	return nil
+}
+
+func (c *Control) WebhookList(ctx context.Context) ([]models.Webhook, error) {
+	const op = "Control.WebhookList"
+
+	log := c.log.With(
+		slog.String("op", op),
+	)
+
+	log.Info("attempting to prepare webhook list")
+
+	list, err := c.storage.Webhooks(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	log.Info("webhook list prepared successfully")
+
+	return list, nil
+}
+
+func webhookError(err error) error {
+	switch {
+	case errors.Is(err, storage.ErrWebhookNotFound):
+		return ErrWebhookNotFound
+	case errors.Is(err, storage.ErrWebhookExists):
+		return ErrWebhookExists
+	}
+
+	return err
+}
@@ -0,0 +1,314 @@
+package eventsrv
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/dvaxert/mdm/internal/domain/models"
+	"github.com/google/uuid"
+)
+
+// subscriberBuffer is the number of events that can be queued for a
+// subscriber before it is considered too slow and dropped.
+const subscriberBuffer = 256
+
+// Events publishes the device events. Every event gets the next sequence
+// number, is forwarded to the notifiers and streamed to the subscribers.
+// The latest events are kept in memory, so a subscriber that reconnects can
+// resume after the last event it received. Sequence numbers start from the
+// server start time in microseconds to keep growing across restarts.
+//
+// Events also tracks the presence of devices: pings bring a device online
+// at once, the transitions to stale and offline are detected by a check
+// that runs every period.
+type Events struct {
+	log       *slog.Logger
+	storage   StorageProvider
+	presence  models.PresencePolicy
+	period    time.Duration
+	notifiers []Notifier
+
+	mu          sync.Mutex
+	seq         int64
+	buffer      []models.Event // кольцевой буфер последних событий
+	head        int            // индекс самого старого события в buffer
+	count       int
+	subscribers map[chan models.Event]models.EventFilter
+	devices     map[uuid.UUID]deviceState
+	stopped     bool
+
+	stop chan struct{}
+	done chan struct{}
+}
+
+type deviceState struct {
+	presence models.Presence
+	lastSeen time.Time
+}
+
+type StorageProvider interface {
+	DeviceStatusList(ctx context.Context, filter models.DeviceFilter, page models.Page) ([]models.DeviceStatus, string, error)
+}
+
+// Notifier receives every published event, errors are handled by the
+// notifier.
+type Notifier interface {
+	Notify(ctx context.Context, event models.Event)
+}
+
+func New(
+	log *slog.Logger,
+	storage StorageProvider,
+	presence models.PresencePolicy,
+	period time.Duration,
+	size int,
+	notifiers ...Notifier,
+) *Events {
+	return &Events{
+		log:         log,
+		storage:     storage,
+		presence:    presence,
+		period:      period,
+		notifiers:   notifiers,
+		seq:         time.Now().UnixMicro(),
+		buffer:      make([]models.Event, size),
+		subscribers: make(map[chan models.Event]models.EventFilter),
+		stop:        make(chan struct{}),
+		done:        make(chan struct{}),
+	}
+}
+
+// Run checks the presence of devices every period until Stop is called.
+func (e *Events) Run() {
+	defer close(e.done)
+
+	ticker := time.NewTicker(e.period)
+	defer ticker.Stop()
+
+	for {
+		if err := e.checkPresence(context.Background()); err != nil {
+			e.log.Error("failed to check device presence", slog.Any("error", err))
+		}
+
+		select {
+		case <-ticker.C:
+		case <-e.stop:
+			return
+		}
+	}
+}
+
+// Stop stops Run, waits for the running check to finish and closes the
+// channels of all subscribers, so that the streams end before the server
+// stops.
+func (e *Events) Stop() {
+	close(e.stop)
+	<-e.done
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	e.stopped = true
+	for events := range e.subscribers {
+		delete(e.subscribers, events)
+		close(events)
+	}
+}
+
+// Notify publishes the event. A ping or a registration brings the device
+// online, which publishes a presence change as well.
+func (e *Events) Notify(ctx context.Context, event models.Event) {
+	e.mu.Lock()
+
+	published := []models.Event{e.publish(event)}
+
+	if event.Type == models.EventDevicePinged || event.Type == models.EventDeviceRegistered {
+		state, known := e.devices[event.DeviceUuid]
+		if known && state.presence != models.PresenceOnline {
+			published = append(published, e.publish(models.NewEvent(
+				models.EventPresenceChanged,
+				event.DeviceUuid,
+				models.PresenceChangedData{Presence: models.PresenceOnline, PreviousPresence: state.presence},
+			)))
+		}
+
+		if e.devices != nil {
+			e.devices[event.DeviceUuid] = deviceState{presence: models.PresenceOnline, lastSeen: event.Time}
+		}
+	}
+
+	e.mu.Unlock()
+
+	for _, event := range published {
+		for _, n := range e.notifiers {
+			n.Notify(ctx, event)
+		}
+	}
+}
+
+// Subscribe streams the events matching the filter until ctx is done. If
+// after is not zero, the kept events following the event with that sequence
+// number are sent first; an error wrapping models.ErrEventSequenceExpired
+// is returned if some of them are no longer kept. The channel is closed when
+// ctx is done, when the subscriber does not keep up with the events or when
+// Events is stopped.
+func (e *Events) Subscribe(ctx context.Context, filter models.EventFilter, after int64) (<-chan models.Event, error) {
+	const op = "Events.Subscribe"
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	var replay []models.Event
+	if after != 0 {
+		if after > e.seq {
+			return nil, fmt.Errorf("%s: %w", op, models.ErrEventSequenceUnknown)
+		}
+
+		// события после after должны быть в буфере целиком
+		if missed := e.seq - after; missed > int64(e.count) {
+			return nil, fmt.Errorf("%s: %w", op, models.ErrEventSequenceExpired)
+		}
+
+		for i := e.count - int(e.seq-after); i < e.count; i++ {
+			event := e.buffer[(e.head+i)%len(e.buffer)]
+			if filter.Match(event) {
+				replay = append(replay, event)
+			}
+		}
+	}
+
+	events := make(chan models.Event, len(replay)+subscriberBuffer)
+	for _, event := range replay {
+		events <- event
+	}
+
+	if e.stopped {
+		close(events)
+		return events, nil
+	}
+	e.subscribers[events] = filter
+
+	go func() {
+		<-ctx.Done()
+
+		e.mu.Lock()
+		defer e.mu.Unlock()
+
+		if _, ok := e.subscribers[events]; ok {
+			delete(e.subscribers, events)
+			close(events)
+		}
+	}()
+
+	return events, nil
+}
+
+// publish numbers the event, keeps it and sends it to the subscribers, e.mu
+// must be held. A subscriber whose queue is full is dropped, it resumes from
+// the last event it received after reconnecting.
+func (e *Events) publish(event models.Event) models.Event {
+	e.seq++
+	event.Seq = e.seq
+
+	if len(e.buffer) != 0 {
+		if e.count == len(e.buffer) {
+			e.buffer[e.head] = event
+			e.head = (e.head + 1) % len(e.buffer)
+		} else {
+			e.buffer[(e.head+e.count)%len(e.buffer)] = event
+			e.count++
+		}
+	}
+
+	for events, filter := range e.subscribers {
+		if !filter.Match(event) {
+			continue
+		}
+
+		select {
+		case events <- event:
+		default:
+			delete(e.subscribers, events)
+			close(events)
+		}
+	}
+
+	return event
+}
+
+// checkPresence publishes the presence changes of devices since the
+// previous check. The first check only records the presence.
+func (e *Events) checkPresence(ctx context.Context) error {
+	const op = "Events.checkPresence"
+
+	var statuses []models.DeviceStatus
+
+	page := models.Page{Size: models.MaxPageSize}
+	for {
+		list, next, err := e.storage.DeviceStatusList(ctx, models.DeviceFilter{}, page)
+		if err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+
+		statuses = append(statuses, list...)
+
+		if next == "" {
+			break
+		}
+		page.Token = next
+	}
+
+	now := time.Now()
+
+	e.mu.Lock()
+
+	first := e.devices == nil
+	if first {
+		e.devices = make(map[uuid.UUID]deviceState, len(statuses))
+	}
+
+	var published []models.Event
+	for _, s := range statuses {
+		state, known := e.devices[s.DeviceUuid]
+
+		// ping мог прийти после чтения статусов
+		lastSeen := s.LastSeen
+		if state.lastSeen.After(lastSeen) {
+			lastSeen = state.lastSeen
+		}
+
+		presence := e.presence.Presence(lastSeen, now)
+		e.devices[s.DeviceUuid] = deviceState{presence: presence, lastSeen: lastSeen}
+
+		if first || !known || presence == state.presence {
+			continue
+		}
+
+		published = append(published, e.publish(models.NewEvent(
+			models.EventPresenceChanged,
+			s.DeviceUuid,
+			models.PresenceChangedData{Presence: presence, PreviousPresence: state.presence},
+		)))
+
+		if presence == models.PresenceOffline {
+			published = append(published, e.publish(models.NewEvent(
+				models.EventDeviceOffline,
+				s.DeviceUuid,
+				models.DeviceOfflineData{LastSeen: lastSeen.UTC()},
+			)))
+		}
+	}
+
+	e.mu.Unlock()
+
+	for _, event := range published {
+		for _, n := range e.notifiers {
+			n.Notify(ctx, event)
+		}
+	}
+
+	return nil
+}
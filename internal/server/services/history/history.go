@@ -0,0 +1,84 @@
+package historysrv
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/dvaxert/mdm/internal/domain/models"
+)
+
+// History applies the retention policy to the device status history.
+type History struct {
+	log     *slog.Logger
+	storage StorageProvider
+	policy  models.RetentionPolicy
+	period  time.Duration
+
+	stop chan struct{}
+	done chan struct{}
+}
+
+type StorageProvider interface {
+	CompactStatusHistory(ctx context.Context, removeBefore time.Time, downsampleBefore time.Time, interval time.Duration) (int64, error)
+}
+
+func New(log *slog.Logger, storage StorageProvider, policy models.RetentionPolicy, period time.Duration) *History {
+	return &History{
+		log:     log,
+		storage: storage,
+		policy:  policy,
+		period:  period,
+		stop:    make(chan struct{}),
+		done:    make(chan struct{}),
+	}
+}
+
+// Run compacts the history every period until Stop is called.
+func (h *History) Run() {
+	defer close(h.done)
+
+	ticker := time.NewTicker(h.period)
+	defer ticker.Stop()
+
+	for {
+		if err := h.Compact(context.Background()); err != nil {
+			h.log.Error("failed to compact status history", slog.Any("error", err))
+		}
+
+		select {
+		case <-ticker.C:
+		case <-h.stop:
+			return
+		}
+	}
+}
+
+// Stop stops Run and waits for the running compaction to finish.
+func (h *History) Stop() {
+	close(h.stop)
+	<-h.done
+}
+
+func (h *History) Compact(ctx context.Context) error {
+	const op = "History.Compact"
+
+	log := h.log.With(slog.String("op", op))
+
+	now := time.Now()
+
+	removed, err := h.storage.CompactStatusHistory(
+		ctx,
+		now.Add(-h.policy.Retention),
+		now.Add(-h.policy.DownsampleAfter),
+		h.policy.DownsampleInterval,
+	)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	log.Info("status history compacted", slog.Int64("removed", removed))
+
+	return nil
+}
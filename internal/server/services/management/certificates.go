@@ -0,0 +1,94 @@
+package managementsrv
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"github.com/dvaxert/mdm/internal/domain/models"
+	"github.com/dvaxert/mdm/internal/server/storage"
+	"github.com/google/uuid"
+)
+
+// RenewCertificate issues a new certificate for an enrolled device, which
+// rotates its certificate before the current one expires. The current
+// certificate stays valid until it expires or is revoked.
+func (m *Management) RenewCertificate(ctx context.Context, device_uuid uuid.UUID, csr []byte) (models.Certificate, error) {
+	const op = "Management.RenewCertificate"
+
+	log := m.log.With(
+		slog.String("op", op),
+		slog.String("uuid", device_uuid.String()),
+	)
+
+	log.Info("attempting to renew device certificate")
+
+	if err := m.admit(ctx, device_uuid); err != nil {
+		return models.Certificate{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	cert, err := m.sign(csr, device_uuid)
+	if err != nil {
+		return models.Certificate{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err = m.storage.AddCertificate(ctx, cert); err != nil {
+		return models.Certificate{}, fmt.Errorf("%s: %w", op, certificateError(err))
+	}
+
+	log.Info("device certificate renewed successfully", slog.String("serial", cert.Serial))
+
+	return cert, nil
+}
+
+// CACertificate returns the certificate of the enrollment CA, nil if the
+// server does not issue device certificates.
+func (m *Management) CACertificate() []byte {
+	if m.ca == nil {
+		return nil
+	}
+
+	return m.ca.CertificatePEM()
+}
+
+// CertificateRevoked reports whether the device certificate with the serial
+// has been revoked.
+func (m *Management) CertificateRevoked(ctx context.Context, serial string) (bool, error) {
+	const op = "Management.CertificateRevoked"
+
+	revoked, err := m.storage.CertificateRevoked(ctx, serial)
+	if err != nil {
+		return false, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return revoked, nil
+}
+
+// sign issues the certificate for the CSR of the device.
+func (m *Management) sign(csr []byte, device_uuid uuid.UUID) (models.Certificate, error) {
+	if m.ca == nil {
+		return models.Certificate{}, ErrEnrollmentDisabled
+	}
+
+	return m.ca.Sign(csr, device_uuid)
+}
+
+func certificateError(err error) error {
+	switch {
+	case errors.Is(err, storage.ErrEnrollmentTokenInvalid):
+		return ErrEnrollmentTokenInvalid
+	case errors.Is(err, storage.ErrEnrollmentTokenScope):
+		return ErrEnrollmentTokenScope
+	case errors.Is(err, storage.ErrDeviceNotFound):
+		return ErrDeviceNotFound
+	case errors.Is(err, storage.ErrDeviceSuspended):
+		return ErrDeviceSuspended
+	case errors.Is(err, storage.ErrDeviceEnrolled):
+		return ErrDeviceEnrolled
+	case errors.Is(err, storage.ErrDeviceDecommissioned):
+		return ErrDeviceDecommissioned
+	}
+
+	return err
+}
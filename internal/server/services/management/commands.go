@@ -0,0 +1,97 @@
+package managementsrv
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"github.com/dvaxert/mdm/internal/domain/models"
+	"github.com/dvaxert/mdm/internal/server/storage"
+	"github.com/google/uuid"
+)
+
+// DeviceCommands returns the commands the device has to execute and marks
+// them as delivered.
+func (m *Management) DeviceCommands(ctx context.Context, device_uuid uuid.UUID) ([]models.Command, error) {
+	const op = "Management.DeviceCommands"
+
+	log := m.log.With(
+		slog.String("op", op),
+		slog.String("uuid", device_uuid.String()),
+	)
+
+	log.Info("attempting to deliver commands to the device")
+
+	if err := m.admit(ctx, device_uuid); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	commands, err := m.storage.DeliverCommands(ctx, device_uuid)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, commandError(err))
+	}
+
+	log.Info("commands delivered successfully", slog.Int("commands", len(commands)))
+
+	return commands, nil
+}
+
+// DeviceReportCommand stores the progress or the result of a command
+// reported by the device. A device that reports a succeeded wipe is moved
+// to the wiped state.
+func (m *Management) DeviceReportCommand(
+	ctx context.Context,
+	device_uuid uuid.UUID,
+	id int64,
+	status models.CommandStatus,
+	result string,
+) error {
+	const op = "Management.DeviceReportCommand"
+
+	log := m.log.With(
+		slog.String("op", op),
+		slog.String("uuid", device_uuid.String()),
+		slog.Int64("command", id),
+		slog.String("status", status.String()),
+	)
+
+	log.Info("attempting to store the command status reported by the device")
+
+	if err := m.admit(ctx, device_uuid); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	command, err := m.storage.ReportCommand(ctx, device_uuid, id, status, result)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, commandError(err))
+	}
+
+	// успешный wipe выводит устройство из эксплуатации
+	if command.Type == models.CommandWipe && command.Status == models.CommandSucceeded {
+		change, err := m.storage.SetDeviceLifecycle(ctx, device_uuid, models.LifecycleWiped)
+		if err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+		m.DeviceLifecycleChanged(ctx, device_uuid, change)
+
+		log.Info("device wiped", slog.Int("revoked_certificates", change.Certificates))
+	}
+
+	log.Info("command status stored successfully")
+
+	return nil
+}
+
+func commandError(err error) error {
+	switch {
+	case errors.Is(err, storage.ErrDeviceNotFound):
+		return ErrDeviceNotFound
+	case errors.Is(err, storage.ErrCommandNotFound):
+		return ErrCommandNotFound
+	case errors.Is(err, storage.ErrCommandCompleted):
+		return ErrCommandCompleted
+	}
+
+	return err
+}
@@ -0,0 +1,70 @@
+package managementsrv
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/dvaxert/mdm/internal/domain/models"
+	"github.com/google/uuid"
+)
+
+// DeviceReportInventory stores the inventory reported by the device. A new
+// version is stored and delivered as an event only when the inventory
+// differs from the latest one, otherwise the latest version is confirmed.
+func (m *Management) DeviceReportInventory(
+	ctx context.Context,
+	device_uuid uuid.UUID,
+	inventory models.Inventory,
+) (models.InventorySnapshot, bool, error) {
+	const op = "Management.DeviceReportInventory"
+
+	log := m.log.With(
+		slog.String("op", op),
+		slog.String("uuid", device_uuid.String()),
+	)
+
+	log.Debug("attempting to store device inventory")
+
+	if err := m.admit(ctx, device_uuid); err != nil {
+		return models.InventorySnapshot{}, false, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := models.ValidateInventory(inventory); err != nil {
+		return models.InventorySnapshot{}, false, fmt.Errorf("%s: %w", op, err)
+	}
+
+	snapshot, changed, err := m.reportInventory(ctx, device_uuid, inventory)
+	if err != nil {
+		return models.InventorySnapshot{}, false, fmt.Errorf("%s: %w", op, err)
+	}
+
+	log.Debug(
+		"device inventory stored successfully",
+		slog.Int64("version", snapshot.Version),
+		slog.Bool("changed", changed),
+	)
+
+	return snapshot, changed, nil
+}
+
+// reportInventory stores the validated inventory and notifies a new version.
+func (m *Management) reportInventory(
+	ctx context.Context,
+	device_uuid uuid.UUID,
+	inventory models.Inventory,
+) (models.InventorySnapshot, bool, error) {
+	snapshot, changed, err := m.storage.ReportInventory(ctx, device_uuid, inventory)
+	if err != nil {
+		return models.InventorySnapshot{}, false, certificateError(err)
+	}
+
+	if changed {
+		m.events.Notify(ctx, models.NewEvent(models.EventInventoryChanged, device_uuid, models.InventoryChangedData{
+			Version: snapshot.Version,
+			Changes: snapshot.Changes,
+		}))
+	}
+
+	return snapshot, changed, nil
+}
@@ -0,0 +1,57 @@
+package managementsrv
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/dvaxert/mdm/internal/domain/models"
+	"github.com/google/uuid"
+)
+
+// DeviceLifecycleChanged handles a committed change of the lifecycle state:
+// a device that is no longer enrolled loses its push session, and the change
+// is delivered as an event.
+func (m *Management) DeviceLifecycleChanged(ctx context.Context, device_uuid uuid.UUID, change models.LifecycleChange) {
+	if change.Previous == change.Current {
+		return
+	}
+
+	if change.Current != models.LifecycleEnrolled {
+		m.mu.Lock()
+		if updates, ok := m.sessions[device_uuid]; ok {
+			delete(m.sessions, device_uuid)
+			close(updates)
+
+			m.log.Info(
+				"device session closed by the lifecycle change",
+				slog.String("uuid", device_uuid.String()),
+				slog.String("lifecycle", change.Current.String()),
+			)
+		}
+		m.mu.Unlock()
+	}
+
+	m.events.Notify(ctx, models.NewEvent(models.EventLifecycleChanged, device_uuid, models.LifecycleChangedData{
+		Lifecycle:         change.Current,
+		PreviousLifecycle: change.Previous,
+	}))
+}
+
+// admit refuses the calls of a device that is not enrolled.
+func (m *Management) admit(ctx context.Context, device_uuid uuid.UUID) error {
+	device, err := m.storage.Device(ctx, device_uuid)
+	if err != nil {
+		return certificateError(err)
+	}
+
+	switch {
+	case device.Lifecycle == models.LifecyclePending:
+		return ErrDevicePending
+	case device.Lifecycle == models.LifecycleSuspended:
+		return ErrDeviceSuspended
+	case device.Lifecycle.Decommissioned():
+		return ErrDeviceDecommissioned
+	}
+
+	return nil
+}
@@ -2,17 +2,48 @@ package managementsrv
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log/slog"
+	"sync"
 
 	"github.com/dvaxert/mdm/internal/domain/models"
+	"github.com/dvaxert/mdm/internal/server/storage"
 	"github.com/google/uuid"
 )
 
+var (
+	ErrDeviceNotFound   = errors.New("device not found")
+	ErrCommandNotFound  = errors.New("command not found")
+	ErrCommandCompleted = errors.New("command already completed")
+
+	ErrDevicePending        = errors.New("device is pending enrollment")
+	ErrDeviceSuspended      = errors.New("device is suspended")
+	ErrDeviceEnrolled       = errors.New("device is already enrolled")
+	ErrDeviceDecommissioned = errors.New("device is retired or wiped")
+
+	ErrEnrollmentDisabled      = errors.New("enrollment CA is not configured")
+	ErrEnrollmentTokenRequired = errors.New("enrollment token is required")
+	ErrEnrollmentTokenInvalid  = errors.New("enrollment token is invalid, expired or used")
+	ErrEnrollmentTokenScope    = errors.New("enrollment token does not allow the device type")
+)
+
+// sessionBuffer is the number of feature updates that can be queued for a
+// connected device before the push is considered failed.
+const sessionBuffer = 16
+
 type Management struct {
-	log     *slog.Logger
-	storage StorageProvider
-	states  map[uuid.UUID]bool // хранилище отображает для каких девайсов было изменено состояние
+	log        *slog.Logger
+	storage    StorageProvider
+	alerts     AlertEvaluator
+	compliance ComplianceEvaluator
+	events     EventNotifier
+	ca         CertificateAuthority // nil, если выпуск сертификатов не настроен
+
+	requireToken bool // регистрация новых устройств только по enrollment token
+
+	mu       sync.Mutex
+	sessions map[uuid.UUID]chan models.DeviceStateUpdate // открытые push-сессии устройств
 }
 
 type StorageProvider interface {
@@ -20,41 +51,203 @@ type StorageProvider interface {
 	Device(ctx context.Context, device_uuid uuid.UUID) (models.Device, error)
 	DeviceFeatures(ctx context.Context, device_uuid uuid.UUID) (models.DeviceFeatures, error)
 	DeviceStatus(ctx context.Context, device_uuid uuid.UUID) (models.DeviceStatus, error)
-	RegisterDevice(ctx context.Context, device_uuid uuid.UUID, device_type models.DeviceType) (int64, error)
-	UpdateDeviceFeature(ctx context.Context, device_uuid uuid.UUID, feature string, state bool) error
+	RegisterDevice(
+		ctx context.Context,
+		device_uuid uuid.UUID,
+		device_type models.DeviceType,
+		labels map[string]string,
+		token_hash string,
+		authenticated bool,
+	) (bool, error)
+	ReportAppliedRevision(ctx context.Context, device_uuid uuid.UUID, revision int64) (models.DeviceRevision, error)
+	ReportDeviceFeatures(ctx context.Context, device_uuid uuid.UUID, values map[string]models.FeatureValue) error
+	UpdateDeviceFeature(ctx context.Context, device_uuid uuid.UUID, feature string, value models.FeatureValue) (int64, error)
 	UpdateDeviceStatus(ctx context.Context, device_uuid uuid.UUID, location string, battery int) error
+	UpdateDeviceStateFetch(ctx context.Context, device_uuid uuid.UUID) error
+	DeliverCommands(ctx context.Context, device_uuid uuid.UUID) ([]models.Command, error)
+	ReportCommand(ctx context.Context, device_uuid uuid.UUID, id int64, status models.CommandStatus, result string) (models.Command, error)
+	SetDeviceLifecycle(ctx context.Context, device_uuid uuid.UUID, lifecycle models.Lifecycle) (models.LifecycleChange, error)
+	ReportInventory(ctx context.Context, device_uuid uuid.UUID, inventory models.Inventory) (models.InventorySnapshot, bool, error)
+	AddCertificate(ctx context.Context, cert models.Certificate) error
+	CertificateRevoked(ctx context.Context, serial string) (bool, error)
+}
+
+// CertificateAuthority issues the client certificates of devices.
+type CertificateAuthority interface {
+	Sign(csr []byte, device_uuid uuid.UUID) (models.Certificate, error)
+	CertificatePEM() []byte
 }
 
-func New(log *slog.Logger, storage StorageProvider) *Management {
+// AlertEvaluator evaluates the alert rules that depend on the reported
+// status, previous is the status before the ping.
+type AlertEvaluator interface {
+	DevicePinged(ctx context.Context, previous models.DeviceStatus, current models.DeviceStatus)
+}
+
+// ComplianceEvaluator evaluates the compliance of a device that has checked
+// in.
+type ComplianceEvaluator interface {
+	DevicePinged(ctx context.Context, device_uuid uuid.UUID)
+}
+
+// EventNotifier delivers the lifecycle events of devices. Errors are handled
+// by the notifier.
+type EventNotifier interface {
+	Notify(ctx context.Context, event models.Event)
+}
+
+// New creates the service, ca is nil if the server does not issue device
+// certificates. With requireToken devices register only with an enrollment
+// token or with their certificate.
+func New(
+	log *slog.Logger,
+	storage StorageProvider,
+	alerts AlertEvaluator,
+	compliance ComplianceEvaluator,
+	events EventNotifier,
+	ca CertificateAuthority,
+	requireToken bool,
+) *Management {
 	return &Management{
-		log:     log,
-		storage: storage,
-		states:  make(map[uuid.UUID]bool),
+		log:          log,
+		storage:      storage,
+		alerts:       alerts,
+		compliance:   compliance,
+		events:       events,
+		ca:           ca,
+		requireToken: requireToken,
+		sessions:     make(map[uuid.UUID]chan models.DeviceStateUpdate),
 	}
 }
 
-func (m *Management) DeviceRegister(ctx context.Context, device_uuid uuid.UUID, device_type models.DeviceType) error {
+// DeviceRegister stores the device together with the labels it reports. An
+// invalid label is reported with an error wrapping models.ErrInvalidLabel.
+//
+// A device registers with an enrollment token, which puts a new device into
+// the scope of the token. Unless the token is optional, a device without a
+// token registers again only if it is authenticated by its certificate.
+// An enrolled device registers with a token only if it is authenticated by
+// its certificate as well, otherwise any token would let a client obtain a
+// certificate of the device; it is refused with ErrDeviceEnrolled.
+// An enrolling device also passes a CSR and receives the issued
+// certificate, otherwise the returned certificate is empty.
+//
+// A device moved to pending enrolls again only with a token and gets a
+// fresh identity: the scope of the token and a new certificate. Suspended
+// and decommissioned devices are refused.
+//
+// The inventory, if the device reports it, is validated before the
+// registration and stored after it, see DeviceReportInventory.
+func (m *Management) DeviceRegister(
+	ctx context.Context,
+	device_uuid uuid.UUID,
+	device_type models.DeviceType,
+	labels map[string]string,
+	csr []byte,
+	token string,
+	authenticated bool,
+	inventory *models.Inventory,
+) (models.Certificate, error) {
 	const op = "Management.DeviceRegister"
 
 	log := m.log.With(
 		slog.String("op", op),
 		slog.String("uuid", device_uuid.String()),
 		slog.String("type", device_type.String()),
+		slog.Any("labels", labels),
 	)
 
 	log.Info("attempting to register device")
 
-	_, err := m.storage.RegisterDevice(ctx, device_uuid, device_type)
+	for key, value := range labels {
+		if err := models.ValidateLabel(key, value); err != nil {
+			return models.Certificate{}, fmt.Errorf("%s: %w", op, err)
+		}
+	}
+
+	if inventory != nil {
+		if err := models.ValidateInventory(*inventory); err != nil {
+			return models.Certificate{}, fmt.Errorf("%s: %w", op, err)
+		}
+	}
+
+	if token == "" && (len(csr) != 0 || m.requireToken && !authenticated) {
+		return models.Certificate{}, fmt.Errorf("%s: %w", op, ErrEnrollmentTokenRequired)
+	}
+
+	var (
+		cert       models.Certificate
+		token_hash string
+	)
+	if token != "" {
+		token_hash = models.TokenHash(token)
+	}
+
+	// устройство проверяется до подписи CSR, окончательно это повторяет
+	// хранилище в транзакции регистрации
+	if token != "" && !authenticated {
+		device, err := m.storage.Device(ctx, device_uuid)
+		if err != nil && !errors.Is(err, storage.ErrDeviceNotFound) {
+			return models.Certificate{}, fmt.Errorf("%s: %w", op, err)
+		}
+
+		if err == nil && device.Lifecycle == models.LifecycleEnrolled {
+			log.Warn("token registration of an enrolled device refused")
+
+			return models.Certificate{}, fmt.Errorf("%s: %w", op, ErrDeviceEnrolled)
+		}
+	}
+
+	// CSR проверяется до регистрации, чтобы ошибочный запрос не расходовал token
+	if len(csr) != 0 {
+		var err error
+		if cert, err = m.sign(csr, device_uuid); err != nil {
+			return models.Certificate{}, fmt.Errorf("%s: %w", op, err)
+		}
+	}
+
+	created, err := m.storage.RegisterDevice(ctx, device_uuid, device_type, labels, token_hash, authenticated)
+	if errors.Is(err, storage.ErrDevicePending) {
+		return models.Certificate{}, fmt.Errorf("%s: %w", op, ErrEnrollmentTokenRequired)
+	}
 	if err != nil {
-		return fmt.Errorf("%s: %w", op, err)
+		return models.Certificate{}, fmt.Errorf("%s: %w", op, certificateError(err))
 	}
 
-	log.Info("device registered successfully")
+	if cert.Serial != "" {
+		if err = m.storage.AddCertificate(ctx, cert); err != nil {
+			return models.Certificate{}, fmt.Errorf("%s: %w", op, err)
+		}
 
-	return nil
+		log.Info("device certificate issued", slog.String("serial", cert.Serial))
+	}
+
+	m.events.Notify(ctx, models.NewEvent(models.EventDeviceRegistered, device_uuid, models.DeviceRegisteredData{
+		DeviceType: device_type,
+		Labels:     labels,
+		First:      created,
+	}))
+
+	if inventory != nil {
+		if _, _, err = m.reportInventory(ctx, device_uuid, *inventory); err != nil {
+			return models.Certificate{}, fmt.Errorf("%s: %w", op, err)
+		}
+	}
+
+	log.Info("device registered successfully", slog.Bool("first", created))
+
+	return cert, nil
 }
 
-func (m *Management) DevicePing(ctx context.Context, device_uuid uuid.UUID, location string, battery int) (bool, error) {
+// DevicePing stores the device status together with the state revision the
+// device has applied. It reports whether the desired state differs from it.
+func (m *Management) DevicePing(
+	ctx context.Context,
+	device_uuid uuid.UUID,
+	location string,
+	battery int,
+	applied_revision int64,
+) (bool, error) {
 	const op = "Management.DevicePing"
 
 	log := m.log.With(
@@ -64,19 +257,40 @@ func (m *Management) DevicePing(ctx context.Context, device_uuid uuid.UUID, loca
 
 	log.Info("attempting to process a ping from the device")
 
-	err := m.storage.UpdateDeviceStatus(ctx, device_uuid, location, battery)
+	if err := m.admit(ctx, device_uuid); err != nil {
+		return false, fmt.Errorf("%s: %w", op, err)
+	}
+
+	previous, err := m.storage.DeviceStatus(ctx, device_uuid)
+	if err != nil {
+		return false, fmt.Errorf("%s: %w", op, err)
+	}
+
+	err = m.storage.UpdateDeviceStatus(ctx, device_uuid, location, battery)
 	if err != nil {
 		return false, fmt.Errorf("%s: %w", op, err)
 	}
 
-	log.Info("ping processed successfully")
+	current := previous
+	current.Location, current.Battery = location, battery
+	m.alerts.DevicePinged(ctx, previous, current)
+	m.statusEvents(ctx, previous, current)
 
-	stateChanged, ok := m.states[device_uuid]
-	if !ok {
-		return false, nil
+	revision, err := m.storage.ReportAppliedRevision(ctx, device_uuid, applied_revision)
+	if err != nil {
+		return false, fmt.Errorf("%s: %w", op, err)
 	}
+	m.revisionApplied(ctx, device_uuid, revision)
+
+	m.compliance.DevicePinged(ctx, device_uuid)
 
-	return stateChanged, nil
+	log.Info(
+		"ping processed successfully",
+		slog.Int64("desired_revision", revision.Desired),
+		slog.Int64("applied_revision", revision.Applied),
+	)
+
+	return revision.StateChanged(), nil
 }
 
 func (m *Management) DeviceState(ctx context.Context, device_uuid uuid.UUID) (models.DeviceFeatures, error) {
@@ -89,38 +303,267 @@ func (m *Management) DeviceState(ctx context.Context, device_uuid uuid.UUID) (mo
 
 	log.Info("attempt to prepare the state of the devices features")
 
+	if err := m.admit(ctx, device_uuid); err != nil {
+		return models.DeviceFeatures{}, fmt.Errorf("%s: %w", op, err)
+	}
+
 	features, err := m.storage.DeviceFeatures(ctx, device_uuid)
 	if err != nil {
 		return models.DeviceFeatures{}, fmt.Errorf("%s: %w", op, err)
 	}
 
-	delete(m.states, device_uuid)
+	if err = m.storage.UpdateDeviceStateFetch(ctx, device_uuid); err != nil {
+		return models.DeviceFeatures{}, fmt.Errorf("%s: %w", op, err)
+	}
 
 	log.Info("state of device features successfully prepared")
 
 	return features, nil
 }
 
-func (m *Management) SetDeviceFeatureState(ctx context.Context, device_uuid uuid.UUID, feature string, state bool) error {
+// DeviceReportState stores the feature values the device has applied and
+// the revision they correspond to.
+func (m *Management) DeviceReportState(
+	ctx context.Context,
+	device_uuid uuid.UUID,
+	values map[string]models.FeatureValue,
+	revision int64,
+) error {
+	const op = "Management.DeviceReportState"
+
+	log := m.log.With(
+		slog.String("op", op),
+		slog.String("uuid", device_uuid.String()),
+		slog.Int64("revision", revision),
+	)
+
+	log.Info("attempting to store the state reported by the device")
+
+	if err := m.admit(ctx, device_uuid); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := m.storage.ReportDeviceFeatures(ctx, device_uuid, values); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	applied, err := m.storage.ReportAppliedRevision(ctx, device_uuid, revision)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	m.revisionApplied(ctx, device_uuid, applied)
+
+	log.Info("reported state stored successfully")
+
+	return nil
+}
+
+func (m *Management) SetDeviceFeatureState(
+	ctx context.Context,
+	device_uuid uuid.UUID,
+	feature string,
+	value models.FeatureValue,
+) error {
 	const op = "Management.SetDeviceFeatureState"
 
 	log := m.log.With(
 		slog.String("op", op),
 		slog.String("uuid", device_uuid.String()),
 		slog.String("feature", feature),
-		slog.Bool("state", state),
+		slog.String("value", value.String()),
 	)
 
 	log.Info("attempt to change state device feature")
 
-	err := m.storage.UpdateDeviceFeature(ctx, device_uuid, feature, state)
+	// изменение и отправка выполняются под одной блокировкой, чтобы дельты
+	// приходили на устройство в порядке ревизий
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	revision, err := m.storage.UpdateDeviceFeature(ctx, device_uuid, feature, value)
 	if err != nil {
 		return fmt.Errorf("%s: %w", op, err)
 	}
 
-	m.states[device_uuid] = true
+	pushed := m.push(models.DeviceStateUpdate{
+		Features: models.DeviceFeatures{
+			DeviceUuid: device_uuid,
+			Features:   map[string]models.FeatureValue{feature: value},
+			Revision:   revision,
+		},
+	})
+	if pushed {
+		log.Info("feature change pushed to the connected device")
+	}
+
+	m.events.Notify(ctx, models.NewEvent(models.EventFeatureDesired, device_uuid, models.FeatureDesiredData{
+		Feature:  feature,
+		Value:    &value,
+		Revision: revision,
+	}))
+
+	log.Info("state of device feature successfully changed", slog.Int64("revision", revision))
+
+	return nil
+}
+
+// DevicesChanged pushes the full state to the connected devices whose desired
+// state was changed in bulk, for example by a catalog change. Devices without
+// a session pick the change up through the revision check on ping.
+func (m *Management) DevicesChanged(ctx context.Context, devices []uuid.UUID) error {
+	const op = "Management.DevicesChanged"
+
+	log := m.log.With(
+		slog.String("op", op),
+		slog.Int("count", len(devices)),
+	)
+
+	log.Info("attempting to push the changed state to devices")
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	pushed := 0
+	for _, device_uuid := range devices {
+		m.events.Notify(ctx, models.NewEvent(models.EventFeatureDesired, device_uuid, models.FeatureDesiredData{}))
+
+		if _, ok := m.sessions[device_uuid]; !ok {
+			continue
+		}
 
-	log.Info("state of device feature successfully changed")
+		features, err := m.storage.DeviceFeatures(ctx, device_uuid)
+		if err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+
+		if m.push(models.DeviceStateUpdate{Features: features, Full: true}) {
+			pushed++
+		}
+	}
+
+	log.Info("changed state pushed to devices", slog.Int("pushed", pushed))
 
 	return nil
 }
+
+// DeviceConnect opens a push session for the device. The current feature
+// state is delivered first, then every committed change as a delta. The
+// session is closed when ctx is done or when the device connects again, in
+// which case the returned channel is closed.
+func (m *Management) DeviceConnect(ctx context.Context, device_uuid uuid.UUID) (<-chan models.DeviceStateUpdate, error) {
+	const op = "Management.DeviceConnect"
+
+	log := m.log.With(
+		slog.String("op", op),
+		slog.String("uuid", device_uuid.String()),
+	)
+
+	log.Info("attempting to open device session")
+
+	if err := m.admit(ctx, device_uuid); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	features, err := m.storage.DeviceFeatures(ctx, device_uuid)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err = m.storage.UpdateDeviceStateFetch(ctx, device_uuid); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	updates := make(chan models.DeviceStateUpdate, sessionBuffer)
+	updates <- models.DeviceStateUpdate{Features: features, Full: true}
+
+	if old, ok := m.sessions[device_uuid]; ok {
+		close(old)
+	}
+	m.sessions[device_uuid] = updates
+
+	go func() {
+		<-ctx.Done()
+
+		m.mu.Lock()
+		defer m.mu.Unlock()
+
+		if m.sessions[device_uuid] == updates {
+			delete(m.sessions, device_uuid)
+			close(updates)
+		}
+
+		log.Info("device session closed")
+	}()
+
+	log.Info("device session opened", slog.Int64("revision", features.Revision))
+
+	return updates, nil
+}
+
+// revisionApplied raises the state applied event if the device has
+// acknowledged a newer revision than before.
+func (m *Management) revisionApplied(ctx context.Context, device_uuid uuid.UUID, revision models.DeviceRevision) {
+	if revision.Applied <= revision.Previous {
+		return
+	}
+
+	m.events.Notify(ctx, models.NewEvent(models.EventStateApplied, device_uuid, models.StateAppliedData{
+		Revision:        revision.Applied,
+		DesiredRevision: revision.Desired,
+	}))
+}
+
+// statusEvents raises the events of a ping: the ping itself and the changes
+// of the status and of the battery band since the previous ping.
+func (m *Management) statusEvents(ctx context.Context, previous models.DeviceStatus, current models.DeviceStatus) {
+	m.events.Notify(ctx, models.NewEvent(models.EventDevicePinged, current.DeviceUuid, models.DevicePingedData{
+		Location: current.Location,
+		Battery:  current.Battery,
+	}))
+
+	if !previous.Reported {
+		return
+	}
+
+	if previous.Location != current.Location || previous.Battery != current.Battery {
+		m.events.Notify(ctx, models.NewEvent(models.EventStatusChanged, current.DeviceUuid, models.StatusChangedData{
+			Location:         current.Location,
+			Battery:          current.Battery,
+			PreviousLocation: previous.Location,
+			PreviousBattery:  previous.Battery,
+		}))
+	}
+
+	if band, was := models.BatteryBandOf(current.Battery), models.BatteryBandOf(previous.Battery); band != was {
+		m.events.Notify(ctx, models.NewEvent(models.EventBatteryBandChanged, current.DeviceUuid, models.BatteryBandChangedData{
+			Battery:      current.Battery,
+			Band:         band,
+			PreviousBand: was,
+		}))
+	}
+}
+
+// push delivers a feature delta to the connected device, m.mu must be held.
+// It reports false if the device has no open session. A session whose queue
+// is full is dropped so that the device reconnects and receives the full
+// state; the revision check on ping covers the gap in between.
+func (m *Management) push(update models.DeviceStateUpdate) bool {
+	device_uuid := update.Features.DeviceUuid
+
+	updates, ok := m.sessions[device_uuid]
+	if !ok {
+		return false
+	}
+
+	select {
+	case updates <- update:
+		return true
+	default:
+		delete(m.sessions, device_uuid)
+		close(updates)
+		return false
+	}
+}
@@ -0,0 +1,219 @@
+package webhooksrv
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/dvaxert/mdm/internal/domain/models"
+)
+
+const (
+	// deliveryBatch ограничивает число доставок за один проход
+	deliveryBatch = 100
+
+	// deliveryRetention - время хранения завершенных доставок
+	deliveryRetention = 7 * 24 * time.Hour
+)
+
+// Webhooks queues the device events for the subscribed webhooks and
+// delivers them. Deliveries are stored, so events queued before a restart
+// are delivered after it.
+type Webhooks struct {
+	log     *slog.Logger
+	storage StorageProvider
+	client  *http.Client
+	policy  models.DeliveryPolicy
+	period  time.Duration
+
+	wake chan struct{}
+	stop chan struct{}
+	done chan struct{}
+}
+
+type StorageProvider interface {
+	EnqueueEvent(ctx context.Context, event models.Event, payload []byte) (int, error)
+	DueDeliveries(ctx context.Context, now time.Time, limit int) ([]models.WebhookDelivery, error)
+	CompleteDelivery(ctx context.Context, id int64) error
+	RetryDelivery(ctx context.Context, id int64, reason string, next time.Time) error
+	PruneDeliveries(ctx context.Context, before time.Time) (int64, error)
+}
+
+func New(
+	log *slog.Logger,
+	storage StorageProvider,
+	policy models.DeliveryPolicy,
+	period time.Duration,
+	timeout time.Duration,
+) *Webhooks {
+	return &Webhooks{
+		log:     log,
+		storage: storage,
+		client:  &http.Client{Timeout: timeout},
+		policy:  policy,
+		period:  period,
+		wake:    make(chan struct{}, 1),
+		stop:    make(chan struct{}),
+		done:    make(chan struct{}),
+	}
+}
+
+// Run delivers the queued events until Stop is called. Deliveries are
+// attempted every period and as soon as an event is queued.
+func (w *Webhooks) Run() {
+	defer close(w.done)
+
+	ticker := time.NewTicker(w.period)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			pruned, err := w.storage.PruneDeliveries(context.Background(), time.Now().Add(-deliveryRetention))
+			if err != nil {
+				w.log.Error("failed to prune webhook deliveries", slog.Any("error", err))
+			} else if pruned != 0 {
+				w.log.Info("webhook deliveries pruned", slog.Int64("deliveries", pruned))
+			}
+		case <-w.wake:
+		case <-w.stop:
+			return
+		}
+
+		if err := w.deliver(context.Background()); err != nil {
+			w.log.Error("failed to deliver webhooks", slog.Any("error", err))
+		}
+	}
+}
+
+// Stop stops Run and waits for the running deliveries to finish.
+func (w *Webhooks) Stop() {
+	close(w.stop)
+	<-w.done
+}
+
+// Notify queues the event for the subscribed webhooks, events of the types
+// not delivered to webhooks are ignored. Errors are logged, they must not
+// fail the operation that raised the event.
+func (w *Webhooks) Notify(ctx context.Context, event models.Event) {
+	const op = "Webhooks.Notify"
+
+	if !slices.Contains(models.WebhookEventTypes, event.Type) {
+		return
+	}
+
+	log := w.log.With(
+		slog.String("op", op),
+		slog.String("event", string(event.Type)),
+		slog.String("uuid", event.DeviceUuid.String()),
+	)
+
+	payload, err := json.Marshal(event)
+	if err != nil {
+		log.Error("failed to encode event", slog.Any("error", err))
+		return
+	}
+
+	queued, err := w.storage.EnqueueEvent(ctx, event, payload)
+	if err != nil {
+		log.Error("failed to queue event", slog.Any("error", err))
+		return
+	}
+
+	if queued == 0 {
+		return
+	}
+
+	select {
+	case w.wake <- struct{}{}:
+	default:
+	}
+}
+
+// deliver attempts the deliveries that are due.
+func (w *Webhooks) deliver(ctx context.Context) error {
+	const op = "Webhooks.deliver"
+
+	due, err := w.storage.DueDeliveries(ctx, time.Now(), deliveryBatch)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	for _, d := range due {
+		log := w.log.With(
+			slog.String("op", op),
+			slog.Int64("delivery", d.Id),
+			slog.String("event", string(d.EventType)),
+			slog.String("url", d.Url),
+		)
+
+		if err = w.send(ctx, d); err == nil {
+			if err = w.storage.CompleteDelivery(ctx, d.Id); err != nil {
+				return fmt.Errorf("%s: %w", op, err)
+			}
+
+			log.Info("webhook delivered")
+			continue
+		}
+
+		next := w.policy.Next(d.Attempts+1, time.Now())
+		if next.IsZero() {
+			log.Error("webhook delivery given up", slog.Int("attempts", d.Attempts+1), slog.Any("error", err))
+		} else {
+			log.Warn("webhook delivery failed", slog.Time("retry_at", next), slog.Any("error", err))
+		}
+
+		if err = w.storage.RetryDelivery(ctx, d.Id, err.Error(), next); err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+	}
+
+	return nil
+}
+
+// send posts the payload of the delivery. The X-Mdm-Signature header holds
+// the hex encoded HMAC-SHA256 of the X-Mdm-Timestamp value, a dot and the
+// body, keyed with the webhook secret.
+func (w *Webhooks) send(ctx context.Context, d models.WebhookDelivery) error {
+	timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+
+	mac := hmac.New(sha256.New, []byte(d.Secret))
+	mac.Write([]byte(timestamp + "."))
+	mac.Write(d.Payload)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, d.Url, bytes.NewReader(d.Payload))
+	if err != nil {
+		return err
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-Mdm-Event", string(d.EventType))
+	req.Header.Set("X-Mdm-Event-Id", d.EventId.String())
+	req.Header.Set("X-Mdm-Timestamp", timestamp)
+	req.Header.Set("X-Mdm-Signature", "sha256="+hex.EncodeToString(mac.Sum(nil)))
+
+	res, err := w.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	// тело ответа дочитывается, чтобы соединение можно было переиспользовать
+	io.Copy(io.Discard, io.LimitReader(res.Body, 64<<10))
+
+	if res.StatusCode < 200 || res.StatusCode >= 300 {
+		return fmt.Errorf("unexpected response status %s", res.Status)
+	}
+
+	return nil
+}
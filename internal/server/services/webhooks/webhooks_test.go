@@ -0,0 +1,249 @@
+package webhooksrv
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/dvaxert/mdm/internal/domain/models"
+	"github.com/google/uuid"
+)
+
+// fakeQueue keeps the deliveries in memory. Every pending delivery is due,
+// the tests check the retry time Webhooks asks for instead of waiting.
+type fakeQueue struct {
+	StorageProvider
+
+	mu         sync.Mutex
+	deliveries []*queued
+}
+
+type queued struct {
+	delivery models.WebhookDelivery
+	next     time.Time
+	reason   string
+	done     bool
+	givenUp  bool
+}
+
+func (q *fakeQueue) add(d models.WebhookDelivery) *queued {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	d.Id = int64(len(q.deliveries) + 1)
+	entry := &queued{delivery: d}
+	q.deliveries = append(q.deliveries, entry)
+
+	return entry
+}
+
+func (q *fakeQueue) DueDeliveries(ctx context.Context, now time.Time, limit int) ([]models.WebhookDelivery, error) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	var result []models.WebhookDelivery
+	for _, entry := range q.deliveries {
+		if !entry.done && !entry.givenUp {
+			result = append(result, entry.delivery)
+		}
+	}
+
+	return result, nil
+}
+
+func (q *fakeQueue) CompleteDelivery(ctx context.Context, id int64) error {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	q.deliveries[id-1].done = true
+
+	return nil
+}
+
+func (q *fakeQueue) RetryDelivery(ctx context.Context, id int64, reason string, next time.Time) error {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	entry := q.deliveries[id-1]
+	entry.delivery.Attempts++
+	entry.reason = reason
+	entry.next = next
+	entry.givenUp = next.IsZero()
+
+	return nil
+}
+
+func newWebhooks(queue *fakeQueue, policy models.DeliveryPolicy) *Webhooks {
+	return New(slog.New(slog.NewTextHandler(io.Discard, nil)), queue, policy, time.Hour, time.Second)
+}
+
+func testDelivery(url string) models.WebhookDelivery {
+	return models.WebhookDelivery{
+		EventId:   uuid.New(),
+		EventType: models.EventDeviceRegistered,
+		Payload:   []byte(`{"type":"device.registered"}`),
+		Url:       url,
+		Secret:    "s3cret",
+	}
+}
+
+func TestSendSignature(t *testing.T) {
+	type request struct {
+		header http.Header
+		body   []byte
+	}
+	requests := make(chan request, 1)
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		requests <- request{header: r.Header.Clone(), body: body}
+	}))
+	defer srv.Close()
+
+	queue := &fakeQueue{}
+	d := testDelivery(srv.URL)
+	entry := queue.add(d)
+
+	before := time.Now().Unix()
+	if err := newWebhooks(queue, models.DeliveryPolicy{MaxAttempts: 3}).deliver(context.Background()); err != nil {
+		t.Fatalf("deliver() error = %v", err)
+	}
+
+	if !entry.done {
+		t.Fatalf("delivery is not completed, reason %q", entry.reason)
+	}
+
+	req := <-requests
+	if string(req.body) != string(d.Payload) {
+		t.Errorf("body = %s, want %s", req.body, d.Payload)
+	}
+
+	for header, want := range map[string]string{
+		"Content-Type":   "application/json",
+		"X-Mdm-Event":    string(d.EventType),
+		"X-Mdm-Event-Id": d.EventId.String(),
+	} {
+		if got := req.header.Get(header); got != want {
+			t.Errorf("%s = %q, want %q", header, got, want)
+		}
+	}
+
+	timestamp := req.header.Get("X-Mdm-Timestamp")
+	sent, err := strconv.ParseInt(timestamp, 10, 64)
+	if err != nil || sent < before || sent > time.Now().Unix() {
+		t.Errorf("X-Mdm-Timestamp = %q, want the unix time of the request", timestamp)
+	}
+
+	mac := hmac.New(sha256.New, []byte(d.Secret))
+	mac.Write([]byte(timestamp + "." + string(req.body)))
+	want := "sha256=" + hex.EncodeToString(mac.Sum(nil))
+
+	if got := req.header.Get("X-Mdm-Signature"); !hmac.Equal([]byte(got), []byte(want)) {
+		t.Errorf("X-Mdm-Signature = %q, want %q", got, want)
+	}
+}
+
+func TestDeliverRetries(t *testing.T) {
+	var (
+		mu    sync.Mutex
+		calls int
+	)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		calls++
+		mu.Unlock()
+
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer srv.Close()
+
+	policy := models.DeliveryPolicy{MaxAttempts: 4, Backoff: time.Minute, MaxBackoff: 3 * time.Minute}
+
+	queue := &fakeQueue{}
+	entry := queue.add(testDelivery(srv.URL))
+	w := newWebhooks(queue, policy)
+
+	for attempt, want := range []time.Duration{time.Minute, 2 * time.Minute, 3 * time.Minute} {
+		before := time.Now()
+		if err := w.deliver(context.Background()); err != nil {
+			t.Fatalf("deliver() error = %v", err)
+		}
+		after := time.Now()
+
+		if entry.done || entry.givenUp {
+			t.Fatalf("attempt %d: done = %t, given up = %t, want a retry", attempt+1, entry.done, entry.givenUp)
+		}
+
+		if entry.next.Before(before.Add(want)) || entry.next.After(after.Add(want)) {
+			t.Errorf("attempt %d: retry in %s, want %s", attempt+1, entry.next.Sub(before), want)
+		}
+
+		if entry.reason == "" {
+			t.Errorf("attempt %d: no failure reason stored", attempt+1)
+		}
+	}
+
+	if err := w.deliver(context.Background()); err != nil {
+		t.Fatalf("deliver() error = %v", err)
+	}
+
+	if !entry.givenUp {
+		t.Fatalf("delivery is not given up after %d attempts", policy.MaxAttempts)
+	}
+
+	// отброшенная доставка больше не отправляется
+	if err := w.deliver(context.Background()); err != nil {
+		t.Fatalf("deliver() error = %v", err)
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+
+	if calls != policy.MaxAttempts {
+		t.Errorf("webhook called %d times, want %d", calls, policy.MaxAttempts)
+	}
+
+	if entry.delivery.Attempts != policy.MaxAttempts {
+		t.Errorf("attempts = %d, want %d", entry.delivery.Attempts, policy.MaxAttempts)
+	}
+}
+
+func TestDeliverRecovers(t *testing.T) {
+	var (
+		mu    sync.Mutex
+		calls int
+	)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		calls++
+		if calls == 1 {
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	}))
+	defer srv.Close()
+
+	queue := &fakeQueue{}
+	entry := queue.add(testDelivery(srv.URL))
+	w := newWebhooks(queue, models.DeliveryPolicy{MaxAttempts: 3, Backoff: time.Second, MaxBackoff: time.Second})
+
+	for i := 0; i < 2; i++ {
+		if err := w.deliver(context.Background()); err != nil {
+			t.Fatalf("deliver() error = %v", err)
+		}
+	}
+
+	if !entry.done || entry.givenUp {
+		t.Errorf("done = %t, given up = %t, want the delivery completed on the second attempt", entry.done, entry.givenUp)
+	}
+}
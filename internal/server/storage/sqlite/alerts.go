@@ -0,0 +1,442 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/dvaxert/mdm/internal/domain/models"
+	"github.com/dvaxert/mdm/internal/server/storage"
+	"github.com/google/uuid"
+)
+
+func (s *Storage) CreateAlertRule(ctx context.Context, rule models.AlertRule) error {
+	const op = "storage.sqlite.CreateAlertRule"
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	tx, err := s.db.Begin()
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	defer tx.Rollback()
+
+	var exists bool
+	err = tx.QueryRowContext(ctx, "SELECT EXISTS(SELECT 1 FROM alert_rules WHERE name = ?);", rule.Name).Scan(&exists)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if exists {
+		return fmt.Errorf("%s: %w", op, storage.ErrAlertRuleExists)
+	}
+
+	if err = insertAlertRule(ctx, tx, rule); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err = tx.Commit(); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+// DeleteAlertRule removes the rule together with its alerts.
+func (s *Storage) DeleteAlertRule(ctx context.Context, name string) error {
+	const op = "storage.sqlite.DeleteAlertRule"
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	tx, err := s.db.Begin()
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	defer tx.Rollback()
+
+	id, err := alertRuleId(ctx, tx, name)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err = deleteAlertRule(ctx, tx, id); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err = tx.Commit(); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+func (s *Storage) AlertRule(ctx context.Context, name string) (models.AlertRule, error) {
+	const op = "storage.sqlite.AlertRule"
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	rules, err := s.alertRules(ctx, "WHERE name = ?", name)
+	if err != nil {
+		return models.AlertRule{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if len(rules) == 0 {
+		return models.AlertRule{}, fmt.Errorf("%s: %w", op, storage.ErrAlertRuleNotFound)
+	}
+
+	return rules[0], nil
+}
+
+func (s *Storage) AlertRules(ctx context.Context) ([]models.AlertRule, error) {
+	const op = "storage.sqlite.AlertRules"
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	rules, err := s.alertRules(ctx, "")
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return rules, nil
+}
+
+// SyncConfigAlertRules makes the rules from the server config match the
+// given ones. Rules created through the Control API are kept, unless a
+// config rule takes their name.
+func (s *Storage) SyncConfigAlertRules(ctx context.Context, rules []models.AlertRule) error {
+	const op = "storage.sqlite.SyncConfigAlertRules"
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	tx, err := s.db.Begin()
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	defer tx.Rollback()
+
+	names := make([]any, 0, len(rules))
+	for _, rule := range rules {
+		names = append(names, rule.Name)
+	}
+
+	// удаляются правила, исчезнувшие из конфига, и правила API с именами из конфига
+	rows, err := tx.QueryContext(
+		ctx,
+		`SELECT id FROM alert_rules
+		 WHERE (from_config = 1 AND name NOT IN (`+placeholders(len(names))+`))
+			OR (from_config = 0 AND name IN (`+placeholders(len(names))+`));`,
+		append(names, names...)...,
+	)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	var stale []int64
+	for rows.Next() {
+		var id int64
+		if err = rows.Scan(&id); err != nil {
+			rows.Close()
+			return fmt.Errorf("%s: %w", op, err)
+		}
+
+		stale = append(stale, id)
+	}
+	rows.Close()
+
+	if err = rows.Err(); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	for _, id := range stale {
+		if err = deleteAlertRule(ctx, tx, id); err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+	}
+
+	for _, rule := range rules {
+		_, err = tx.ExecContext(
+			ctx,
+			`UPDATE alert_rules SET condition = ?, threshold = ?, duration = ?, feature = ?
+			 WHERE name = ?;`,
+			rule.Condition, rule.Threshold, int64(rule.Duration/time.Second), rule.Feature, rule.Name,
+		)
+		if err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+
+		_, err = tx.ExecContext(
+			ctx,
+			`INSERT OR IGNORE INTO alert_rules(name, condition, threshold, duration, feature, from_config)
+			 VALUES(?, ?, ?, ?, ?, 1);`,
+			rule.Name, rule.Condition, rule.Threshold, int64(rule.Duration/time.Second), rule.Feature,
+		)
+		if err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+// FireAlert raises an alert of the rule for the device. If the rule already
+// has a firing alert for the device, its count and message are updated
+// instead. It reports whether a new alert was raised.
+func (s *Storage) FireAlert(ctx context.Context, rule_id int64, device_uuid uuid.UUID, message string) (bool, error) {
+	const op = "storage.sqlite.FireAlert"
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	tx, err := s.db.Begin()
+	if err != nil {
+		return false, fmt.Errorf("%s: %w", op, err)
+	}
+	defer tx.Rollback()
+
+	device_id, err := deviceId(ctx, tx, device_uuid)
+	if err != nil {
+		return false, fmt.Errorf("%s: %w", op, err)
+	}
+
+	res, err := tx.ExecContext(
+		ctx,
+		`UPDATE alerts SET count = count + 1, message = ?, last_fired_at = unixepoch()
+		 WHERE rule_id = ? AND device_id = ? AND resolved_at IS NULL;`,
+		message, rule_id, device_id,
+	)
+	if err != nil {
+		return false, fmt.Errorf("%s: %w", op, err)
+	}
+
+	updated, err := res.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if updated == 0 {
+		_, err = tx.ExecContext(
+			ctx,
+			`INSERT INTO alerts(rule_id, device_id, message, fired_at, last_fired_at)
+			 VALUES(?, ?, ?, unixepoch(), unixepoch());`,
+			rule_id, device_id, message,
+		)
+		if err != nil {
+			return false, fmt.Errorf("%s: %w", op, err)
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		return false, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return updated == 0, nil
+}
+
+// ResolveAlert resolves the firing alert of the rule for the device. It
+// reports whether there was one.
+func (s *Storage) ResolveAlert(ctx context.Context, rule_id int64, device_uuid uuid.UUID) (bool, error) {
+	const op = "storage.sqlite.ResolveAlert"
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	res, err := s.db.ExecContext(
+		ctx,
+		`UPDATE alerts SET resolved_at = unixepoch()
+		 WHERE rule_id = ? AND resolved_at IS NULL
+			AND device_id = (SELECT id FROM devices WHERE uuid = ?);`,
+		rule_id, device_uuid,
+	)
+	if err != nil {
+		return false, fmt.Errorf("%s: %w", op, err)
+	}
+
+	n, err := res.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return n != 0, nil
+}
+
+// AlertList returns the alerts matching the filter, newest first.
+func (s *Storage) AlertList(ctx context.Context, filter models.AlertFilter) ([]models.Alert, error) {
+	const op = "storage.sqlite.AlertList"
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var (
+		conditions = []string{"1"}
+		args       []any
+	)
+
+	if filter.FiringOnly {
+		conditions = append(conditions, "a.resolved_at IS NULL")
+	}
+
+	if filter.Device != uuid.Nil {
+		conditions = append(conditions, "d.uuid = ?")
+		args = append(args, filter.Device)
+	}
+
+	if filter.Scope != nil {
+		condition, scope_args := scopeCondition(*filter.Scope)
+		conditions = append(conditions, condition)
+		args = append(args, scope_args...)
+	}
+
+	limit := filter.Limit
+	if limit <= 0 {
+		limit = -1
+	}
+
+	rows, err := s.db.QueryContext(
+		ctx,
+		`SELECT a.id, r.name, d.uuid, a.message, a.count, a.fired_at, a.last_fired_at, a.resolved_at, a.acknowledged_at
+		 FROM alerts AS a
+			JOIN alert_rules AS r
+			ON a.rule_id = r.id
+			JOIN devices AS d
+			ON a.device_id = d.id
+		 WHERE `+strings.Join(conditions, " AND ")+`
+		 ORDER BY a.fired_at DESC, a.id DESC
+		 LIMIT ?;`,
+		append(args, limit)...,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	var result []models.Alert
+	for rows.Next() {
+		var (
+			alert                  models.Alert
+			fired, last            int64
+			resolved, acknowledged sql.NullInt64
+		)
+		err = rows.Scan(
+			&alert.Id, &alert.Rule, &alert.DeviceUuid, &alert.Message, &alert.Count,
+			&fired, &last, &resolved, &acknowledged,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+
+		alert.FiredAt = time.Unix(fired, 0)
+		alert.LastFiredAt = time.Unix(last, 0)
+		alert.ResolvedAt = unixTime(resolved)
+		alert.AcknowledgedAt = unixTime(acknowledged)
+		alert.Firing = !resolved.Valid
+
+		result = append(result, alert)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return result, nil
+}
+
+// AcknowledgeAlert marks the alert as acknowledged, acknowledging it again
+// keeps the first acknowledgement time.
+func (s *Storage) AcknowledgeAlert(ctx context.Context, id int64) error {
+	const op = "storage.sqlite.AcknowledgeAlert"
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	res, err := s.db.ExecContext(
+		ctx,
+		"UPDATE alerts SET acknowledged_at = COALESCE(acknowledged_at, unixepoch()) WHERE id = ?;",
+		id,
+	)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	n, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if n == 0 {
+		return fmt.Errorf("%s: %w", op, storage.ErrAlertNotFound)
+	}
+
+	return nil
+}
+
+func (s *Storage) alertRules(ctx context.Context, where string, args ...any) ([]models.AlertRule, error) {
+	rows, err := s.db.QueryContext(
+		ctx,
+		"SELECT id, name, condition, threshold, duration, feature, from_config FROM alert_rules "+where+" ORDER BY name;",
+		args...,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var result []models.AlertRule
+	for rows.Next() {
+		var (
+			rule     models.AlertRule
+			duration int64
+		)
+		err = rows.Scan(&rule.Id, &rule.Name, &rule.Condition, &rule.Threshold, &duration, &rule.Feature, &rule.FromConfig)
+		if err != nil {
+			return nil, err
+		}
+		rule.Duration = time.Duration(duration) * time.Second
+
+		result = append(result, rule)
+	}
+
+	return result, rows.Err()
+}
+
+func insertAlertRule(ctx context.Context, tx *sql.Tx, rule models.AlertRule) error {
+	_, err := tx.ExecContext(
+		ctx,
+		`INSERT INTO alert_rules(name, condition, threshold, duration, feature, from_config)
+		 VALUES(?, ?, ?, ?, ?, ?);`,
+		rule.Name, rule.Condition, rule.Threshold, int64(rule.Duration/time.Second), rule.Feature, rule.FromConfig,
+	)
+
+	return err
+}
+
+func deleteAlertRule(ctx context.Context, tx *sql.Tx, id int64) error {
+	if _, err := tx.ExecContext(ctx, "DELETE FROM alerts WHERE rule_id = ?;", id); err != nil {
+		return err
+	}
+
+	_, err := tx.ExecContext(ctx, "DELETE FROM alert_rules WHERE id = ?;", id)
+
+	return err
+}
+
+func alertRuleId(ctx context.Context, tx *sql.Tx, name string) (int64, error) {
+	var id int64
+	err := tx.QueryRowContext(ctx, "SELECT id FROM alert_rules WHERE name = ?;", name).Scan(&id)
+	if errors.Is(err, sql.ErrNoRows) {
+		return 0, storage.ErrAlertRuleNotFound
+	}
+
+	return id, err
+}
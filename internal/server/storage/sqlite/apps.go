@@ -0,0 +1,592 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"maps"
+
+	"github.com/dvaxert/mdm/internal/domain/models"
+	"github.com/dvaxert/mdm/internal/server/storage"
+	"github.com/google/uuid"
+)
+
+// CreateApp adds the app to the catalog.
+func (s *Storage) CreateApp(ctx context.Context, app models.CatalogApp) error {
+	const op = "storage.sqlite.CreateApp"
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	res, err := s.db.ExecContext(
+		ctx,
+		"INSERT INTO apps(app_id, name, version) VALUES(?,?,?) ON CONFLICT(app_id) DO NOTHING;",
+		app.Id, app.Name, app.Version,
+	)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	inserted, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if inserted == 0 {
+		return fmt.Errorf("%s: %w", op, storage.ErrAppExists)
+	}
+
+	return nil
+}
+
+// DeleteApp removes the app from the catalog together with its rules. The
+// uuids of the devices whose desired state changed are returned.
+func (s *Storage) DeleteApp(ctx context.Context, app_id string) ([]uuid.UUID, error) {
+	const op = "storage.sqlite.DeleteApp"
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	tx, err := s.db.Begin()
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer tx.Rollback()
+
+	id, err := appId(ctx, tx, app_id)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	// политика устройства содержит приложение, если для него есть правило
+	devices, err := queryDevices(
+		ctx, tx,
+		`SELECT d.id, d.uuid
+		 FROM devices AS d
+			JOIN device_app_policy AS p
+			ON p.device_id = d.id
+		 WHERE p.app_id = ?;`,
+		id,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	for _, query := range []string{
+		"DELETE FROM profile_apps WHERE app_id = ?;",
+		"DELETE FROM device_group_apps WHERE app_id = ?;",
+	} {
+		if _, err = tx.ExecContext(ctx, query, id); err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+	}
+
+	changed, err := resolveDevices(ctx, tx, devices)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if _, err = tx.ExecContext(ctx, "DELETE FROM apps WHERE id = ?;", id); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return changed, nil
+}
+
+// AppList returns the catalog ordered by app id, with the rules of every app.
+func (s *Storage) AppList(ctx context.Context) ([]models.CatalogApp, error) {
+	const op = "storage.sqlite.AppList"
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	rows, err := s.db.QueryContext(ctx, "SELECT id, app_id, name, version FROM apps ORDER BY app_id;")
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	var result []models.CatalogApp
+	index := make(map[int64]int)
+	for rows.Next() {
+		var (
+			id  int64
+			app models.CatalogApp
+		)
+		if err = rows.Scan(&id, &app.Id, &app.Name, &app.Version); err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+
+		index[id] = len(result)
+		result = append(result, app)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	rules, err := s.db.QueryContext(
+		ctx,
+		`SELECT pa.app_id, pa.rule, p.name, ''
+		 FROM profile_apps AS pa
+			JOIN profiles AS p
+			ON pa.profile_id = p.id
+		 UNION ALL
+		 SELECT ga.app_id, ga.rule, '', g.name
+		 FROM device_group_apps AS ga
+			JOIN device_groups AS g
+			ON ga.group_id = g.id
+		 ORDER BY 3, 4;`,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rules.Close()
+
+	for rules.Next() {
+		var (
+			id     int64
+			target models.AppRuleTarget
+		)
+		if err = rules.Scan(&id, &target.Rule, &target.Profile, &target.Group); err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+
+		app := &result[index[id]]
+		app.Rules = append(app.Rules, target)
+	}
+
+	if err = rules.Err(); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return result, nil
+}
+
+// SetAppRule sets the rule for the app on the profile or on the group of the
+// target, replacing the previous one. The uuids of the devices whose desired
+// state changed are returned.
+func (s *Storage) SetAppRule(ctx context.Context, app_id string, target models.AppRuleTarget) ([]uuid.UUID, error) {
+	const op = "storage.sqlite.SetAppRule"
+
+	changed, err := s.updateAppRule(
+		ctx, app_id, target,
+		"INSERT INTO profile_apps(profile_id, app_id, rule) VALUES(?1,?2,?3) ON CONFLICT(profile_id, app_id) DO UPDATE SET rule = ?3;",
+		"INSERT INTO device_group_apps(group_id, app_id, rule) VALUES(?1,?2,?3) ON CONFLICT(group_id, app_id) DO UPDATE SET rule = ?3;",
+	)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return changed, nil
+}
+
+// ClearAppRule removes the rule for the app from the profile or the group of
+// the target. The uuids of the devices whose desired state changed are
+// returned.
+func (s *Storage) ClearAppRule(ctx context.Context, app_id string, target models.AppRuleTarget) ([]uuid.UUID, error) {
+	const op = "storage.sqlite.ClearAppRule"
+
+	changed, err := s.updateAppRule(
+		ctx, app_id, target,
+		"DELETE FROM profile_apps WHERE profile_id = ?1 AND app_id = ?2;",
+		"DELETE FROM device_group_apps WHERE group_id = ?1 AND app_id = ?2;",
+	)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return changed, nil
+}
+
+// updateAppRule runs the profile or the group query for the target, the
+// queries take the profile or group id, the app id and the rule. A query
+// that changes nothing is reported with storage.ErrAppRuleNotFound.
+func (s *Storage) updateAppRule(
+	ctx context.Context,
+	app_id string,
+	target models.AppRuleTarget,
+	profile_query string,
+	group_query string,
+) ([]uuid.UUID, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	tx, err := s.db.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	id, err := appId(ctx, tx, app_id)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		query     string
+		target_id int64
+		devices   map[int64]uuid.UUID
+	)
+	if target.Profile != "" {
+		if target_id, err = profileId(ctx, tx, target.Profile); err != nil {
+			return nil, err
+		}
+
+		query = profile_query
+		devices, err = profileDevices(ctx, tx, target_id)
+	} else {
+		if target_id, err = groupId(ctx, tx, target.Group); err != nil {
+			return nil, err
+		}
+
+		query = group_query
+		devices, err = groupMembers(ctx, tx, target_id)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := tx.ExecContext(ctx, query, target_id, id, target.Rule)
+	if err != nil {
+		return nil, err
+	}
+
+	updated, err := res.RowsAffected()
+	if err != nil {
+		return nil, err
+	}
+
+	if updated == 0 {
+		return nil, storage.ErrAppRuleNotFound
+	}
+
+	changed, err := resolveDevices(ctx, tx, devices)
+	if err != nil {
+		return nil, err
+	}
+
+	return changed, tx.Commit()
+}
+
+// DeviceAppCompliance checks the app policy of the device against the apps
+// of its latest inventory.
+func (s *Storage) DeviceAppCompliance(ctx context.Context, device_uuid uuid.UUID) (models.AppCompliance, error) {
+	const op = "storage.sqlite.DeviceAppCompliance"
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var id int64
+	err := s.db.QueryRowContext(ctx, "SELECT id FROM devices WHERE uuid = ?;", device_uuid).Scan(&id)
+	if errors.Is(err, sql.ErrNoRows) {
+		return models.AppCompliance{}, fmt.Errorf("%s: %w", op, storage.ErrDeviceNotFound)
+	}
+	if err != nil {
+		return models.AppCompliance{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	var result models.AppCompliance
+	err = s.db.QueryRowContext(
+		ctx,
+		"SELECT COALESCE(MAX(version), 0) FROM inventory_snapshots WHERE device_id = ?;",
+		id,
+	).Scan(&result.InventoryVersion)
+	if err != nil {
+		return models.AppCompliance{}, fmt.Errorf("%s: %w", op, err)
+	}
+	result.Reported = result.InventoryVersion != 0
+
+	policies, err := s.devicePolicies(ctx, placeholders(1), []any{id})
+	if err != nil {
+		return models.AppCompliance{}, fmt.Errorf("%s: %w", op, err)
+	}
+	result.Policy = policies[id]
+
+	if !result.Reported {
+		return result, nil
+	}
+
+	installed, err := s.installedApps(ctx, placeholders(1), []any{id})
+	if err != nil {
+		return models.AppCompliance{}, fmt.Errorf("%s: %w", op, err)
+	}
+	result.Violations = result.Policy.Violations(installed[id])
+
+	return result, nil
+}
+
+// AppViolationList returns a page of the devices matching the filter whose
+// latest inventory breaks their app policy, see models.AppPolicy. Devices
+// that have not reported their inventory are skipped.
+func (s *Storage) AppViolationList(
+	ctx context.Context,
+	filter models.DeviceFilter,
+	page models.Page,
+) ([]models.DeviceAppViolations, string, error) {
+	const op = "storage.sqlite.AppViolationList"
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	where, args := deviceFilter(filter)
+
+	// условие повторяет models.AppPolicy.Violations
+	where += ` AND EXISTS (SELECT 1 FROM inventory_snapshots WHERE device_id = d.id) AND (
+		EXISTS (
+			SELECT 1
+			FROM device_app_policy AS p
+				JOIN apps AS a
+				ON a.id = p.app_id
+			WHERE p.device_id = d.id AND p.rule = ?
+				AND NOT EXISTS (SELECT 1 FROM device_apps AS i WHERE i.device_id = d.id AND i.app_id = a.app_id)
+		)
+		OR EXISTS (
+			SELECT 1
+			FROM device_app_policy AS p
+				JOIN apps AS a
+				ON a.id = p.app_id
+				JOIN device_apps AS i
+				ON i.device_id = d.id AND i.app_id = a.app_id
+			WHERE p.device_id = d.id AND p.rule = ?
+		)
+		OR EXISTS (SELECT 1 FROM device_app_policy WHERE device_id = d.id AND rule = ?)
+			AND EXISTS (
+				SELECT 1
+				FROM device_apps AS i
+				WHERE i.device_id = d.id AND NOT EXISTS (
+					SELECT 1
+					FROM device_app_policy AS p
+						JOIN apps AS a
+						ON a.id = p.app_id
+					WHERE p.device_id = d.id AND a.app_id = i.app_id
+				)
+			)
+	)`
+	args = append(args, models.AppRequire, models.AppBlock, models.AppAllow)
+
+	devices, next, err := s.devicePage(ctx, where, args, page)
+	if err != nil {
+		return nil, "", fmt.Errorf("%s: %w", op, err)
+	}
+
+	result := make([]models.DeviceAppViolations, 0, len(devices))
+	if len(devices) == 0 {
+		return result, next, nil
+	}
+
+	in, ids := deviceIds(devices)
+
+	policies, err := s.devicePolicies(ctx, in, ids)
+	if err != nil {
+		return nil, "", fmt.Errorf("%s: %w", op, err)
+	}
+
+	installed, err := s.installedApps(ctx, in, ids)
+	if err != nil {
+		return nil, "", fmt.Errorf("%s: %w", op, err)
+	}
+
+	for _, d := range devices {
+		result = append(result, models.DeviceAppViolations{
+			DeviceId:   d.Id,
+			DeviceUuid: d.Uuid,
+			Violations: policies[d.Id].Violations(installed[d.Id]),
+		})
+	}
+
+	return result, next, nil
+}
+
+// devicePolicies returns the app policies of the devices whose ids are
+// passed with the placeholders.
+func (s *Storage) devicePolicies(ctx context.Context, in string, ids []any) (map[int64]models.AppPolicy, error) {
+	rows, err := s.db.QueryContext(
+		ctx,
+		`SELECT p.device_id, a.app_id, a.name, a.version, p.rule
+		 FROM device_app_policy AS p
+			JOIN apps AS a
+			ON a.id = p.app_id
+		 WHERE p.device_id IN (`+in+`)
+		 ORDER BY a.app_id;`,
+		ids...,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	result := make(map[int64]models.AppPolicy)
+	for rows.Next() {
+		var (
+			id    int64
+			entry models.AppPolicyEntry
+		)
+		if err = rows.Scan(&id, &entry.Id, &entry.Name, &entry.Version, &entry.Rule); err != nil {
+			return nil, err
+		}
+
+		result[id] = append(result[id], entry)
+	}
+
+	return result, rows.Err()
+}
+
+// installedApps returns the apps of the latest inventory of the devices
+// whose ids are passed with the placeholders.
+func (s *Storage) installedApps(ctx context.Context, in string, ids []any) (map[int64][]models.InstalledApp, error) {
+	rows, err := s.db.QueryContext(
+		ctx,
+		"SELECT device_id, app_id, name, version FROM device_apps WHERE device_id IN ("+in+") ORDER BY app_id;",
+		ids...,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	result := make(map[int64][]models.InstalledApp)
+	for rows.Next() {
+		var (
+			id  int64
+			app models.InstalledApp
+		)
+		if err = rows.Scan(&id, &app.Id, &app.Name, &app.Version); err != nil {
+			return nil, err
+		}
+
+		result[id] = append(result[id], app)
+	}
+
+	return result, rows.Err()
+}
+
+// resolveAppPolicy recomputes the app policy of the device from the rules of
+// its profiles and groups in the precedence of models.Group and reports
+// whether it changed.
+func resolveAppPolicy(ctx context.Context, tx *sql.Tx, device_id int64) (bool, error) {
+	rows, err := tx.QueryContext(
+		ctx,
+		`WITH RECURSIVE membership(group_id, distance) AS (
+			SELECT group_id, 1 FROM device_group_members WHERE device_id = ?1
+			UNION ALL
+			SELECT g.parent_id, m.distance + 1
+			FROM membership AS m
+				JOIN device_groups AS g
+				ON g.id = m.group_id
+			WHERE g.parent_id IS NOT NULL
+		 )
+		 SELECT app_id, rule FROM (
+			SELECT pa.app_id, pa.rule, 0 AS distance, '' AS group_name, 1 AS from_profile, p.name AS profile_name
+			FROM device_profiles AS dp
+				JOIN profiles AS p
+				ON dp.profile_id = p.id
+				JOIN profile_apps AS pa
+				ON pa.profile_id = p.id
+			WHERE dp.device_id = ?1
+			UNION ALL
+			SELECT ga.app_id, ga.rule, m.distance, g.name, 0, ''
+			FROM membership AS m
+				JOIN device_group_apps AS ga
+				ON ga.group_id = m.group_id
+				JOIN device_groups AS g
+				ON g.id = m.group_id
+			UNION ALL
+			SELECT pa.app_id, pa.rule, m.distance, g.name, 1, p.name
+			FROM membership AS m
+				JOIN device_groups AS g
+				ON g.id = m.group_id
+				JOIN device_group_profiles AS gp
+				ON gp.group_id = m.group_id
+				JOIN profiles AS p
+				ON gp.profile_id = p.id
+				JOIN profile_apps AS pa
+				ON pa.profile_id = p.id
+		 )
+		 ORDER BY distance, group_name, from_profile, profile_name;`,
+		device_id,
+	)
+	if err != nil {
+		return false, err
+	}
+	defer rows.Close()
+
+	resolved := make(map[int64]models.AppRule)
+	for rows.Next() {
+		var (
+			app_id int64
+			rule   models.AppRule
+		)
+		if err = rows.Scan(&app_id, &rule); err != nil {
+			return false, err
+		}
+
+		// строки упорядочены по приоритету, побеждает первое правило
+		if _, ok := resolved[app_id]; !ok {
+			resolved[app_id] = rule
+		}
+	}
+
+	if err = rows.Err(); err != nil {
+		return false, err
+	}
+
+	rows, err = tx.QueryContext(ctx, "SELECT app_id, rule FROM device_app_policy WHERE device_id = ?;", device_id)
+	if err != nil {
+		return false, err
+	}
+	defer rows.Close()
+
+	current := make(map[int64]models.AppRule)
+	for rows.Next() {
+		var (
+			app_id int64
+			rule   models.AppRule
+		)
+		if err = rows.Scan(&app_id, &rule); err != nil {
+			return false, err
+		}
+
+		current[app_id] = rule
+	}
+
+	if err = rows.Err(); err != nil {
+		return false, err
+	}
+
+	if maps.Equal(resolved, current) {
+		return false, nil
+	}
+
+	if _, err = tx.ExecContext(ctx, "DELETE FROM device_app_policy WHERE device_id = ?;", device_id); err != nil {
+		return false, err
+	}
+
+	for app_id, rule := range resolved {
+		_, err = tx.ExecContext(
+			ctx,
+			"INSERT INTO device_app_policy(device_id, app_id, rule) VALUES(?,?,?);",
+			device_id, app_id, rule,
+		)
+		if err != nil {
+			return false, err
+		}
+	}
+
+	return true, nil
+}
+
+func appId(ctx context.Context, tx *sql.Tx, app_id string) (int64, error) {
+	var id int64
+	err := tx.QueryRowContext(ctx, "SELECT id FROM apps WHERE app_id = ?;", app_id).Scan(&id)
+	if errors.Is(err, sql.ErrNoRows) {
+		return 0, storage.ErrAppNotFound
+	}
+
+	return id, err
+}
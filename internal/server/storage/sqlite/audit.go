@@ -0,0 +1,217 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/dvaxert/mdm/internal/domain/models"
+	"github.com/google/uuid"
+)
+
+// AppendAudit appends the record to the audit log, chaining it to the last
+// record, and returns the stored record.
+func (s *Storage) AppendAudit(ctx context.Context, record models.AuditRecord) (models.AuditRecord, error) {
+	const op = "storage.sqlite.AppendAudit"
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	tx, err := s.db.Begin()
+	if err != nil {
+		return models.AuditRecord{}, fmt.Errorf("%s: %w", op, err)
+	}
+	defer tx.Rollback()
+
+	var last int64
+	err = tx.QueryRowContext(ctx, "SELECT id, hash FROM audit_log ORDER BY id DESC LIMIT 1;").Scan(&last, &record.PrevHash)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return models.AuditRecord{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	// повторы устройств не хранятся, поэтому не входят и в хеш
+	devices := make([]uuid.UUID, 0, len(record.Devices))
+	for _, device := range record.Devices {
+		if !slices.Contains(devices, device) {
+			devices = append(devices, device)
+		}
+	}
+	record.Devices = devices
+
+	// номер входит в хеш, поэтому назначается до вставки
+	record.Id = last + 1
+	record.Time = time.Unix(record.Time.Unix(), 0)
+	record.Hash = record.ComputeHash()
+
+	_, err = tx.ExecContext(
+		ctx,
+		`INSERT INTO audit_log(id, time, actor, peer, method, group_name, payload, previous, new, result, error, prev_hash, hash)
+		 VALUES(?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?);`,
+		record.Id, record.Time.Unix(), record.Actor, record.Peer, record.Method, record.Group, record.Payload,
+		record.Previous, record.New, record.Result, record.Error, record.PrevHash, record.Hash,
+	)
+	if err != nil {
+		return models.AuditRecord{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	for _, device := range record.Devices {
+		_, err = tx.ExecContext(
+			ctx,
+			"INSERT INTO audit_devices(record_id, device_uuid) VALUES(?, ?);",
+			record.Id, device.String(),
+		)
+		if err != nil {
+			return models.AuditRecord{}, fmt.Errorf("%s: %w", op, err)
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		return models.AuditRecord{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return record, nil
+}
+
+// AuditList returns the audit records matching the filter, newest first.
+func (s *Storage) AuditList(ctx context.Context, filter models.AuditFilter) ([]models.AuditRecord, error) {
+	const op = "storage.sqlite.AuditList"
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	tx, err := s.db.Begin()
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer tx.Rollback()
+
+	var (
+		conditions = []string{"1"}
+		args       []any
+	)
+
+	if filter.Device != uuid.Nil {
+		conditions = append(conditions, "a.id IN (SELECT record_id FROM audit_devices WHERE device_uuid = ?)")
+		args = append(args, filter.Device.String())
+	}
+
+	if filter.Actor != "" {
+		conditions = append(conditions, "a.actor = ?")
+		args = append(args, filter.Actor)
+	}
+
+	if !filter.From.IsZero() {
+		conditions = append(conditions, "a.time >= ?")
+		args = append(args, filter.From.Unix())
+	}
+
+	if !filter.To.IsZero() {
+		conditions = append(conditions, "a.time <= ?")
+		args = append(args, filter.To.Unix())
+	}
+
+	limit := filter.Limit
+	if limit <= 0 {
+		limit = -1
+	}
+
+	result, err := auditRecords(
+		ctx,
+		tx,
+		"WHERE "+strings.Join(conditions, " AND ")+" ORDER BY a.id DESC LIMIT ?",
+		append(args, limit)...,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return result, nil
+}
+
+// AuditRecords returns up to limit audit records following the record
+// after, oldest first, to walk the whole chain.
+func (s *Storage) AuditRecords(ctx context.Context, after int64, limit int) ([]models.AuditRecord, error) {
+	const op = "storage.sqlite.AuditRecords"
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	tx, err := s.db.Begin()
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer tx.Rollback()
+
+	result, err := auditRecords(ctx, tx, "WHERE a.id > ? ORDER BY a.id LIMIT ?", after, limit)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return result, nil
+}
+
+func auditRecords(ctx context.Context, tx *sql.Tx, where string, args ...any) ([]models.AuditRecord, error) {
+	rows, err := tx.QueryContext(
+		ctx,
+		`SELECT a.id, a.time, a.actor, a.peer, a.method, a.group_name, a.payload,
+			a.previous, a.new, a.result, a.error, a.prev_hash, a.hash
+		 FROM audit_log AS a
+		 `+where+`;`,
+		args...,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var result []models.AuditRecord
+	for rows.Next() {
+		var (
+			record models.AuditRecord
+			at     int64
+		)
+		err = rows.Scan(
+			&record.Id, &at, &record.Actor, &record.Peer, &record.Method, &record.Group, &record.Payload,
+			&record.Previous, &record.New, &record.Result, &record.Error, &record.PrevHash, &record.Hash,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		record.Time = time.Unix(at, 0)
+
+		result = append(result, record)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	// устройства записи читаются в порядке добавления, как при расчете хеша
+	for i := range result {
+		devices, err := tx.QueryContext(ctx, "SELECT device_uuid FROM audit_devices WHERE record_id = ? ORDER BY rowid;", result[i].Id)
+		if err != nil {
+			return nil, err
+		}
+
+		for devices.Next() {
+			var device uuid.UUID
+			if err = devices.Scan(&device); err != nil {
+				devices.Close()
+				return nil, err
+			}
+
+			result[i].Devices = append(result[i].Devices, device)
+		}
+		devices.Close()
+
+		if err = devices.Err(); err != nil {
+			return nil, err
+		}
+	}
+
+	return result, nil
+}
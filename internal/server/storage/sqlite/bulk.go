@@ -0,0 +1,201 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strconv"
+
+	"github.com/dvaxert/mdm/internal/domain/models"
+	"github.com/dvaxert/mdm/internal/server/storage"
+	"github.com/google/uuid"
+)
+
+// BulkUpdateDeviceFeature sets the feature value on every device of the
+// target in a single transaction, as UpdateDeviceFeature does for one
+// device, and bumps the revision of the changed devices. Devices that
+// already have the value set on them are left as is, devices the feature
+// does not apply to are skipped. Retired and wiped devices are never
+// changed: the group and the selector do not select them, listed ones are
+// skipped. With dryRun the transaction is rolled
+// back, so the result is exactly what the change would do.
+func (s *Storage) BulkUpdateDeviceFeature(
+	ctx context.Context,
+	target models.BulkTarget,
+	feature string,
+	value models.FeatureValue,
+	dryRun bool,
+) (models.BulkFeatureResult, error) {
+	const op = "storage.sqlite.BulkUpdateDeviceFeature"
+
+	encoded, err := encodeValue(value)
+	if err != nil {
+		return models.BulkFeatureResult{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	tx, err := s.db.Begin()
+	if err != nil {
+		return models.BulkFeatureResult{}, fmt.Errorf("%s: %w", op, err)
+	}
+	defer tx.Rollback()
+
+	feature_id, err := featureId(ctx, tx, feature)
+	if err != nil {
+		return models.BulkFeatureResult{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	var value_type models.FeatureType
+	err = tx.QueryRowContext(ctx, "SELECT value_type FROM features WHERE id = ?;", feature_id).Scan(&value_type)
+	if err != nil {
+		return models.BulkFeatureResult{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	where, args, err := bulkTarget(ctx, tx, target)
+	if err != nil {
+		return models.BulkFeatureResult{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	rows, err := tx.QueryContext(
+		ctx,
+		`SELECT d.id, d.uuid, d.lifecycle, `+featureAppliesTo(strconv.FormatInt(feature_id, 10), "d.type")+`, df.value, df.overridden
+		 FROM devices AS d
+			LEFT JOIN device_features AS df
+			ON df.device_id = d.id AND df.feature_id = `+strconv.FormatInt(feature_id, 10)+`
+		 WHERE `+where+`
+		 ORDER BY d.uuid;`,
+		args...,
+	)
+	if err != nil {
+		return models.BulkFeatureResult{}, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	type device struct {
+		id     int64
+		change models.FeatureChange
+	}
+
+	var (
+		result  models.BulkFeatureResult
+		changed []device
+	)
+	for rows.Next() {
+		var (
+			d          device
+			lifecycle  models.Lifecycle
+			applies    bool
+			previous   sql.NullString
+			overridden sql.NullBool
+		)
+		if err = rows.Scan(&d.id, &d.change.DeviceUuid, &lifecycle, &applies, &previous, &overridden); err != nil {
+			return models.BulkFeatureResult{}, fmt.Errorf("%s: %w", op, err)
+		}
+
+		switch {
+		case !applies || lifecycle.Decommissioned():
+			result.Skipped = append(result.Skipped, d.change.DeviceUuid)
+			continue
+		case overridden.Bool && previous.String == encoded:
+			result.Unchanged = append(result.Unchanged, d.change.DeviceUuid)
+			continue
+		}
+
+		if previous.Valid {
+			v, err := decodeValue(value_type, previous.String)
+			if err != nil {
+				return models.BulkFeatureResult{}, fmt.Errorf("%s: %w", op, err)
+			}
+
+			d.change.Previous = &v
+			d.change.Overridden = overridden.Bool
+		}
+
+		changed = append(changed, d)
+	}
+
+	if err = rows.Err(); err != nil {
+		return models.BulkFeatureResult{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if len(target.Devices) != 0 {
+		found := len(result.Skipped) + len(result.Unchanged) + len(changed)
+		if found != len(uniqueDevices(target.Devices)) {
+			return models.BulkFeatureResult{}, fmt.Errorf("%s: %w", op, storage.ErrDeviceNotFound)
+		}
+	}
+
+	for _, d := range changed {
+		if err = setDeviceFeature(ctx, tx, d.id, feature_id, value, encoded); err != nil {
+			return models.BulkFeatureResult{}, fmt.Errorf("%s: %w", op, err)
+		}
+
+		if _, err = bumpRevision(ctx, tx, d.id); err != nil {
+			return models.BulkFeatureResult{}, fmt.Errorf("%s: %w", op, err)
+		}
+
+		result.Changes = append(result.Changes, d.change)
+	}
+
+	if dryRun {
+		return result, nil
+	}
+
+	if err = tx.Commit(); err != nil {
+		return models.BulkFeatureResult{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return result, nil
+}
+
+// bulkTarget returns the condition on the devices table aliased as d that
+// selects the devices of the target.
+func bulkTarget(ctx context.Context, tx *sql.Tx, target models.BulkTarget) (string, []any, error) {
+	switch {
+	case len(target.Devices) != 0:
+		devices := uniqueDevices(target.Devices)
+
+		args := make([]any, 0, len(devices))
+		for _, device_uuid := range devices {
+			args = append(args, device_uuid)
+		}
+
+		return "d.uuid IN (" + placeholders(len(args)) + ")", args, nil
+	case target.Group != "":
+		group_id, err := groupId(ctx, tx, target.Group)
+		if err != nil {
+			return "", nil, err
+		}
+
+		where, args := deviceFilter(models.DeviceFilter{})
+
+		return where + ` AND d.id IN (
+			WITH RECURSIVE subgroups(id) AS (
+				SELECT ?
+				UNION
+				SELECT g.id FROM device_groups AS g JOIN subgroups AS s ON g.parent_id = s.id
+			)
+			SELECT device_id FROM device_group_members WHERE group_id IN (SELECT id FROM subgroups)
+		)`, append(args, group_id), nil
+	}
+
+	where, args := deviceFilter(models.DeviceFilter{Selector: target.Selector})
+
+	return where, args, nil
+}
+
+func uniqueDevices(devices []uuid.UUID) []uuid.UUID {
+	seen := make(map[uuid.UUID]bool, len(devices))
+
+	result := make([]uuid.UUID, 0, len(devices))
+	for _, device_uuid := range devices {
+		if !seen[device_uuid] {
+			seen[device_uuid] = true
+			result = append(result, device_uuid)
+		}
+	}
+
+	return result
+}
@@ -0,0 +1,265 @@
+package sqlite
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/dvaxert/mdm/internal/domain/models"
+	"github.com/dvaxert/mdm/internal/server/storage"
+	"github.com/google/uuid"
+)
+
+// revisions returns the desired revision and the camera value of every
+// device.
+func revisions(t *testing.T, s *Storage, devices []uuid.UUID) map[uuid.UUID]string {
+	t.Helper()
+
+	result := make(map[uuid.UUID]string, len(devices))
+	for _, device_uuid := range devices {
+		features, err := s.DeviceFeatures(context.Background(), device_uuid)
+		if err != nil {
+			t.Fatalf("DeviceFeatures() error = %v", err)
+		}
+
+		result[device_uuid] = fmt.Sprintf("%d %s", features.Revision, features.Features["camera"])
+	}
+
+	return result
+}
+
+func sortedUuids(devices []uuid.UUID) []uuid.UUID {
+	result := slices.Clone(devices)
+	slices.SortFunc(result, func(a, b uuid.UUID) int { return strings.Compare(a.String(), b.String()) })
+
+	return result
+}
+
+func TestBulkUpdateDeviceFeatureTargets(t *testing.T) {
+	ctx := context.Background()
+	enabled := models.BoolValue(true)
+
+	s := newStorage(t)
+	devices := registerDevices(t, s, models.Ios, models.Android, models.Windows, models.Ios)
+
+	// первые три устройства в подгруппе, четвертое вне группы
+	if err := s.CreateGroup(ctx, "office", ""); err != nil {
+		t.Fatalf("CreateGroup() error = %v", err)
+	}
+	if err := s.CreateGroup(ctx, "floor", "office"); err != nil {
+		t.Fatalf("CreateGroup() error = %v", err)
+	}
+
+	for _, device_uuid := range devices[:3] {
+		if _, err := s.AddGroupDevice(ctx, "floor", device_uuid); err != nil {
+			t.Fatalf("AddGroupDevice() error = %v", err)
+		}
+		if err := s.UpdateDeviceLabels(ctx, device_uuid, map[string]string{"site": "oslo"}, nil); err != nil {
+			t.Fatalf("UpdateDeviceLabels() error = %v", err)
+		}
+	}
+
+	selector, err := models.ParseSelector("site=oslo")
+	if err != nil {
+		t.Fatalf("ParseSelector() error = %v", err)
+	}
+
+	want := sortedUuids(devices[:3])
+
+	for _, tt := range []struct {
+		name   string
+		target models.BulkTarget
+	}{
+		{name: "devices", target: models.BulkTarget{Devices: append(slices.Clone(devices[:3]), devices[0])}},
+		{name: "group", target: models.BulkTarget{Group: "office"}},
+		{name: "selector", target: models.BulkTarget{Selector: selector}},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := s.BulkUpdateDeviceFeature(ctx, tt.target, "camera", enabled, true)
+			if err != nil {
+				t.Fatalf("BulkUpdateDeviceFeature() error = %v", err)
+			}
+
+			if got := result.Changed(); !slices.Equal(got, want) {
+				t.Errorf("BulkUpdateDeviceFeature() changed %v, want %v", got, want)
+			}
+
+			if len(result.Unchanged) != 0 || len(result.Skipped) != 0 {
+				t.Errorf("BulkUpdateDeviceFeature() unchanged %v, skipped %v, want none", result.Unchanged, result.Skipped)
+			}
+		})
+	}
+}
+
+func TestBulkUpdateDeviceFeatureDryRun(t *testing.T) {
+	ctx := context.Background()
+	enabled := models.BoolValue(true)
+
+	s := newStorage(t)
+	devices := registerDevices(t, s, models.Ios, models.Android)
+	before := revisions(t, s, devices)
+
+	dry, err := s.BulkUpdateDeviceFeature(ctx, models.BulkTarget{Devices: devices}, "camera", enabled, true)
+	if err != nil {
+		t.Fatalf("BulkUpdateDeviceFeature() error = %v", err)
+	}
+
+	if after := revisions(t, s, devices); !maps.Equal(after, before) {
+		t.Errorf("dry run changed the devices: %v, was %v", after, before)
+	}
+
+	// настоящее изменение совпадает с пробным и меняет ревизию
+	applied, err := s.BulkUpdateDeviceFeature(ctx, models.BulkTarget{Devices: devices}, "camera", enabled, false)
+	if err != nil {
+		t.Fatalf("BulkUpdateDeviceFeature() error = %v", err)
+	}
+
+	if !slices.Equal(applied.Changed(), dry.Changed()) {
+		t.Errorf("changed %v, dry run reported %v", applied.Changed(), dry.Changed())
+	}
+
+	after := revisions(t, s, devices)
+	for _, device_uuid := range devices {
+		if after[device_uuid] == before[device_uuid] {
+			t.Errorf("device %s is not changed: %s", device_uuid, after[device_uuid])
+		}
+	}
+
+	// повторное изменение ничего не меняет
+	again, err := s.BulkUpdateDeviceFeature(ctx, models.BulkTarget{Devices: devices}, "camera", enabled, false)
+	if err != nil {
+		t.Fatalf("BulkUpdateDeviceFeature() error = %v", err)
+	}
+
+	if len(again.Changes) != 0 || len(again.Unchanged) != len(devices) {
+		t.Errorf("repeated change: changed %v, unchanged %v", again.Changed(), again.Unchanged)
+	}
+
+	if got := revisions(t, s, devices); !maps.Equal(got, after) {
+		t.Errorf("repeated change bumped the revisions: %v, was %v", got, after)
+	}
+}
+
+func TestBulkUpdateDeviceFeatureDecommissioned(t *testing.T) {
+	ctx := context.Background()
+
+	s := newStorage(t)
+	devices := registerDevices(t, s, models.Ios, models.Ios, models.Ios)
+
+	if _, err := s.SetDeviceLifecycle(ctx, devices[1], models.LifecycleRetired); err != nil {
+		t.Fatalf("SetDeviceLifecycle() error = %v", err)
+	}
+	if _, err := s.SetDeviceLifecycle(ctx, devices[2], models.LifecycleWiped); err != nil {
+		t.Fatalf("SetDeviceLifecycle() error = %v", err)
+	}
+
+	before := revisions(t, s, devices)
+
+	result, err := s.BulkUpdateDeviceFeature(ctx, models.BulkTarget{Devices: devices}, "camera", models.BoolValue(true), false)
+	if err != nil {
+		t.Fatalf("BulkUpdateDeviceFeature() error = %v", err)
+	}
+
+	if got := result.Changed(); !slices.Equal(got, devices[:1]) {
+		t.Errorf("changed %v, want %v", got, devices[:1])
+	}
+
+	if got, want := result.Skipped, sortedUuids(devices[1:]); !slices.Equal(got, want) {
+		t.Errorf("skipped %v, want %v", got, want)
+	}
+
+	after := revisions(t, s, devices)
+	for _, device_uuid := range devices[1:] {
+		if after[device_uuid] != before[device_uuid] {
+			t.Errorf("decommissioned device %s is changed: %s, was %s", device_uuid, after[device_uuid], before[device_uuid])
+		}
+	}
+}
+
+func TestBulkUpdateDeviceFeatureFailure(t *testing.T) {
+	ctx := context.Background()
+	enabled := models.BoolValue(true)
+
+	tests := []struct {
+		name    string
+		prepare func(t *testing.T, s *Storage, devices []uuid.UUID) models.BulkTarget
+		feature string
+		wantErr error
+	}{
+		{
+			name: "unknown device",
+			prepare: func(t *testing.T, s *Storage, devices []uuid.UUID) models.BulkTarget {
+				return models.BulkTarget{Devices: append(slices.Clone(devices), uuid.New())}
+			},
+			feature: "camera",
+			wantErr: storage.ErrDeviceNotFound,
+		},
+		{
+			name: "unknown feature",
+			prepare: func(t *testing.T, s *Storage, devices []uuid.UUID) models.BulkTarget {
+				return models.BulkTarget{Devices: devices}
+			},
+			feature: "missing",
+			wantErr: storage.ErrFeatureNotFound,
+		},
+		{
+			name: "unknown group",
+			prepare: func(t *testing.T, s *Storage, devices []uuid.UUID) models.BulkTarget {
+				return models.BulkTarget{Group: "missing"}
+			},
+			feature: "camera",
+			wantErr: storage.ErrGroupNotFound,
+		},
+		{
+			// запись ревизии последнего устройства падает, когда первые
+			// уже изменены в транзакции
+			name: "last device fails",
+			prepare: func(t *testing.T, s *Storage, devices []uuid.UUID) models.BulkTarget {
+				last := sortedUuids(devices)[len(devices)-1]
+
+				var device_id int64
+				if err := s.db.QueryRow("SELECT id FROM devices WHERE uuid = ?;", last).Scan(&device_id); err != nil {
+					t.Fatalf("device id: %v", err)
+				}
+
+				for _, event := range []string{"INSERT", "UPDATE"} {
+					_, err := s.db.Exec(fmt.Sprintf(
+						`CREATE TRIGGER fail_%[1]s BEFORE %[1]s ON device_revisions
+						 WHEN NEW.device_id = %[2]d
+						 BEGIN SELECT RAISE(ABORT, 'revision is not written'); END;`,
+						event, device_id,
+					))
+					if err != nil {
+						t.Fatalf("create trigger: %v", err)
+					}
+				}
+
+				return models.BulkTarget{Devices: devices}
+			},
+			feature: "camera",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := newStorage(t)
+			devices := registerDevices(t, s, models.Ios, models.Android, models.Windows)
+
+			target := tt.prepare(t, s, devices)
+			before := revisions(t, s, devices)
+
+			_, err := s.BulkUpdateDeviceFeature(ctx, target, tt.feature, enabled, false)
+			if err == nil || (tt.wantErr != nil && !errors.Is(err, tt.wantErr)) {
+				t.Fatalf("BulkUpdateDeviceFeature() error = %v, want %v", err, tt.wantErr)
+			}
+
+			if after := revisions(t, s, devices); !maps.Equal(after, before) {
+				t.Errorf("failed change is applied: %v, was %v", after, before)
+			}
+		})
+	}
+}
@@ -0,0 +1,331 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/dvaxert/mdm/internal/domain/models"
+	"github.com/dvaxert/mdm/internal/server/storage"
+	"github.com/google/uuid"
+)
+
+// commandColumns are the columns read by commands, the command table is
+// aliased as c and the device table as d.
+const commandColumns = `c.id, d.uuid, c.type, c.payload, c.status, c.result,
+	c.created_at, c.delivered_at, c.acknowledged_at, c.completed_at, c.expires_at`
+
+// CreateCommand queues the command for the device and returns its id.
+func (s *Storage) CreateCommand(ctx context.Context, command models.Command) (int64, error) {
+	const op = "storage.sqlite.CreateCommand"
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	tx, err := s.db.Begin()
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+	defer tx.Rollback()
+
+	device_id, err := deviceId(ctx, tx, command.DeviceUuid)
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	var id int64
+	err = tx.QueryRowContext(
+		ctx,
+		`INSERT INTO commands(device_id, type, payload, status, created_at, expires_at)
+		 VALUES(?, ?, ?, ?, unixepoch(), ?)
+		 RETURNING id;`,
+		device_id, command.Type, command.Payload, models.CommandQueued, command.ExpiresAt.Unix(),
+	).Scan(&id)
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err = tx.Commit(); err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return id, nil
+}
+
+func (s *Storage) Command(ctx context.Context, id int64) (models.Command, error) {
+	const op = "storage.sqlite.Command"
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	tx, err := s.db.Begin()
+	if err != nil {
+		return models.Command{}, fmt.Errorf("%s: %w", op, err)
+	}
+	defer tx.Rollback()
+
+	if err = expireCommands(ctx, tx); err != nil {
+		return models.Command{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	list, err := commands(ctx, tx, "WHERE c.id = ?", id)
+	if err != nil {
+		return models.Command{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if len(list) == 0 {
+		return models.Command{}, fmt.Errorf("%s: %w", op, storage.ErrCommandNotFound)
+	}
+
+	if err = tx.Commit(); err != nil {
+		return models.Command{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return list[0], nil
+}
+
+// CommandList returns the commands matching the filter, newest first.
+func (s *Storage) CommandList(ctx context.Context, filter models.CommandFilter) ([]models.Command, error) {
+	const op = "storage.sqlite.CommandList"
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	tx, err := s.db.Begin()
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer tx.Rollback()
+
+	if err = expireCommands(ctx, tx); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	var (
+		conditions = []string{"1"}
+		args       []any
+	)
+
+	if filter.PendingOnly {
+		conditions = append(conditions, "c.status < ?")
+		args = append(args, models.CommandSucceeded)
+	}
+
+	if filter.Device != uuid.Nil {
+		conditions = append(conditions, "d.uuid = ?")
+		args = append(args, filter.Device)
+	}
+
+	if filter.Scope != nil {
+		condition, scope_args := scopeCondition(*filter.Scope)
+		conditions = append(conditions, condition)
+		args = append(args, scope_args...)
+	}
+
+	limit := filter.Limit
+	if limit <= 0 {
+		limit = -1
+	}
+
+	result, err := commands(
+		ctx,
+		tx,
+		"WHERE "+strings.Join(conditions, " AND ")+" ORDER BY c.id DESC LIMIT ?",
+		append(args, limit)...,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return result, nil
+}
+
+// DeliverCommands marks the queued commands of the device as delivered and
+// returns the commands the device has not acknowledged yet, oldest first.
+// Delivered commands are returned again until the device acknowledges them,
+// in case the previous response was lost.
+func (s *Storage) DeliverCommands(ctx context.Context, device_uuid uuid.UUID) ([]models.Command, error) {
+	const op = "storage.sqlite.DeliverCommands"
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	tx, err := s.db.Begin()
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer tx.Rollback()
+
+	device_id, err := deviceId(ctx, tx, device_uuid)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err = expireCommands(ctx, tx); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	_, err = tx.ExecContext(
+		ctx,
+		"UPDATE commands SET status = ?, delivered_at = unixepoch() WHERE device_id = ? AND status = ?;",
+		models.CommandDelivered, device_id, models.CommandQueued,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	result, err := commands(ctx, tx, "WHERE c.device_id = ? AND c.status = ? ORDER BY c.id", device_id, models.CommandDelivered)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return result, nil
+}
+
+// ReportCommand moves the command of the device to the reported status and
+// returns the updated command, result is kept for the final statuses.
+// Acknowledging a command again is ignored. A command that has already
+// completed can not be changed.
+func (s *Storage) ReportCommand(
+	ctx context.Context,
+	device_uuid uuid.UUID,
+	id int64,
+	status models.CommandStatus,
+	result string,
+) (models.Command, error) {
+	const op = "storage.sqlite.ReportCommand"
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	tx, err := s.db.Begin()
+	if err != nil {
+		return models.Command{}, fmt.Errorf("%s: %w", op, err)
+	}
+	defer tx.Rollback()
+
+	if err = expireCommands(ctx, tx); err != nil {
+		return models.Command{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	var current models.CommandStatus
+	err = tx.QueryRowContext(
+		ctx,
+		`SELECT c.status FROM commands AS c
+			JOIN devices AS d
+			ON c.device_id = d.id
+		 WHERE c.id = ? AND d.uuid = ?;`,
+		id, device_uuid,
+	).Scan(&current)
+	if errors.Is(err, sql.ErrNoRows) {
+		return models.Command{}, fmt.Errorf("%s: %w", op, storage.ErrCommandNotFound)
+	}
+	if err != nil {
+		return models.Command{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if current.Completed() {
+		return models.Command{}, fmt.Errorf("%s: %w", op, storage.ErrCommandCompleted)
+	}
+
+	switch status {
+	case models.CommandAcknowledged:
+		_, err = tx.ExecContext(
+			ctx,
+			`UPDATE commands SET status = ?,
+				delivered_at = COALESCE(delivered_at, unixepoch()),
+				acknowledged_at = COALESCE(acknowledged_at, unixepoch())
+			 WHERE id = ?;`,
+			status, id,
+		)
+	default:
+		_, err = tx.ExecContext(
+			ctx,
+			`UPDATE commands SET status = ?, result = ?,
+				delivered_at = COALESCE(delivered_at, unixepoch()),
+				completed_at = unixepoch()
+			 WHERE id = ?;`,
+			status, result, id,
+		)
+	}
+	if err != nil {
+		return models.Command{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	list, err := commands(ctx, tx, "WHERE c.id = ?", id)
+	if err != nil {
+		return models.Command{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err = tx.Commit(); err != nil {
+		return models.Command{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return list[0], nil
+}
+
+// expireCommands expires the commands that have not completed before their
+// deadline. Commands expire when they are read, so the stored status is
+// up to date for every reader.
+func expireCommands(ctx context.Context, tx *sql.Tx) error {
+	_, err := tx.ExecContext(
+		ctx,
+		"UPDATE commands SET status = ?, completed_at = expires_at WHERE status < ? AND expires_at <= unixepoch();",
+		models.CommandExpired, models.CommandSucceeded,
+	)
+
+	return err
+}
+
+func commands(ctx context.Context, tx *sql.Tx, where string, args ...any) ([]models.Command, error) {
+	rows, err := tx.QueryContext(
+		ctx,
+		`SELECT `+commandColumns+`
+		 FROM commands AS c
+			JOIN devices AS d
+			ON c.device_id = d.id
+		 `+where+`;`,
+		args...,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var result []models.Command
+	for rows.Next() {
+		var (
+			command                         models.Command
+			created, expires                int64
+			delivered, acknowledged, closed sql.NullInt64
+		)
+		err = rows.Scan(
+			&command.Id, &command.DeviceUuid, &command.Type, &command.Payload, &command.Status, &command.Result,
+			&created, &delivered, &acknowledged, &closed, &expires,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		command.CreatedAt = time.Unix(created, 0)
+		command.DeliveredAt = unixTime(delivered)
+		command.AcknowledgedAt = unixTime(acknowledged)
+		command.CompletedAt = unixTime(closed)
+		command.ExpiresAt = time.Unix(expires, 0)
+
+		result = append(result, command)
+	}
+
+	return result, rows.Err()
+}
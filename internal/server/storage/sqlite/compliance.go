@@ -0,0 +1,545 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/dvaxert/mdm/internal/domain/models"
+	"github.com/dvaxert/mdm/internal/server/storage"
+	"github.com/google/uuid"
+)
+
+func (s *Storage) CreateComplianceRule(ctx context.Context, rule models.ComplianceRule) error {
+	const op = "storage.sqlite.CreateComplianceRule"
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	tx, err := s.db.Begin()
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	defer tx.Rollback()
+
+	var exists bool
+	err = tx.QueryRowContext(ctx, "SELECT EXISTS(SELECT 1 FROM compliance_rules WHERE name = ?);", rule.Name).Scan(&exists)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if exists {
+		return fmt.Errorf("%s: %w", op, storage.ErrComplianceRuleExists)
+	}
+
+	_, err = tx.ExecContext(
+		ctx,
+		`INSERT INTO compliance_rules(name, condition, os_name, version, feature, threshold, duration, selector)
+		 VALUES(?, ?, ?, ?, ?, ?, ?, ?);`,
+		rule.Name, rule.Condition, rule.OsName, rule.Version, rule.Feature, rule.Threshold,
+		int64(rule.Duration/time.Second), rule.Selector,
+	)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err = tx.Commit(); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+// DeleteComplianceRule removes the rule, the reasons it has produced stay in
+// the compliance history.
+func (s *Storage) DeleteComplianceRule(ctx context.Context, name string) error {
+	const op = "storage.sqlite.DeleteComplianceRule"
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	res, err := s.db.ExecContext(ctx, "DELETE FROM compliance_rules WHERE name = ?;", name)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if affected == 0 {
+		return fmt.Errorf("%s: %w", op, storage.ErrComplianceRuleNotFound)
+	}
+
+	return nil
+}
+
+func (s *Storage) ComplianceRules(ctx context.Context) ([]models.ComplianceRule, error) {
+	const op = "storage.sqlite.ComplianceRules"
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	rows, err := s.db.QueryContext(
+		ctx,
+		`SELECT id, name, condition, os_name, version, feature, threshold, duration, selector
+		 FROM compliance_rules ORDER BY name;`,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	var result []models.ComplianceRule
+	for rows.Next() {
+		var (
+			rule     models.ComplianceRule
+			duration int64
+		)
+		err = rows.Scan(
+			&rule.Id, &rule.Name, &rule.Condition, &rule.OsName, &rule.Version,
+			&rule.Feature, &rule.Threshold, &duration, &rule.Selector,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		rule.Duration = time.Duration(duration) * time.Second
+
+		result = append(result, rule)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return result, nil
+}
+
+// DeviceComplianceInput returns the data the compliance rules are evaluated
+// against for the device.
+func (s *Storage) DeviceComplianceInput(ctx context.Context, device_uuid uuid.UUID) (models.ComplianceInput, error) {
+	const op = "storage.sqlite.DeviceComplianceInput"
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	inputs, err := s.complianceInputs(ctx, "d.uuid = ?", []any{device_uuid})
+	if err != nil {
+		return models.ComplianceInput{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if len(inputs) == 0 {
+		return models.ComplianceInput{}, fmt.Errorf("%s: %w", op, storage.ErrDeviceNotFound)
+	}
+
+	return inputs[0], nil
+}
+
+// ComplianceInputs returns the data the compliance rules are evaluated
+// against for all devices in service.
+func (s *Storage) ComplianceInputs(ctx context.Context) ([]models.ComplianceInput, error) {
+	const op = "storage.sqlite.ComplianceInputs"
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	where, args := deviceFilter(models.DeviceFilter{})
+
+	inputs, err := s.complianceInputs(ctx, where, args)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return inputs, nil
+}
+
+// SaveDeviceCompliance stores the result of the evaluation of the device. A
+// history record is added if the status or the reasons differ from the
+// previous result. It returns the previous result and whether a record was
+// added.
+func (s *Storage) SaveDeviceCompliance(
+	ctx context.Context,
+	device_uuid uuid.UUID,
+	status models.ComplianceStatus,
+	reasons []models.ComplianceReason,
+) (models.DeviceCompliance, bool, error) {
+	const op = "storage.sqlite.SaveDeviceCompliance"
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	tx, err := s.db.Begin()
+	if err != nil {
+		return models.DeviceCompliance{}, false, fmt.Errorf("%s: %w", op, err)
+	}
+	defer tx.Rollback()
+
+	id, err := deviceId(ctx, tx, device_uuid)
+	if err != nil {
+		return models.DeviceCompliance{}, false, fmt.Errorf("%s: %w", op, err)
+	}
+
+	previous, history_id, err := deviceCompliance(ctx, tx, id)
+	if err != nil {
+		return models.DeviceCompliance{}, false, fmt.Errorf("%s: %w", op, err)
+	}
+	previous.DeviceUuid = device_uuid
+
+	changed := history_id == 0 || previous.Status != status || !slices.Equal(previous.Reasons, reasons)
+	if changed {
+		err = tx.QueryRowContext(
+			ctx,
+			"INSERT INTO compliance_history(device_id, status, recorded_at) VALUES(?, ?, unixepoch()) RETURNING id;",
+			id, status,
+		).Scan(&history_id)
+		if err != nil {
+			return models.DeviceCompliance{}, false, fmt.Errorf("%s: %w", op, err)
+		}
+
+		for _, r := range reasons {
+			_, err = tx.ExecContext(
+				ctx,
+				"INSERT INTO compliance_reasons(history_id, rule, condition, message) VALUES(?, ?, ?, ?);",
+				history_id, r.Rule, r.Condition, r.Message,
+			)
+			if err != nil {
+				return models.DeviceCompliance{}, false, fmt.Errorf("%s: %w", op, err)
+			}
+		}
+	}
+
+	_, err = tx.ExecContext(
+		ctx,
+		"INSERT OR REPLACE INTO device_compliance(device_id, history_id, evaluated_at) VALUES(?, ?, unixepoch());",
+		id, history_id,
+	)
+	if err != nil {
+		return models.DeviceCompliance{}, false, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err = tx.Commit(); err != nil {
+		return models.DeviceCompliance{}, false, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return previous, changed, nil
+}
+
+// DeviceCompliance returns the latest compliance result of the device, the
+// status is unknown if the device has not been evaluated yet.
+func (s *Storage) DeviceCompliance(ctx context.Context, device_uuid uuid.UUID) (models.DeviceCompliance, error) {
+	const op = "storage.sqlite.DeviceCompliance"
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	tx, err := s.db.Begin()
+	if err != nil {
+		return models.DeviceCompliance{}, fmt.Errorf("%s: %w", op, err)
+	}
+	defer tx.Rollback()
+
+	id, err := deviceId(ctx, tx, device_uuid)
+	if err != nil {
+		return models.DeviceCompliance{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	compliance, _, err := deviceCompliance(ctx, tx, id)
+	if err != nil {
+		return models.DeviceCompliance{}, fmt.Errorf("%s: %w", op, err)
+	}
+	compliance.DeviceUuid = device_uuid
+
+	return compliance, nil
+}
+
+// ComplianceHistory returns the compliance history of the device, newest
+// first. Zero limit returns the whole history.
+func (s *Storage) ComplianceHistory(ctx context.Context, device_uuid uuid.UUID, limit int) ([]models.ComplianceRecord, error) {
+	const op = "storage.sqlite.ComplianceHistory"
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	tx, err := s.db.Begin()
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer tx.Rollback()
+
+	id, err := deviceId(ctx, tx, device_uuid)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if limit <= 0 {
+		limit = -1
+	}
+
+	rows, err := tx.QueryContext(
+		ctx,
+		"SELECT id, status, recorded_at FROM compliance_history WHERE device_id = ? ORDER BY id DESC LIMIT ?;",
+		id, limit,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	var (
+		result []models.ComplianceRecord
+		ids    []int64
+	)
+	for rows.Next() {
+		var (
+			record     models.ComplianceRecord
+			history_id int64
+			recorded   int64
+		)
+		if err = rows.Scan(&history_id, &record.Status, &recorded); err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		record.RecordedAt = time.Unix(recorded, 0)
+
+		result = append(result, record)
+		ids = append(ids, history_id)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	for i, history_id := range ids {
+		if result[i].Reasons, err = complianceReasons(ctx, tx, history_id); err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+	}
+
+	return result, nil
+}
+
+// ComplianceSummary counts the devices matching the filter by compliance
+// status and the devices failing every rule with their latest result.
+func (s *Storage) ComplianceSummary(ctx context.Context, filter models.DeviceFilter) (models.ComplianceSummary, error) {
+	const op = "storage.sqlite.ComplianceSummary"
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	where, args := deviceFilter(filter)
+
+	var summary models.ComplianceSummary
+	err := s.db.QueryRowContext(
+		ctx,
+		`SELECT COUNT(*),
+			COUNT(*) FILTER (WHERE h.status = ?),
+			COUNT(*) FILTER (WHERE h.status = ?)
+		 FROM devices AS d
+			LEFT JOIN device_compliance AS c
+			ON c.device_id = d.id
+			LEFT JOIN compliance_history AS h
+			ON h.id = c.history_id
+		 WHERE `+where+`;`,
+		append([]any{models.ComplianceCompliant, models.ComplianceNonCompliant}, args...)...,
+	).Scan(&summary.Total, &summary.Compliant, &summary.NonCompliant)
+	if err != nil {
+		return models.ComplianceSummary{}, fmt.Errorf("%s: %w", op, err)
+	}
+	summary.Unknown = summary.Total - summary.Compliant - summary.NonCompliant
+
+	rows, err := s.db.QueryContext(
+		ctx,
+		`SELECT r.rule, r.condition, COUNT(DISTINCT d.id)
+		 FROM devices AS d
+			JOIN device_compliance AS c
+			ON c.device_id = d.id
+			JOIN compliance_reasons AS r
+			ON r.history_id = c.history_id
+		 WHERE `+where+`
+		 GROUP BY r.rule, r.condition
+		 ORDER BY r.rule;`,
+		args...,
+	)
+	if err != nil {
+		return models.ComplianceSummary{}, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var v models.RuleViolations
+		if err = rows.Scan(&v.Rule, &v.Condition, &v.Devices); err != nil {
+			return models.ComplianceSummary{}, fmt.Errorf("%s: %w", op, err)
+		}
+
+		summary.Rules = append(summary.Rules, v)
+	}
+
+	if err = rows.Err(); err != nil {
+		return models.ComplianceSummary{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return summary, nil
+}
+
+// complianceInputs collects the status, the latest inventory, the labels and
+// the drifted features of the devices matching the condition.
+func (s *Storage) complianceInputs(ctx context.Context, where string, args []any) ([]models.ComplianceInput, error) {
+	rows, err := s.db.QueryContext(
+		ctx,
+		`SELECT d.id, d.uuid, d.last_seen, s.battery,
+			json_extract(i.data, '$.os_name'), json_extract(i.data, '$.os_version')
+		 FROM devices AS d
+			LEFT JOIN device_statuses AS s
+			ON s.device_id = d.id
+			LEFT JOIN inventory_snapshots AS i
+			ON i.device_id = d.id
+				AND i.version = (SELECT MAX(version) FROM inventory_snapshots WHERE device_id = d.id)
+		 WHERE `+where+`
+		 ORDER BY d.id;`,
+		args...,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var (
+		result []models.ComplianceInput
+		index  = make(map[int64]int)
+	)
+	for rows.Next() {
+		var (
+			input               models.ComplianceInput
+			id                  int64
+			seen                sql.NullInt64
+			battery             sql.NullInt64
+			os_name, os_version sql.NullString
+		)
+		if err = rows.Scan(&id, &input.DeviceUuid, &seen, &battery, &os_name, &os_version); err != nil {
+			return nil, err
+		}
+
+		input.LastSeen = unixTime(seen)
+		input.Battery, input.StatusReported = int(battery.Int64), battery.Valid
+		input.OsName, input.OsVersion, input.Inventory = os_name.String, os_version.String, os_name.Valid
+
+		index[id] = len(result)
+		result = append(result, input)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	if len(result) == 0 {
+		return result, nil
+	}
+
+	ids := make([]any, 0, len(index))
+	for id := range index {
+		ids = append(ids, id)
+	}
+	in := placeholders(len(ids))
+
+	labels, err := s.deviceLabels(ctx, "WHERE device_id IN ("+in+")", ids...)
+	if err != nil {
+		return nil, err
+	}
+
+	for id, i := range index {
+		result[i].Labels = labels[id]
+	}
+
+	drifts, err := s.db.QueryContext(
+		ctx,
+		`SELECT df.device_id, f.name, df.drift_since
+		 FROM device_features AS df
+			JOIN features AS f
+			ON df.feature_id = f.id
+		 WHERE df.drift_since IS NOT NULL AND df.device_id IN (`+in+`)
+		 ORDER BY f.name;`,
+		ids...,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer drifts.Close()
+
+	for drifts.Next() {
+		var (
+			drift models.FeatureDrift
+			id    int64
+			since int64
+		)
+		if err = drifts.Scan(&id, &drift.Feature, &since); err != nil {
+			return nil, err
+		}
+		drift.Since = time.Unix(since, 0)
+
+		input := &result[index[id]]
+		input.Drifts = append(input.Drifts, drift)
+	}
+
+	return result, drifts.Err()
+}
+
+// deviceCompliance returns the latest compliance result of the device and
+// the id of its history record, zero if the device has not been evaluated.
+func deviceCompliance(ctx context.Context, tx *sql.Tx, device_id int64) (models.DeviceCompliance, int64, error) {
+	var (
+		compliance          models.DeviceCompliance
+		history_id          int64
+		evaluated, recorded int64
+	)
+	err := tx.QueryRowContext(
+		ctx,
+		`SELECT c.history_id, h.status, c.evaluated_at, h.recorded_at
+		 FROM device_compliance AS c
+			JOIN compliance_history AS h
+			ON h.id = c.history_id
+		 WHERE c.device_id = ?;`,
+		device_id,
+	).Scan(&history_id, &compliance.Status, &evaluated, &recorded)
+	if errors.Is(err, sql.ErrNoRows) {
+		return models.DeviceCompliance{}, 0, nil
+	}
+	if err != nil {
+		return models.DeviceCompliance{}, 0, err
+	}
+
+	compliance.EvaluatedAt = time.Unix(evaluated, 0)
+	compliance.Since = time.Unix(recorded, 0)
+
+	if compliance.Reasons, err = complianceReasons(ctx, tx, history_id); err != nil {
+		return models.DeviceCompliance{}, 0, err
+	}
+
+	return compliance, history_id, nil
+}
+
+func complianceReasons(ctx context.Context, tx *sql.Tx, history_id int64) ([]models.ComplianceReason, error) {
+	rows, err := tx.QueryContext(
+		ctx,
+		"SELECT rule, condition, message FROM compliance_reasons WHERE history_id = ? ORDER BY rowid;",
+		history_id,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var result []models.ComplianceReason
+	for rows.Next() {
+		var r models.ComplianceReason
+		if err = rows.Scan(&r.Rule, &r.Condition, &r.Message); err != nil {
+			return nil, err
+		}
+
+		result = append(result, r)
+	}
+
+	return result, rows.Err()
+}
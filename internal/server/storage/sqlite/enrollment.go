@@ -0,0 +1,505 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/dvaxert/mdm/internal/domain/models"
+	"github.com/dvaxert/mdm/internal/server/storage"
+	"github.com/google/uuid"
+)
+
+// CreateEnrollmentToken stores the enrollment token and returns its id.
+// Only the hash of the token is kept. The group and the profile of the
+// token scope must exist.
+func (s *Storage) CreateEnrollmentToken(ctx context.Context, token models.EnrollmentToken, token_hash string) (int64, error) {
+	const op = "storage.sqlite.CreateEnrollmentToken"
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	tx, err := s.db.Begin()
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+	defer tx.Rollback()
+
+	if token.Group != "" {
+		if _, err = groupId(ctx, tx, token.Group); err != nil {
+			return 0, fmt.Errorf("%s: %w", op, err)
+		}
+	}
+
+	if token.Profile != "" {
+		if _, err = profileId(ctx, tx, token.Profile); err != nil {
+			return 0, fmt.Errorf("%s: %w", op, err)
+		}
+	}
+
+	var id int64
+	err = tx.QueryRowContext(
+		ctx,
+		`INSERT INTO enrollment_tokens(token_hash, description, max_uses, group_name, profile_name, created_at, expires_at)
+		 VALUES(?, ?, ?, ?, ?, unixepoch(), ?)
+		 RETURNING id;`,
+		token_hash, token.Description, token.MaxUses, token.Group, token.Profile, token.ExpiresAt.Unix(),
+	).Scan(&id)
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	for _, t := range token.DeviceTypes {
+		_, err = tx.ExecContext(
+			ctx,
+			"INSERT OR IGNORE INTO enrollment_token_types(token_id, device_type) VALUES(?, ?);",
+			id, t,
+		)
+		if err != nil {
+			return 0, fmt.Errorf("%s: %w", op, err)
+		}
+	}
+
+	for key, value := range token.Labels {
+		_, err = tx.ExecContext(
+			ctx,
+			"INSERT INTO enrollment_token_labels(token_id, key, value) VALUES(?, ?, ?);",
+			id, key, value,
+		)
+		if err != nil {
+			return 0, fmt.Errorf("%s: %w", op, err)
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return id, nil
+}
+
+// EnrollmentTokenList returns all enrollment tokens, newest first.
+func (s *Storage) EnrollmentTokenList(ctx context.Context) ([]models.EnrollmentToken, error) {
+	const op = "storage.sqlite.EnrollmentTokenList"
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	tx, err := s.db.Begin()
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer tx.Rollback()
+
+	result, err := enrollmentTokens(ctx, tx, "ORDER BY t.id DESC")
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return result, nil
+}
+
+// RevokeEnrollmentToken revokes the token, revoking it again keeps the first
+// revocation time.
+func (s *Storage) RevokeEnrollmentToken(ctx context.Context, id int64) error {
+	const op = "storage.sqlite.RevokeEnrollmentToken"
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	res, err := s.db.ExecContext(
+		ctx,
+		"UPDATE enrollment_tokens SET revoked_at = COALESCE(revoked_at, unixepoch()) WHERE id = ?;",
+		id,
+	)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	n, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if n == 0 {
+		return fmt.Errorf("%s: %w", op, storage.ErrEnrollmentTokenNotFound)
+	}
+
+	return nil
+}
+
+// AddCertificate records a certificate issued to the device.
+func (s *Storage) AddCertificate(ctx context.Context, cert models.Certificate) error {
+	const op = "storage.sqlite.AddCertificate"
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	tx, err := s.db.Begin()
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	defer tx.Rollback()
+
+	device_id, err := deviceId(ctx, tx, cert.DeviceUuid)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	_, err = tx.ExecContext(
+		ctx,
+		`INSERT INTO certificates(serial, device_id, not_before, not_after, issued_at)
+		 VALUES(?, ?, ?, ?, ?);`,
+		cert.Serial, device_id, cert.NotBefore.Unix(), cert.NotAfter.Unix(), cert.IssuedAt.Unix(),
+	)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err = tx.Commit(); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+// CertificateList returns the certificates issued to the device, or to all
+// devices if device_uuid is uuid.Nil, newest first.
+func (s *Storage) CertificateList(ctx context.Context, device_uuid uuid.UUID) ([]models.Certificate, error) {
+	const op = "storage.sqlite.CertificateList"
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	where, args := "", []any{}
+	if device_uuid != uuid.Nil {
+		where, args = "WHERE d.uuid = ?", append(args, device_uuid)
+	}
+
+	rows, err := s.db.QueryContext(
+		ctx,
+		`SELECT c.serial, d.uuid, c.not_before, c.not_after, c.issued_at, c.revoked_at
+		 FROM certificates AS c
+			JOIN devices AS d
+			ON c.device_id = d.id
+		 `+where+`
+		 ORDER BY c.issued_at DESC, c.rowid DESC;`,
+		args...,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	var result []models.Certificate
+	for rows.Next() {
+		var (
+			cert                        models.Certificate
+			notBefore, notAfter, issued int64
+			revoked                     sql.NullInt64
+		)
+		err = rows.Scan(&cert.Serial, &cert.DeviceUuid, &notBefore, &notAfter, &issued, &revoked)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+
+		cert.NotBefore = time.Unix(notBefore, 0)
+		cert.NotAfter = time.Unix(notAfter, 0)
+		cert.IssuedAt = time.Unix(issued, 0)
+		cert.RevokedAt = unixTime(revoked)
+
+		result = append(result, cert)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return result, nil
+}
+
+// RevokeCertificate revokes the certificate, revoking it again keeps the
+// first revocation time.
+func (s *Storage) RevokeCertificate(ctx context.Context, serial string) error {
+	const op = "storage.sqlite.RevokeCertificate"
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	res, err := s.db.ExecContext(
+		ctx,
+		"UPDATE certificates SET revoked_at = COALESCE(revoked_at, unixepoch()) WHERE serial = ?;",
+		serial,
+	)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	n, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if n == 0 {
+		return fmt.Errorf("%s: %w", op, storage.ErrCertificateNotFound)
+	}
+
+	return nil
+}
+
+// RevokeDeviceCertificates revokes all certificates of the device and
+// returns the number of certificates revoked by the call.
+func (s *Storage) RevokeDeviceCertificates(ctx context.Context, device_uuid uuid.UUID) (int64, error) {
+	const op = "storage.sqlite.RevokeDeviceCertificates"
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	tx, err := s.db.Begin()
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+	defer tx.Rollback()
+
+	device_id, err := deviceId(ctx, tx, device_uuid)
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	res, err := tx.ExecContext(
+		ctx,
+		"UPDATE certificates SET revoked_at = unixepoch() WHERE device_id = ? AND revoked_at IS NULL;",
+		device_id,
+	)
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	n, err := res.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err = tx.Commit(); err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return n, nil
+}
+
+// CertificateRevoked reports whether the certificate has been revoked.
+// Certificates that were not issued by the enrollment CA are not revoked.
+func (s *Storage) CertificateRevoked(ctx context.Context, serial string) (bool, error) {
+	const op = "storage.sqlite.CertificateRevoked"
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var revoked bool
+	err := s.db.QueryRowContext(
+		ctx,
+		"SELECT EXISTS(SELECT 1 FROM certificates WHERE serial = ? AND revoked_at IS NOT NULL);",
+		serial,
+	).Scan(&revoked)
+	if err != nil {
+		return false, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return revoked, nil
+}
+
+// useEnrollmentToken spends one use of the token for a device of the type
+// and returns the token. A token that does not exist or can not be used any
+// more is reported with storage.ErrEnrollmentTokenInvalid, a token that does
+// not allow the type with storage.ErrEnrollmentTokenScope.
+func useEnrollmentToken(
+	ctx context.Context,
+	tx *sql.Tx,
+	token_hash string,
+	device_type models.DeviceType,
+) (models.EnrollmentToken, error) {
+	list, err := enrollmentTokens(ctx, tx, "WHERE t.token_hash = ?", token_hash)
+	if err != nil {
+		return models.EnrollmentToken{}, err
+	}
+
+	if len(list) == 0 || !list[0].Usable(time.Now()) {
+		return models.EnrollmentToken{}, storage.ErrEnrollmentTokenInvalid
+	}
+
+	token := list[0]
+	if !token.AllowsType(device_type) {
+		return models.EnrollmentToken{}, storage.ErrEnrollmentTokenScope
+	}
+
+	_, err = tx.ExecContext(
+		ctx,
+		"UPDATE enrollment_tokens SET uses = uses + 1, used_at = unixepoch() WHERE id = ?;",
+		token.Id,
+	)
+	if err != nil {
+		return models.EnrollmentToken{}, err
+	}
+
+	return token, nil
+}
+
+// enrollDevice puts a newly registered device into the scope of the token:
+// the group, the profile and the labels, which take precedence over the
+// reported ones. A group or a profile deleted after the token was created
+// makes the token invalid.
+func enrollDevice(ctx context.Context, tx *sql.Tx, device_id int64, token models.EnrollmentToken) error {
+	if token.Group != "" {
+		group_id, err := groupId(ctx, tx, token.Group)
+		if errors.Is(err, storage.ErrGroupNotFound) {
+			return fmt.Errorf("%w: group %q not found", storage.ErrEnrollmentTokenInvalid, token.Group)
+		}
+		if err != nil {
+			return err
+		}
+
+		_, err = tx.ExecContext(
+			ctx,
+			"INSERT OR IGNORE INTO device_group_members(group_id, device_id) VALUES(?,?);",
+			group_id, device_id,
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	if token.Profile != "" {
+		profile_id, err := profileId(ctx, tx, token.Profile)
+		if errors.Is(err, storage.ErrProfileNotFound) {
+			return fmt.Errorf("%w: profile %q not found", storage.ErrEnrollmentTokenInvalid, token.Profile)
+		}
+		if err != nil {
+			return err
+		}
+
+		_, err = tx.ExecContext(
+			ctx,
+			"INSERT OR IGNORE INTO device_profiles(profile_id, device_id) VALUES(?,?);",
+			profile_id, device_id,
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	for key, value := range token.Labels {
+		_, err := tx.ExecContext(
+			ctx,
+			`INSERT INTO device_labels(device_id, key, value, reported) VALUES(?, ?, ?, 0)
+			 ON CONFLICT(device_id, key) DO UPDATE SET value = excluded.value, reported = 0;`,
+			device_id, key, value,
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func enrollmentTokens(ctx context.Context, tx *sql.Tx, where string, args ...any) ([]models.EnrollmentToken, error) {
+	rows, err := tx.QueryContext(
+		ctx,
+		`SELECT t.id, t.description, t.max_uses, t.uses, t.group_name, t.profile_name,
+			t.created_at, t.expires_at, t.used_at, t.revoked_at
+		 FROM enrollment_tokens AS t
+		 `+where+`;`,
+		args...,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var (
+		result []models.EnrollmentToken
+		index  = make(map[int64]int)
+	)
+	for rows.Next() {
+		var (
+			token            models.EnrollmentToken
+			created, expires int64
+			used, revoked    sql.NullInt64
+		)
+		err = rows.Scan(
+			&token.Id, &token.Description, &token.MaxUses, &token.Uses, &token.Group, &token.Profile,
+			&created, &expires, &used, &revoked,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		token.CreatedAt = time.Unix(created, 0)
+		token.ExpiresAt = time.Unix(expires, 0)
+		token.LastUsedAt = unixTime(used)
+		token.RevokedAt = unixTime(revoked)
+
+		index[token.Id] = len(result)
+		result = append(result, token)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	if len(result) == 0 {
+		return result, nil
+	}
+
+	types, err := tx.QueryContext(ctx, "SELECT token_id, device_type FROM enrollment_token_types ORDER BY device_type;")
+	if err != nil {
+		return nil, err
+	}
+	defer types.Close()
+
+	for types.Next() {
+		var (
+			id int64
+			t  models.DeviceType
+		)
+		if err = types.Scan(&id, &t); err != nil {
+			return nil, err
+		}
+
+		if i, ok := index[id]; ok {
+			result[i].DeviceTypes = append(result[i].DeviceTypes, t)
+		}
+	}
+	if err = types.Err(); err != nil {
+		return nil, err
+	}
+
+	labels, err := tx.QueryContext(ctx, "SELECT token_id, key, value FROM enrollment_token_labels;")
+	if err != nil {
+		return nil, err
+	}
+	defer labels.Close()
+
+	for labels.Next() {
+		var (
+			id         int64
+			key, value string
+		)
+		if err = labels.Scan(&id, &key, &value); err != nil {
+			return nil, err
+		}
+
+		i, ok := index[id]
+		if !ok {
+			continue
+		}
+
+		if result[i].Labels == nil {
+			result[i].Labels = make(map[string]string)
+		}
+		result[i].Labels[key] = value
+	}
+
+	return result, labels.Err()
+}
@@ -0,0 +1,376 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/dvaxert/mdm/internal/domain/models"
+	"github.com/dvaxert/mdm/internal/server/storage"
+	"github.com/google/uuid"
+)
+
+// CreateFeature adds the feature to the catalog and seeds its default state on
+// every existing device it applies to. The uuids of those devices are returned.
+func (s *Storage) CreateFeature(ctx context.Context, feature models.Feature) ([]uuid.UUID, error) {
+	const op = "storage.sqlite.CreateFeature"
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	tx, err := s.db.Begin()
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer tx.Rollback()
+
+	var exists bool
+	err = tx.QueryRowContext(ctx, "SELECT EXISTS(SELECT 1 FROM features WHERE name = ?);", feature.Name).Scan(&exists)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if exists {
+		return nil, fmt.Errorf("%s: %w", op, storage.ErrFeatureExists)
+	}
+
+	default_value, err := encodeValue(feature.Default)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	var enum_values sql.NullString
+	if feature.Type == models.FeatureEnum {
+		b, err := json.Marshal(feature.EnumValues)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+
+		enum_values = sql.NullString{String: string(b), Valid: true}
+	}
+
+	res, err := tx.ExecContext(
+		ctx,
+		`INSERT INTO features(name, description, value_type, default_state, default_value, int_min, int_max, enum_values)
+		 VALUES(?,?,?,?,?,?,?,?);`,
+		feature.Name,
+		feature.Description,
+		feature.Type,
+		legacyState(feature.Default),
+		default_value,
+		feature.Min,
+		feature.Max,
+		enum_values,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	id, err := res.LastInsertId()
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	for _, t := range feature.DeviceTypes {
+		_, err = tx.ExecContext(
+			ctx,
+			"INSERT OR IGNORE INTO feature_device_types(feature_id, device_type) VALUES(?,?);",
+			id, t,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+	}
+
+	devices, err := queryDevices(
+		ctx, tx,
+		"SELECT id, uuid FROM devices AS d WHERE "+featureAppliesTo(strconv.FormatInt(id, 10), "d.type")+";",
+	)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	result := make([]uuid.UUID, 0, len(devices))
+	for device_id, device_uuid := range devices {
+		_, err = tx.ExecContext(
+			ctx,
+			`INSERT OR IGNORE INTO device_features(device_id, feature_id, state, value, desired_at, drift_since)
+			 VALUES(?, ?, ?, ?, unixepoch(), unixepoch());`,
+			device_id, id, legacyState(feature.Default), default_value,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+
+		if _, err = bumpRevision(ctx, tx, device_id); err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+
+		result = append(result, device_uuid)
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return result, nil
+}
+
+func (s *Storage) Feature(ctx context.Context, name string) (models.Feature, error) {
+	const op = "storage.sqlite.Feature"
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	row := s.db.QueryRowContext(ctx, "SELECT "+featureColumns+" FROM features WHERE name = ?;", name)
+
+	feature, err := scanFeature(row)
+	if errors.Is(err, sql.ErrNoRows) {
+		return models.Feature{}, fmt.Errorf("%s: %w", op, storage.ErrFeatureNotFound)
+	}
+	if err != nil {
+		return models.Feature{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	types, err := s.featureDeviceTypes(ctx, "WHERE feature_id = ?", feature.Id)
+	if err != nil {
+		return models.Feature{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	feature.DeviceTypes = types[feature.Id]
+
+	return feature, nil
+}
+
+func (s *Storage) FeatureList(ctx context.Context) ([]models.Feature, error) {
+	const op = "storage.sqlite.FeatureList"
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	rows, err := s.db.QueryContext(ctx, "SELECT "+featureColumns+" FROM features ORDER BY name;")
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	var result []models.Feature
+	for rows.Next() {
+		f, err := scanFeature(rows)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+
+		result = append(result, f)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	types, err := s.featureDeviceTypes(ctx, "")
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	for i := range result {
+		result[i].DeviceTypes = types[result[i].Id]
+	}
+
+	return result, nil
+}
+
+// DeprecateFeature marks the feature as deprecated or returns it to service.
+// A deprecated feature is no longer seeded on new devices and cannot be changed.
+func (s *Storage) DeprecateFeature(ctx context.Context, name string, deprecated bool) error {
+	const op = "storage.sqlite.DeprecateFeature"
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	res, err := s.db.ExecContext(ctx, "UPDATE features SET deprecated = ? WHERE name = ?;", deprecated, name)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if affected == 0 {
+		return fmt.Errorf("%s: %w", op, storage.ErrFeatureNotFound)
+	}
+
+	return nil
+}
+
+// DeleteFeature removes the feature from the catalog and from every device.
+// The uuids of the devices that had the feature are returned.
+func (s *Storage) DeleteFeature(ctx context.Context, name string) ([]uuid.UUID, error) {
+	const op = "storage.sqlite.DeleteFeature"
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	tx, err := s.db.Begin()
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer tx.Rollback()
+
+	var id int64
+	err = tx.QueryRowContext(ctx, "SELECT id FROM features WHERE name = ?;", name).Scan(&id)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, fmt.Errorf("%s: %w", op, storage.ErrFeatureNotFound)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	devices, err := queryDevices(
+		ctx, tx,
+		`SELECT d.id, d.uuid
+		 FROM devices AS d
+			JOIN device_features AS df
+			ON df.device_id = d.id
+		 WHERE df.feature_id = ?;`,
+		id,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	for _, query := range []string{
+		"DELETE FROM device_features WHERE feature_id = ?;",
+		"DELETE FROM device_group_features WHERE feature_id = ?;",
+		"DELETE FROM profile_features WHERE feature_id = ?;",
+		"DELETE FROM feature_device_types WHERE feature_id = ?;",
+		"DELETE FROM features WHERE id = ?;",
+	} {
+		if _, err = tx.ExecContext(ctx, query, id); err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+	}
+
+	result := make([]uuid.UUID, 0, len(devices))
+	for device_id, device_uuid := range devices {
+		if _, err = bumpRevision(ctx, tx, device_id); err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+
+		result = append(result, device_uuid)
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return result, nil
+}
+
+const featureColumns = "id, name, description, deprecated, value_type, default_value, int_min, int_max, enum_values"
+
+// scanFeature scans a feature selected with featureColumns, device types are
+// not filled.
+func scanFeature(row interface{ Scan(dest ...any) error }) (models.Feature, error) {
+	var (
+		f             models.Feature
+		default_value string
+		int_min       sql.NullInt64
+		int_max       sql.NullInt64
+		enum_values   sql.NullString
+	)
+
+	err := row.Scan(
+		&f.Id,
+		&f.Name,
+		&f.Description,
+		&f.Deprecated,
+		&f.Type,
+		&default_value,
+		&int_min,
+		&int_max,
+		&enum_values,
+	)
+	if err != nil {
+		return models.Feature{}, err
+	}
+
+	if f.Default, err = decodeValue(f.Type, default_value); err != nil {
+		return models.Feature{}, err
+	}
+
+	if int_min.Valid {
+		f.Min = &int_min.Int64
+	}
+
+	if int_max.Valid {
+		f.Max = &int_max.Int64
+	}
+
+	if enum_values.Valid {
+		if err = json.Unmarshal([]byte(enum_values.String), &f.EnumValues); err != nil {
+			return models.Feature{}, err
+		}
+	}
+
+	return f, nil
+}
+
+// featureDeviceTypes returns the device types of the features selected by the
+// where clause, keyed by feature id.
+func (s *Storage) featureDeviceTypes(ctx context.Context, where string, args ...any) (map[int64][]models.DeviceType, error) {
+	rows, err := s.db.QueryContext(
+		ctx,
+		"SELECT feature_id, device_type FROM feature_device_types "+where+" ORDER BY device_type;",
+		args...,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	result := make(map[int64][]models.DeviceType)
+	for rows.Next() {
+		var (
+			id int64
+			t  models.DeviceType
+		)
+		if err = rows.Scan(&id, &t); err != nil {
+			return nil, err
+		}
+
+		result[id] = append(result[id], t)
+	}
+
+	return result, rows.Err()
+}
+
+// queryDevices runs a query selecting device id and uuid and returns the
+// uuids keyed by id.
+func queryDevices(ctx context.Context, tx *sql.Tx, query string, args ...any) (map[int64]uuid.UUID, error) {
+	rows, err := tx.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	result := make(map[int64]uuid.UUID)
+	for rows.Next() {
+		var (
+			id          int64
+			device_uuid uuid.UUID
+		)
+		if err = rows.Scan(&id, &device_uuid); err != nil {
+			return nil, err
+		}
+
+		result[id] = device_uuid
+	}
+
+	return result, rows.Err()
+}
@@ -0,0 +1,729 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/dvaxert/mdm/internal/domain/models"
+	"github.com/dvaxert/mdm/internal/server/storage"
+	"github.com/google/uuid"
+)
+
+// CreateGroup creates an empty group. An empty parent creates a root group.
+func (s *Storage) CreateGroup(ctx context.Context, name string, parent string) error {
+	const op = "storage.sqlite.CreateGroup"
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	tx, err := s.db.Begin()
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	defer tx.Rollback()
+
+	var exists bool
+	err = tx.QueryRowContext(ctx, "SELECT EXISTS(SELECT 1 FROM device_groups WHERE name = ?);", name).Scan(&exists)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if exists {
+		return fmt.Errorf("%s: %w", op, storage.ErrGroupExists)
+	}
+
+	var parent_id sql.NullInt64
+	if parent != "" {
+		if parent_id.Int64, err = groupId(ctx, tx, parent); err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+		parent_id.Valid = true
+	}
+
+	_, err = tx.ExecContext(ctx, "INSERT INTO device_groups(name, parent_id) VALUES(?,?);", name, parent_id)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err = tx.Commit(); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+// DeleteGroup removes a group without subgroups. The uuids of the former
+// members whose desired state changed are returned.
+func (s *Storage) DeleteGroup(ctx context.Context, name string) ([]uuid.UUID, error) {
+	const op = "storage.sqlite.DeleteGroup"
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	tx, err := s.db.Begin()
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer tx.Rollback()
+
+	id, err := groupId(ctx, tx, name)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	var subgroups bool
+	err = tx.QueryRowContext(ctx, "SELECT EXISTS(SELECT 1 FROM device_groups WHERE parent_id = ?);", id).Scan(&subgroups)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if subgroups {
+		return nil, fmt.Errorf("%s: %w", op, storage.ErrGroupHasSubgroups)
+	}
+
+	devices, err := groupMembers(ctx, tx, id)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	for _, query := range []string{
+		"DELETE FROM device_group_members WHERE group_id = ?;",
+		"DELETE FROM device_group_features WHERE group_id = ?;",
+		"DELETE FROM device_group_profiles WHERE group_id = ?;",
+		"DELETE FROM device_group_apps WHERE group_id = ?;",
+		"DELETE FROM device_groups WHERE id = ?;",
+	} {
+		if _, err = tx.ExecContext(ctx, query, id); err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+	}
+
+	changed, err := resolveDevices(ctx, tx, devices)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return changed, nil
+}
+
+func (s *Storage) Group(ctx context.Context, name string) (models.Group, error) {
+	const op = "storage.sqlite.Group"
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	groups, err := s.groups(ctx, "WHERE g.name = ?", name)
+	if err != nil {
+		return models.Group{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if len(groups) == 0 {
+		return models.Group{}, fmt.Errorf("%s: %w", op, storage.ErrGroupNotFound)
+	}
+
+	return groups[0], nil
+}
+
+// GroupDevices returns the devices of the group and of its subgroups.
+func (s *Storage) GroupDevices(ctx context.Context, name string) ([]uuid.UUID, error) {
+	const op = "storage.sqlite.GroupDevices"
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	tx, err := s.db.Begin()
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer tx.Rollback()
+
+	id, err := groupId(ctx, tx, name)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	members, err := groupMembers(ctx, tx, id)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	result := make([]uuid.UUID, 0, len(members))
+	for _, device_uuid := range members {
+		result = append(result, device_uuid)
+	}
+
+	return result, nil
+}
+
+func (s *Storage) GroupList(ctx context.Context) ([]models.Group, error) {
+	const op = "storage.sqlite.GroupList"
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	groups, err := s.groups(ctx, "")
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return groups, nil
+}
+
+// AddGroupDevice adds the device to the group. The device uuid is returned if
+// its desired state changed.
+func (s *Storage) AddGroupDevice(ctx context.Context, group string, device_uuid uuid.UUID) ([]uuid.UUID, error) {
+	const op = "storage.sqlite.AddGroupDevice"
+
+	changed, err := s.updateMembership(
+		ctx, group, device_uuid,
+		"INSERT OR IGNORE INTO device_group_members(group_id, device_id) VALUES(?,?);",
+	)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return changed, nil
+}
+
+// RemoveGroupDevice removes the device from the group. The device uuid is
+// returned if its desired state changed.
+func (s *Storage) RemoveGroupDevice(ctx context.Context, group string, device_uuid uuid.UUID) ([]uuid.UUID, error) {
+	const op = "storage.sqlite.RemoveGroupDevice"
+
+	changed, err := s.updateMembership(
+		ctx, group, device_uuid,
+		"DELETE FROM device_group_members WHERE group_id = ? AND device_id = ?;",
+	)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return changed, nil
+}
+
+// SetGroupFeature sets the feature value of the group. The uuids of the
+// members whose desired state changed are returned.
+func (s *Storage) SetGroupFeature(
+	ctx context.Context,
+	group string,
+	feature string,
+	value models.FeatureValue,
+) ([]uuid.UUID, error) {
+	const op = "storage.sqlite.SetGroupFeature"
+
+	encoded, err := encodeValue(value)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	changed, err := s.updateGroupFeature(
+		ctx, group, feature,
+		`INSERT INTO device_group_features(group_id, feature_id, value) VALUES(?1, ?2, ?3)
+		 ON CONFLICT(group_id, feature_id) DO UPDATE SET value = excluded.value;`,
+		encoded,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return changed, nil
+}
+
+// ClearGroupFeature removes the feature value of the group. The uuids of the
+// members whose desired state changed are returned.
+func (s *Storage) ClearGroupFeature(ctx context.Context, group string, feature string) ([]uuid.UUID, error) {
+	const op = "storage.sqlite.ClearGroupFeature"
+
+	changed, err := s.updateGroupFeature(
+		ctx, group, feature,
+		"DELETE FROM device_group_features WHERE group_id = ?1 AND feature_id = ?2;",
+	)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return changed, nil
+}
+
+// ResetDeviceFeature removes the value set on the device itself, the feature
+// value is inherited from the device profiles and groups again. The device uuid is
+// returned if its desired state changed.
+func (s *Storage) ResetDeviceFeature(ctx context.Context, device_uuid uuid.UUID, feature string) ([]uuid.UUID, error) {
+	const op = "storage.sqlite.ResetDeviceFeature"
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	tx, err := s.db.Begin()
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer tx.Rollback()
+
+	device_id, err := deviceId(ctx, tx, device_uuid)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	feature_id, err := featureId(ctx, tx, feature)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	_, err = tx.ExecContext(
+		ctx,
+		"UPDATE device_features SET overridden = 0 WHERE device_id = ? AND feature_id = ?;",
+		device_id, feature_id,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	changed, err := resolveDevices(ctx, tx, map[int64]uuid.UUID{device_id: device_uuid})
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return changed, nil
+}
+
+// updateMembership runs a query changing the membership of the device, the
+// query takes the group id and the device id.
+func (s *Storage) updateMembership(ctx context.Context, group string, device_uuid uuid.UUID, query string) ([]uuid.UUID, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	tx, err := s.db.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	group_id, err := groupId(ctx, tx, group)
+	if err != nil {
+		return nil, err
+	}
+
+	device_id, err := deviceId(ctx, tx, device_uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err = tx.ExecContext(ctx, query, group_id, device_id); err != nil {
+		return nil, err
+	}
+
+	changed, err := resolveDevices(ctx, tx, map[int64]uuid.UUID{device_id: device_uuid})
+	if err != nil {
+		return nil, err
+	}
+
+	return changed, tx.Commit()
+}
+
+// updateGroupFeature runs a query changing the feature value of the group,
+// the query takes the group id, the feature id and the extra arguments.
+func (s *Storage) updateGroupFeature(
+	ctx context.Context,
+	group string,
+	feature string,
+	query string,
+	args ...any,
+) ([]uuid.UUID, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	tx, err := s.db.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	group_id, err := groupId(ctx, tx, group)
+	if err != nil {
+		return nil, err
+	}
+
+	feature_id, err := featureId(ctx, tx, feature)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err = tx.ExecContext(ctx, query, append([]any{group_id, feature_id}, args...)...); err != nil {
+		return nil, err
+	}
+
+	devices, err := groupMembers(ctx, tx, group_id)
+	if err != nil {
+		return nil, err
+	}
+
+	changed, err := resolveDevices(ctx, tx, devices)
+	if err != nil {
+		return nil, err
+	}
+
+	return changed, tx.Commit()
+}
+
+// groups returns the groups selected by the where clause on device_groups
+// aliased as g, with their direct members and feature values.
+func (s *Storage) groups(ctx context.Context, where string, args ...any) ([]models.Group, error) {
+	rows, err := s.db.QueryContext(
+		ctx,
+		`SELECT g.id, g.name, COALESCE(p.name, '')
+		 FROM device_groups AS g
+			LEFT JOIN device_groups AS p
+			ON g.parent_id = p.id
+		 `+where+`
+		 ORDER BY g.name;`,
+		args...,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var result []models.Group
+	index := make(map[int64]int)
+	for rows.Next() {
+		g := models.Group{Features: make(map[string]models.FeatureValue)}
+		if err = rows.Scan(&g.Id, &g.Name, &g.Parent); err != nil {
+			return nil, err
+		}
+
+		index[g.Id] = len(result)
+		result = append(result, g)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	members, err := s.db.QueryContext(
+		ctx,
+		`SELECT g.id, d.uuid
+		 FROM device_group_members AS m
+			JOIN device_groups AS g
+			ON m.group_id = g.id
+			JOIN devices AS d
+			ON m.device_id = d.id
+		 `+where+`
+		 ORDER BY d.uuid;`,
+		args...,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer members.Close()
+
+	for members.Next() {
+		var (
+			id          int64
+			device_uuid uuid.UUID
+		)
+		if err = members.Scan(&id, &device_uuid); err != nil {
+			return nil, err
+		}
+
+		g := &result[index[id]]
+		g.Devices = append(g.Devices, device_uuid)
+	}
+
+	if err = members.Err(); err != nil {
+		return nil, err
+	}
+
+	features, err := s.db.QueryContext(
+		ctx,
+		`SELECT g.id, f.name, f.value_type, gf.value
+		 FROM device_group_features AS gf
+			JOIN device_groups AS g
+			ON gf.group_id = g.id
+			JOIN features AS f
+			ON gf.feature_id = f.id
+		 `+where+`;`,
+		args...,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer features.Close()
+
+	for features.Next() {
+		var (
+			id         int64
+			name       string
+			value_type models.FeatureType
+			value      string
+		)
+		if err = features.Scan(&id, &name, &value_type, &value); err != nil {
+			return nil, err
+		}
+
+		v, err := decodeValue(value_type, value)
+		if err != nil {
+			return nil, err
+		}
+
+		result[index[id]].Features[name] = v
+	}
+
+	if err = features.Err(); err != nil {
+		return nil, err
+	}
+
+	profiles, err := s.db.QueryContext(
+		ctx,
+		`SELECT g.id, p.name
+		 FROM device_group_profiles AS gp
+			JOIN device_groups AS g
+			ON gp.group_id = g.id
+			JOIN profiles AS p
+			ON gp.profile_id = p.id
+		 `+where+`
+		 ORDER BY p.name;`,
+		args...,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer profiles.Close()
+
+	for profiles.Next() {
+		var (
+			id   int64
+			name string
+		)
+		if err = profiles.Scan(&id, &name); err != nil {
+			return nil, err
+		}
+
+		g := &result[index[id]]
+		g.Profiles = append(g.Profiles, name)
+	}
+
+	return result, profiles.Err()
+}
+
+// groupMembers returns the devices of the group and all of its subgroups.
+func groupMembers(ctx context.Context, tx *sql.Tx, group_id int64) (map[int64]uuid.UUID, error) {
+	return queryDevices(
+		ctx, tx,
+		`WITH RECURSIVE subgroups(id) AS (
+			SELECT ?
+			UNION
+			SELECT g.id FROM device_groups AS g JOIN subgroups AS s ON g.parent_id = s.id
+		 )
+		 SELECT DISTINCT d.id, d.uuid
+		 FROM devices AS d
+			JOIN device_group_members AS m
+			ON m.device_id = d.id
+		 WHERE m.group_id IN (SELECT id FROM subgroups);`,
+		group_id,
+	)
+}
+
+// resolveDevices recomputes the desired values of the features the devices
+// inherit from profiles and groups (see models.Group for the precedence) and
+// their app policy, and bumps the revision of the devices whose desired
+// state changed. The uuids of those devices are returned.
+func resolveDevices(ctx context.Context, tx *sql.Tx, devices map[int64]uuid.UUID) ([]uuid.UUID, error) {
+	var result []uuid.UUID
+	for device_id, device_uuid := range devices {
+		features, err := resolveDevice(ctx, tx, device_id)
+		if err != nil {
+			return nil, err
+		}
+
+		apps, err := resolveAppPolicy(ctx, tx, device_id)
+		if err != nil {
+			return nil, err
+		}
+
+		if !features && !apps {
+			continue
+		}
+
+		if _, err = bumpRevision(ctx, tx, device_id); err != nil {
+			return nil, err
+		}
+
+		result = append(result, device_uuid)
+	}
+
+	return result, nil
+}
+
+func resolveDevice(ctx context.Context, tx *sql.Tx, device_id int64) (bool, error) {
+	// membership содержит группы устройства вместе с предками и расстоянием
+	// до устройства, профили самого устройства считаются ближе любой группы
+	rows, err := tx.QueryContext(
+		ctx,
+		`WITH RECURSIVE membership(group_id, distance) AS (
+			SELECT group_id, 1 FROM device_group_members WHERE device_id = ?1
+			UNION ALL
+			SELECT g.parent_id, m.distance + 1
+			FROM membership AS m
+				JOIN device_groups AS g
+				ON g.id = m.group_id
+			WHERE g.parent_id IS NOT NULL
+		 )
+		 SELECT feature_id, value FROM (
+			SELECT pf.feature_id, pf.value, 0 AS distance, '' AS group_name, 1 AS from_profile, p.name AS profile_name
+			FROM device_profiles AS dp
+				JOIN profiles AS p
+				ON dp.profile_id = p.id
+				JOIN profile_features AS pf
+				ON pf.profile_id = p.id
+			WHERE dp.device_id = ?1
+			UNION ALL
+			SELECT gf.feature_id, gf.value, m.distance, g.name, 0, ''
+			FROM membership AS m
+				JOIN device_group_features AS gf
+				ON gf.group_id = m.group_id
+				JOIN device_groups AS g
+				ON g.id = m.group_id
+			UNION ALL
+			SELECT pf.feature_id, pf.value, m.distance, g.name, 1, p.name
+			FROM membership AS m
+				JOIN device_groups AS g
+				ON g.id = m.group_id
+				JOIN device_group_profiles AS gp
+				ON gp.group_id = m.group_id
+				JOIN profiles AS p
+				ON gp.profile_id = p.id
+				JOIN profile_features AS pf
+				ON pf.profile_id = p.id
+		 )
+		 ORDER BY distance, group_name, from_profile, profile_name;`,
+		device_id,
+	)
+	if err != nil {
+		return false, err
+	}
+	defer rows.Close()
+
+	inherited := make(map[int64]string)
+	for rows.Next() {
+		var (
+			feature_id int64
+			value      string
+		)
+		if err = rows.Scan(&feature_id, &value); err != nil {
+			return false, err
+		}
+
+		// строки упорядочены по приоритету, побеждает первое значение
+		if _, ok := inherited[feature_id]; !ok {
+			inherited[feature_id] = value
+		}
+	}
+
+	if err = rows.Err(); err != nil {
+		return false, err
+	}
+
+	rows, err = tx.QueryContext(
+		ctx,
+		`SELECT df.id, df.feature_id, df.value, f.default_value
+		 FROM device_features AS df
+			JOIN features AS f
+			ON df.feature_id = f.id
+		 WHERE df.device_id = ? AND df.overridden = 0;`,
+		device_id,
+	)
+	if err != nil {
+		return false, err
+	}
+	defer rows.Close()
+
+	updates := make(map[int64]string)
+	for rows.Next() {
+		var (
+			id            int64
+			feature_id    int64
+			value         string
+			default_value string
+		)
+		if err = rows.Scan(&id, &feature_id, &value, &default_value); err != nil {
+			return false, err
+		}
+
+		desired, ok := inherited[feature_id]
+		if !ok {
+			desired = default_value
+		}
+
+		if desired != value {
+			updates[id] = desired
+		}
+	}
+
+	if err = rows.Err(); err != nil {
+		return false, err
+	}
+
+	// state дублирует значение только для bool функций, значения других
+	// типов в JSON не могут быть равны 'true'
+	for id, value := range updates {
+		_, err = tx.ExecContext(
+			ctx,
+			`UPDATE device_features SET
+				state = (?1 = 'true'),
+				value = ?1,
+				desired_at = unixepoch(),
+				drift_since = CASE
+					WHEN reported_value IS ?1 THEN NULL
+					ELSE COALESCE(drift_since, unixepoch())
+				END
+			 WHERE id = ?2;`,
+			value, id,
+		)
+		if err != nil {
+			return false, err
+		}
+	}
+
+	return len(updates) != 0, nil
+}
+
+func groupId(ctx context.Context, tx *sql.Tx, name string) (int64, error) {
+	var id int64
+	err := tx.QueryRowContext(ctx, "SELECT id FROM device_groups WHERE name = ?;", name).Scan(&id)
+	if errors.Is(err, sql.ErrNoRows) {
+		return 0, storage.ErrGroupNotFound
+	}
+
+	return id, err
+}
+
+func deviceId(ctx context.Context, tx *sql.Tx, device_uuid uuid.UUID) (int64, error) {
+	var id int64
+	err := tx.QueryRowContext(ctx, "SELECT id FROM devices WHERE uuid = ?;", device_uuid).Scan(&id)
+	if errors.Is(err, sql.ErrNoRows) {
+		return 0, storage.ErrDeviceNotFound
+	}
+
+	return id, err
+}
+
+func featureId(ctx context.Context, tx *sql.Tx, name string) (int64, error) {
+	var id int64
+	err := tx.QueryRowContext(ctx, "SELECT id FROM features WHERE name = ?;", name).Scan(&id)
+	if errors.Is(err, sql.ErrNoRows) {
+		return 0, storage.ErrFeatureNotFound
+	}
+
+	return id, err
+}
@@ -0,0 +1,128 @@
+package sqlite
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	"github.com/dvaxert/mdm/internal/domain/models"
+	"github.com/google/uuid"
+)
+
+func stringValue(s string) models.FeatureValue {
+	return models.FeatureValue{Type: models.FeatureString, Str: s}
+}
+
+// newWallpaperStorage returns a storage with the string feature wallpaper,
+// whose default is "default", and a registered device.
+func newWallpaperStorage(t *testing.T) (*Storage, uuid.UUID) {
+	t.Helper()
+
+	s := newStorage(t)
+
+	_, err := s.CreateFeature(context.Background(), models.Feature{
+		Name:    "wallpaper",
+		Type:    models.FeatureString,
+		Default: stringValue("default"),
+	})
+	if err != nil {
+		t.Fatalf("CreateFeature() error = %v", err)
+	}
+
+	return s, registerDevices(t, s, models.Ios)[0]
+}
+
+// resolveStep changes the storage and checks the resolved wallpaper of the
+// device and whether the change is reported for it.
+type resolveStep struct {
+	name    string
+	change  func() ([]uuid.UUID, error)
+	want    string
+	changed bool
+}
+
+func runResolveSteps(t *testing.T, s *Storage, device uuid.UUID, steps []resolveStep) {
+	t.Helper()
+
+	for _, step := range steps {
+		changed, err := step.change()
+		if err != nil {
+			t.Fatalf("%s: error = %v", step.name, err)
+		}
+
+		if got := slices.Contains(changed, device); got != step.changed {
+			t.Errorf("%s: device changed = %t, want %t", step.name, got, step.changed)
+		}
+
+		features, err := s.DeviceFeatures(context.Background(), device)
+		if err != nil {
+			t.Fatalf("%s: DeviceFeatures() error = %v", step.name, err)
+		}
+
+		if got := features.Features["wallpaper"].Str; got != step.want {
+			t.Errorf("%s: wallpaper = %q, want %q", step.name, got, step.want)
+		}
+	}
+}
+
+func TestResolveGroupPrecedence(t *testing.T) {
+	ctx := context.Background()
+	s, device := newWallpaperStorage(t)
+
+	// company > office > floor и отдельная annex, устройство в floor и annex
+	for _, g := range [][2]string{{"company", ""}, {"office", "company"}, {"floor", "office"}, {"annex", ""}} {
+		if err := s.CreateGroup(ctx, g[0], g[1]); err != nil {
+			t.Fatalf("CreateGroup() error = %v", err)
+		}
+	}
+	for _, g := range []string{"floor", "annex"} {
+		if _, err := s.AddGroupDevice(ctx, g, device); err != nil {
+			t.Fatalf("AddGroupDevice() error = %v", err)
+		}
+	}
+
+	set := func(group, value string) func() ([]uuid.UUID, error) {
+		return func() ([]uuid.UUID, error) { return s.SetGroupFeature(ctx, group, "wallpaper", stringValue(value)) }
+	}
+	unset := func(groups ...string) func() ([]uuid.UUID, error) {
+		return func() ([]uuid.UUID, error) {
+			var result []uuid.UUID
+			for _, group := range groups {
+				changed, err := s.ClearGroupFeature(ctx, group, "wallpaper")
+				if err != nil {
+					return nil, err
+				}
+				result = append(result, changed...)
+			}
+			return result, nil
+		}
+	}
+
+	runResolveSteps(t, s, device, []resolveStep{
+		{name: "root group", change: set("company", "company"), want: "company", changed: true},
+		{name: "nearer ancestor", change: set("office", "office"), want: "office", changed: true},
+		{name: "farther ancestor", change: set("company", "company 2"), want: "office", changed: false},
+		{name: "direct group", change: set("floor", "floor"), want: "floor", changed: true},
+		{name: "same distance, first by name", change: set("annex", "annex"), want: "annex", changed: true},
+		{name: "same distance, last by name", change: set("floor", "floor 2"), want: "annex", changed: false},
+		{name: "group cleared", change: unset("annex"), want: "floor 2", changed: true},
+		{
+			name: "value set on the device",
+			change: func() ([]uuid.UUID, error) {
+				_, err := s.UpdateDeviceFeature(ctx, device, "wallpaper", stringValue("device"))
+				return []uuid.UUID{device}, err
+			},
+			want:    "device",
+			changed: true,
+		},
+		{name: "group under a device value", change: set("floor", "floor 3"), want: "device", changed: false},
+		{
+			name:    "device value reset",
+			change:  func() ([]uuid.UUID, error) { return s.ResetDeviceFeature(ctx, device, "wallpaper") },
+			want:    "floor 3",
+			changed: true,
+		},
+		{name: "ancestors only", change: unset("floor", "office"), want: "company 2", changed: true},
+		{name: "catalog default", change: unset("company"), want: "default", changed: true},
+	})
+}
@@ -0,0 +1,130 @@
+package sqlite
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/dvaxert/mdm/internal/domain/models"
+	"github.com/google/uuid"
+)
+
+// DeviceStatusHistory returns the statuses reported by the device in the
+// time range, oldest first.
+func (s *Storage) DeviceStatusHistory(
+	ctx context.Context,
+	device_uuid uuid.UUID,
+	from time.Time,
+	to time.Time,
+) ([]models.StatusPoint, error) {
+	const op = "storage.sqlite.DeviceStatusHistory"
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	tx, err := s.db.Begin()
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer tx.Rollback()
+
+	device_id, err := deviceId(ctx, tx, device_uuid)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	rows, err := tx.QueryContext(
+		ctx,
+		`SELECT reported_at, location, battery
+		 FROM device_status_history
+		 WHERE device_id = ? AND reported_at BETWEEN ? AND ?
+		 ORDER BY reported_at, id;`,
+		device_id, from.Unix(), to.Unix(),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	var result []models.StatusPoint
+	for rows.Next() {
+		var (
+			point models.StatusPoint
+			at    int64
+		)
+		if err = rows.Scan(&at, &point.Location, &point.Battery); err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		point.Time = time.Unix(at, 0)
+
+		result = append(result, point)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return result, nil
+}
+
+// CompactStatusHistory removes the points older than removeBefore and keeps
+// only the last point of every interval among the points older than
+// downsampleBefore. It returns the number of removed points.
+func (s *Storage) CompactStatusHistory(
+	ctx context.Context,
+	removeBefore time.Time,
+	downsampleBefore time.Time,
+	interval time.Duration,
+) (int64, error) {
+	const op = "storage.sqlite.CompactStatusHistory"
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	tx, err := s.db.Begin()
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+	defer tx.Rollback()
+
+	res, err := tx.ExecContext(ctx, "DELETE FROM device_status_history WHERE reported_at < ?;", removeBefore.Unix())
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	removed, err := res.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	// интервалы отсчитываются от начала эпохи, поэтому повторное
+	// прореживание не сдвигает их границы
+	if seconds := int64(interval / time.Second); seconds > 0 {
+		res, err = tx.ExecContext(
+			ctx,
+			`DELETE FROM device_status_history
+			 WHERE reported_at < ?1 AND id NOT IN (
+				SELECT MAX(id) FROM device_status_history
+				WHERE reported_at < ?1
+				GROUP BY device_id, reported_at / ?2
+			 );`,
+			downsampleBefore.Unix(), seconds,
+		)
+		if err != nil {
+			return 0, fmt.Errorf("%s: %w", op, err)
+		}
+
+		downsampled, err := res.RowsAffected()
+		if err != nil {
+			return 0, fmt.Errorf("%s: %w", op, err)
+		}
+
+		removed += downsampled
+	}
+
+	if err = tx.Commit(); err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return removed, nil
+}
@@ -0,0 +1,239 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/dvaxert/mdm/internal/domain/models"
+	"github.com/dvaxert/mdm/internal/server/storage"
+	"github.com/google/uuid"
+)
+
+// ReportInventory stores the inventory reported by the device. When it equals
+// the latest stored version only the confirmation time is updated, otherwise
+// a new version is stored with its differences from the previous one and
+// the installed apps of the device are replaced. It returns the latest
+// snapshot and whether a new version was stored.
+func (s *Storage) ReportInventory(
+	ctx context.Context,
+	device_uuid uuid.UUID,
+	inventory models.Inventory,
+) (models.InventorySnapshot, bool, error) {
+	const op = "storage.sqlite.ReportInventory"
+
+	inventory.Normalize()
+
+	data, err := json.Marshal(inventory)
+	if err != nil {
+		return models.InventorySnapshot{}, false, fmt.Errorf("%s: %w", op, err)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	tx, err := s.db.Begin()
+	if err != nil {
+		return models.InventorySnapshot{}, false, fmt.Errorf("%s: %w", op, err)
+	}
+	defer tx.Rollback()
+
+	id, err := deviceId(ctx, tx, device_uuid)
+	if err != nil {
+		return models.InventorySnapshot{}, false, fmt.Errorf("%s: %w", op, err)
+	}
+
+	var (
+		snapshot_id int64
+		version     int64
+		previous    string
+		reported    int64
+	)
+	err = tx.QueryRowContext(
+		ctx,
+		"SELECT id, version, data, reported_at FROM inventory_snapshots WHERE device_id = ? ORDER BY version DESC LIMIT 1;",
+		id,
+	).Scan(&snapshot_id, &version, &previous, &reported)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return models.InventorySnapshot{}, false, fmt.Errorf("%s: %w", op, err)
+	}
+
+	// инвентарь не изменился, подтверждаем последнюю версию
+	if version != 0 && previous == string(data) {
+		var confirmed int64
+		err = tx.QueryRowContext(
+			ctx,
+			"UPDATE inventory_snapshots SET confirmed_at = unixepoch() WHERE id = ? RETURNING confirmed_at;",
+			snapshot_id,
+		).Scan(&confirmed)
+		if err != nil {
+			return models.InventorySnapshot{}, false, fmt.Errorf("%s: %w", op, err)
+		}
+
+		if err = tx.Commit(); err != nil {
+			return models.InventorySnapshot{}, false, fmt.Errorf("%s: %w", op, err)
+		}
+
+		return models.InventorySnapshot{
+			DeviceUuid:    device_uuid,
+			Version:       version,
+			LatestVersion: version,
+			Inventory:     inventory,
+			ReportedAt:    unixTime(sql.NullInt64{Int64: reported, Valid: true}),
+			ConfirmedAt:   unixTime(sql.NullInt64{Int64: confirmed, Valid: true}),
+		}, false, nil
+	}
+
+	var changes []models.InventoryChange
+	if version != 0 {
+		var last models.Inventory
+		if err = json.Unmarshal([]byte(previous), &last); err != nil {
+			return models.InventorySnapshot{}, false, fmt.Errorf("%s: %w", op, err)
+		}
+
+		changes = models.InventoryChanges(last, inventory)
+	}
+
+	version++
+
+	err = tx.QueryRowContext(
+		ctx,
+		`INSERT INTO inventory_snapshots (device_id, version, data, reported_at, confirmed_at)
+		VALUES (?, ?, ?, unixepoch(), unixepoch())
+		RETURNING id, reported_at;`,
+		id, version, string(data),
+	).Scan(&snapshot_id, &reported)
+	if err != nil {
+		return models.InventorySnapshot{}, false, fmt.Errorf("%s: %w", op, err)
+	}
+
+	for _, c := range changes {
+		_, err = tx.ExecContext(
+			ctx,
+			"INSERT INTO inventory_changes (snapshot_id, field, previous, current) VALUES (?, ?, ?, ?);",
+			snapshot_id, c.Field, c.Previous, c.Current,
+		)
+		if err != nil {
+			return models.InventorySnapshot{}, false, fmt.Errorf("%s: %w", op, err)
+		}
+	}
+
+	if _, err = tx.ExecContext(ctx, "DELETE FROM device_apps WHERE device_id = ?;", id); err != nil {
+		return models.InventorySnapshot{}, false, fmt.Errorf("%s: %w", op, err)
+	}
+
+	for _, a := range inventory.Apps {
+		_, err = tx.ExecContext(
+			ctx,
+			"INSERT INTO device_apps (device_id, app_id, name, version) VALUES (?, ?, ?, ?);",
+			id, a.Id, a.Name, a.Version,
+		)
+		if err != nil {
+			return models.InventorySnapshot{}, false, fmt.Errorf("%s: %w", op, err)
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		return models.InventorySnapshot{}, false, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return models.InventorySnapshot{
+		DeviceUuid:    device_uuid,
+		Version:       version,
+		LatestVersion: version,
+		Inventory:     inventory,
+		Changes:       changes,
+		ReportedAt:    unixTime(sql.NullInt64{Int64: reported, Valid: true}),
+		ConfirmedAt:   unixTime(sql.NullInt64{Int64: reported, Valid: true}),
+	}, true, nil
+}
+
+// DeviceInventory returns the version of the device inventory with its
+// differences from the previous version, zero version is the latest one.
+func (s *Storage) DeviceInventory(
+	ctx context.Context,
+	device_uuid uuid.UUID,
+	version int64,
+) (models.InventorySnapshot, error) {
+	const op = "storage.sqlite.DeviceInventory"
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	tx, err := s.db.Begin()
+	if err != nil {
+		return models.InventorySnapshot{}, fmt.Errorf("%s: %w", op, err)
+	}
+	defer tx.Rollback()
+
+	id, err := deviceId(ctx, tx, device_uuid)
+	if err != nil {
+		return models.InventorySnapshot{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	snapshot := models.InventorySnapshot{DeviceUuid: device_uuid}
+
+	err = tx.QueryRowContext(
+		ctx,
+		"SELECT COALESCE(MAX(version), 0) FROM inventory_snapshots WHERE device_id = ?;",
+		id,
+	).Scan(&snapshot.LatestVersion)
+	if err != nil {
+		return models.InventorySnapshot{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if version == 0 {
+		version = snapshot.LatestVersion
+	}
+
+	var (
+		snapshot_id         int64
+		data                string
+		reported, confirmed sql.NullInt64
+	)
+	err = tx.QueryRowContext(
+		ctx,
+		"SELECT id, version, data, reported_at, confirmed_at FROM inventory_snapshots WHERE device_id = ? AND version = ?;",
+		id, version,
+	).Scan(&snapshot_id, &snapshot.Version, &data, &reported, &confirmed)
+	if errors.Is(err, sql.ErrNoRows) {
+		return models.InventorySnapshot{}, fmt.Errorf("%s: %w", op, storage.ErrInventoryNotFound)
+	}
+	if err != nil {
+		return models.InventorySnapshot{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err = json.Unmarshal([]byte(data), &snapshot.Inventory); err != nil {
+		return models.InventorySnapshot{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	snapshot.ReportedAt = unixTime(reported)
+	snapshot.ConfirmedAt = unixTime(confirmed)
+
+	rows, err := tx.QueryContext(
+		ctx,
+		"SELECT field, previous, current FROM inventory_changes WHERE snapshot_id = ? ORDER BY rowid;",
+		snapshot_id,
+	)
+	if err != nil {
+		return models.InventorySnapshot{}, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var c models.InventoryChange
+		if err = rows.Scan(&c.Field, &c.Previous, &c.Current); err != nil {
+			return models.InventorySnapshot{}, fmt.Errorf("%s: %w", op, err)
+		}
+
+		snapshot.Changes = append(snapshot.Changes, c)
+	}
+
+	if err = rows.Err(); err != nil {
+		return models.InventorySnapshot{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return snapshot, nil
+}
@@ -0,0 +1,207 @@
+package sqlite
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/dvaxert/mdm/internal/domain/models"
+	"github.com/google/uuid"
+)
+
+// UpdateDeviceLabels sets and removes labels of the device. Labels set here
+// take precedence over the labels reported by the device at registration.
+func (s *Storage) UpdateDeviceLabels(
+	ctx context.Context,
+	device_uuid uuid.UUID,
+	set map[string]string,
+	remove []string,
+) error {
+	const op = "storage.sqlite.UpdateDeviceLabels"
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	tx, err := s.db.Begin()
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	defer tx.Rollback()
+
+	device_id, err := deviceId(ctx, tx, device_uuid)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	for _, key := range remove {
+		_, err = tx.ExecContext(ctx, "DELETE FROM device_labels WHERE device_id = ? AND key = ?;", device_id, key)
+		if err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+	}
+
+	for key, value := range set {
+		_, err = tx.ExecContext(
+			ctx,
+			`INSERT INTO device_labels(device_id, key, value, reported) VALUES(?, ?, ?, 0)
+			 ON CONFLICT(device_id, key) DO UPDATE SET value = excluded.value, reported = 0;`,
+			device_id, key, value,
+		)
+		if err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+// deviceLabels returns the labels selected by the where clause, keyed by
+// device id.
+func (s *Storage) deviceLabels(ctx context.Context, where string, args ...any) (map[int64]map[string]string, error) {
+	rows, err := s.db.QueryContext(ctx, "SELECT device_id, key, value FROM device_labels "+where+";", args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	result := make(map[int64]map[string]string)
+	for rows.Next() {
+		var (
+			id    int64
+			key   string
+			value string
+		)
+		if err = rows.Scan(&id, &key, &value); err != nil {
+			return nil, err
+		}
+
+		if result[id] == nil {
+			result[id] = make(map[string]string)
+		}
+
+		result[id][key] = value
+	}
+
+	return result, rows.Err()
+}
+
+// deviceFilter returns an SQL condition on devices aliased as d that holds
+// for the devices matching the filter, and its arguments.
+func deviceFilter(filter models.DeviceFilter) (string, []any) {
+	conditions, args := selectorConditions(filter.Selector)
+
+	if len(filter.Types) != 0 {
+		conditions = append(conditions, "d.type IN ("+placeholders(len(filter.Types))+")")
+		for _, t := range filter.Types {
+			args = append(args, t)
+		}
+	}
+
+	if filter.BatteryMin != nil {
+		conditions = append(conditions, "EXISTS (SELECT 1 FROM device_statuses WHERE device_id = d.id AND battery >= ?)")
+		args = append(args, *filter.BatteryMin)
+	}
+
+	if filter.BatteryMax != nil {
+		conditions = append(conditions, "EXISTS (SELECT 1 FROM device_statuses WHERE device_id = d.id AND battery <= ?)")
+		args = append(args, *filter.BatteryMax)
+	}
+
+	if filter.Scope != nil {
+		condition, scope_args := scopeCondition(*filter.Scope)
+		conditions = append(conditions, condition)
+		args = append(args, scope_args...)
+	}
+
+	// выведенные из эксплуатации устройства видны только по явному фильтру
+	if len(filter.Lifecycles) != 0 {
+		conditions = append(conditions, "d.lifecycle IN ("+placeholders(len(filter.Lifecycles))+")")
+		for _, l := range filter.Lifecycles {
+			args = append(args, l)
+		}
+	} else {
+		conditions = append(conditions, "d.lifecycle NOT IN (?, ?)")
+		args = append(args, models.LifecycleRetired, models.LifecycleWiped)
+	}
+
+	return strings.Join(conditions, " AND "), args
+}
+
+// selectorConditions returns the SQL conditions on devices aliased as d that
+// all hold for the devices matching the selector, and their arguments.
+func selectorConditions(selector models.Selector) ([]string, []any) {
+	var (
+		conditions []string
+		args       []any
+	)
+
+	for _, r := range selector {
+		label := "SELECT 1 FROM device_labels WHERE device_id = d.id AND key = ?"
+		args = append(args, r.Key)
+
+		switch r.Op {
+		case models.SelectorEquals, models.SelectorNotEquals, models.SelectorIn, models.SelectorNotIn:
+			label += " AND value IN (" + placeholders(len(r.Values)) + ")"
+			for _, v := range r.Values {
+				args = append(args, v)
+			}
+		}
+
+		switch r.Op {
+		case models.SelectorEquals, models.SelectorIn, models.SelectorExists:
+			conditions = append(conditions, "EXISTS ("+label+")")
+		default:
+			conditions = append(conditions, "NOT EXISTS ("+label+")")
+		}
+	}
+
+	return conditions, args
+}
+
+// scopeCondition returns an SQL condition on devices aliased as d that holds
+// for the devices of the scope, and its arguments.
+func scopeCondition(scope models.DeviceScope) (string, []any) {
+	var (
+		alternatives []string
+		args         []any
+	)
+
+	if len(scope.Groups) != 0 {
+		alternatives = append(alternatives, `d.id IN (
+			WITH RECURSIVE subgroups(id) AS (
+				SELECT id FROM device_groups WHERE name IN (`+placeholders(len(scope.Groups))+`)
+				UNION
+				SELECT g.id FROM device_groups AS g JOIN subgroups AS s ON g.parent_id = s.id
+			)
+			SELECT device_id FROM device_group_members WHERE group_id IN (SELECT id FROM subgroups)
+		)`)
+		for _, group := range scope.Groups {
+			args = append(args, group)
+		}
+	}
+
+	// пустой селектор роли не выбирает устройств, как и в DeviceInScope
+	for _, selector := range scope.Selectors {
+		if len(selector) == 0 {
+			continue
+		}
+
+		conditions, selector_args := selectorConditions(selector)
+		alternatives = append(alternatives, "("+strings.Join(conditions, " AND ")+")")
+		args = append(args, selector_args...)
+	}
+
+	if len(alternatives) == 0 {
+		return "0", nil
+	}
+
+	return "(" + strings.Join(alternatives, " OR ") + ")", args
+}
+
+func placeholders(n int) string {
+	return strings.TrimSuffix(strings.Repeat("?,", n), ",")
+}
@@ -0,0 +1,104 @@
+package sqlite
+
+import (
+	"context"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/dvaxert/mdm/internal/domain/models"
+	"github.com/google/uuid"
+)
+
+func TestDeviceFilterScope(t *testing.T) {
+	ctx := context.Background()
+
+	s := newStorage(t)
+	devices := registerDevices(t, s, models.Ios, models.Ios, models.Ios, models.Ios)
+
+	// первое устройство в группе, второе в ее подгруппе, у третьего метка,
+	// четвертое ни в одной области
+	if err := s.CreateGroup(ctx, "office", ""); err != nil {
+		t.Fatalf("CreateGroup() error = %v", err)
+	}
+	if err := s.CreateGroup(ctx, "floor", "office"); err != nil {
+		t.Fatalf("CreateGroup() error = %v", err)
+	}
+	if _, err := s.AddGroupDevice(ctx, "office", devices[0]); err != nil {
+		t.Fatalf("AddGroupDevice() error = %v", err)
+	}
+	if _, err := s.AddGroupDevice(ctx, "floor", devices[1]); err != nil {
+		t.Fatalf("AddGroupDevice() error = %v", err)
+	}
+	if err := s.UpdateDeviceLabels(ctx, devices[2], map[string]string{"site": "oslo"}, nil); err != nil {
+		t.Fatalf("UpdateDeviceLabels() error = %v", err)
+	}
+
+	for _, device_uuid := range devices {
+		_, err := s.CreateCommand(ctx, models.Command{
+			DeviceUuid: device_uuid,
+			Type:       models.CommandReboot,
+			ExpiresAt:  time.Now().Add(time.Hour),
+		})
+		if err != nil {
+			t.Fatalf("CreateCommand() error = %v", err)
+		}
+	}
+
+	oslo, err := models.ParseSelector("site=oslo")
+	if err != nil {
+		t.Fatalf("ParseSelector() error = %v", err)
+	}
+
+	tests := []struct {
+		name  string
+		scope *models.DeviceScope
+		want  []uuid.UUID
+	}{
+		{name: "not scoped", want: devices},
+		{name: "empty scope", scope: &models.DeviceScope{}},
+		{name: "group with subgroups", scope: &models.DeviceScope{Groups: []string{"office"}}, want: devices[:2]},
+		{name: "subgroup", scope: &models.DeviceScope{Groups: []string{"floor"}}, want: devices[1:2]},
+		{name: "unknown group", scope: &models.DeviceScope{Groups: []string{"missing"}}},
+		{name: "selector", scope: &models.DeviceScope{Selectors: []models.Selector{oslo}}, want: devices[2:3]},
+		{name: "empty selector", scope: &models.DeviceScope{Selectors: []models.Selector{nil}}},
+		{
+			name:  "group or selector",
+			scope: &models.DeviceScope{Groups: []string{"floor"}, Selectors: []models.Selector{oslo}},
+			want:  devices[1:3],
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			listed, _, err := s.DeviceList(ctx, models.DeviceFilter{Scope: tt.scope}, models.Page{Size: 10})
+			if err != nil {
+				t.Fatalf("DeviceList() error = %v", err)
+			}
+
+			var got []uuid.UUID
+			for _, d := range listed {
+				got = append(got, d.Uuid)
+			}
+
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("DeviceList() = %v, want %v", got, tt.want)
+			}
+
+			commands, err := s.CommandList(ctx, models.CommandFilter{Scope: tt.scope})
+			if err != nil {
+				t.Fatalf("CommandList() error = %v", err)
+			}
+
+			// команды идут от новых к старым
+			got = nil
+			for _, c := range commands {
+				got = slices.Insert(got, 0, c.DeviceUuid)
+			}
+
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("CommandList() devices = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,112 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/dvaxert/mdm/internal/domain/models"
+	"github.com/dvaxert/mdm/internal/server/storage"
+	"github.com/google/uuid"
+)
+
+// SetDeviceLifecycle moves the device to the lifecycle state. A change the
+// operator can not make (see models.Lifecycle.CanChange) is reported with
+// storage.ErrLifecycleChange.
+//
+// Moving the device to pending or decommissioning it revokes its identity:
+// the certificates of the device are revoked and the commands it has not
+// completed expire, so that a device enrolled again starts clean. The
+// history of the device is kept.
+func (s *Storage) SetDeviceLifecycle(
+	ctx context.Context,
+	device_uuid uuid.UUID,
+	lifecycle models.Lifecycle,
+) (models.LifecycleChange, error) {
+	const op = "storage.sqlite.SetDeviceLifecycle"
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	tx, err := s.db.Begin()
+	if err != nil {
+		return models.LifecycleChange{}, fmt.Errorf("%s: %w", op, err)
+	}
+	defer tx.Rollback()
+
+	var (
+		id     int64
+		change = models.LifecycleChange{Current: lifecycle}
+	)
+	err = tx.QueryRowContext(
+		ctx,
+		"SELECT id, lifecycle FROM devices WHERE uuid = ?;",
+		device_uuid,
+	).Scan(&id, &change.Previous)
+	if errors.Is(err, sql.ErrNoRows) {
+		return models.LifecycleChange{}, fmt.Errorf("%s: %w", op, storage.ErrDeviceNotFound)
+	}
+	if err != nil {
+		return models.LifecycleChange{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if !change.Previous.CanChange(lifecycle) {
+		return models.LifecycleChange{}, fmt.Errorf("%s: %w", op, storage.ErrLifecycleChange)
+	}
+
+	if change.Previous == lifecycle {
+		return change, nil
+	}
+
+	_, err = tx.ExecContext(
+		ctx,
+		"UPDATE devices SET lifecycle = ?, lifecycle_changed_at = unixepoch() WHERE id = ?;",
+		lifecycle, id,
+	)
+	if err != nil {
+		return models.LifecycleChange{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if lifecycle.Revokes() {
+		if change.Certificates, err = revokeIdentity(ctx, tx, id); err != nil {
+			return models.LifecycleChange{}, fmt.Errorf("%s: %w", op, err)
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		return models.LifecycleChange{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return change, nil
+}
+
+// revokeIdentity revokes the certificates of the device and expires the
+// commands it has not completed. It returns the number of revoked
+// certificates.
+func revokeIdentity(ctx context.Context, tx *sql.Tx, device_id int64) (int, error) {
+	res, err := tx.ExecContext(
+		ctx,
+		"UPDATE certificates SET revoked_at = unixepoch() WHERE device_id = ? AND revoked_at IS NULL;",
+		device_id,
+	)
+	if err != nil {
+		return 0, err
+	}
+
+	revoked, err := res.RowsAffected()
+	if err != nil {
+		return 0, err
+	}
+
+	_, err = tx.ExecContext(
+		ctx,
+		"UPDATE commands SET status = ?, completed_at = unixepoch() WHERE device_id = ? AND status < ?;",
+		models.CommandExpired, device_id, models.CommandSucceeded,
+	)
+	if err != nil {
+		return 0, err
+	}
+
+	return int(revoked), nil
+}
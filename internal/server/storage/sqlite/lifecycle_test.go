@@ -0,0 +1,229 @@
+package sqlite
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/dvaxert/mdm/internal/domain/models"
+	"github.com/dvaxert/mdm/internal/server/storage"
+	"github.com/google/uuid"
+)
+
+// deviceIn registers a device and moves it to the lifecycle state.
+func deviceIn(t *testing.T, s *Storage, lifecycle models.Lifecycle) uuid.UUID {
+	t.Helper()
+
+	device := registerDevices(t, s, models.Ios)[0]
+	if lifecycle == models.LifecycleEnrolled {
+		return device
+	}
+
+	if _, err := s.SetDeviceLifecycle(context.Background(), device, lifecycle); err != nil {
+		t.Fatalf("SetDeviceLifecycle() error = %v", err)
+	}
+
+	return device
+}
+
+func lifecycleOf(t *testing.T, s *Storage, device uuid.UUID) models.Lifecycle {
+	t.Helper()
+
+	d, err := s.Device(context.Background(), device)
+	if err != nil {
+		t.Fatalf("Device() error = %v", err)
+	}
+
+	return d.Lifecycle
+}
+
+func TestRegisterDeviceLifecycle(t *testing.T) {
+	ctx := context.Background()
+	token := models.TokenHash("enrollment-token")
+
+	tests := []struct {
+		name          string
+		lifecycle     models.Lifecycle
+		new           bool
+		token         string
+		authenticated bool
+		wantEnrolled  bool
+		wantErr       error
+		want          models.Lifecycle
+		wantUses      int
+	}{
+		{name: "new device", new: true, wantEnrolled: true, want: models.LifecycleEnrolled},
+		{name: "new device with a token", new: true, token: token, wantEnrolled: true, want: models.LifecycleEnrolled, wantUses: 1},
+		{name: "enrolled", lifecycle: models.LifecycleEnrolled, want: models.LifecycleEnrolled},
+		{
+			name:      "enrolled with a token",
+			lifecycle: models.LifecycleEnrolled,
+			token:     token,
+			wantErr:   storage.ErrDeviceEnrolled,
+			want:      models.LifecycleEnrolled,
+		},
+		{
+			name:          "enrolled with a token and a certificate",
+			lifecycle:     models.LifecycleEnrolled,
+			token:         token,
+			authenticated: true,
+			want:          models.LifecycleEnrolled,
+			wantUses:      1,
+		},
+		{name: "pending", lifecycle: models.LifecyclePending, wantErr: storage.ErrDevicePending, want: models.LifecyclePending},
+		{
+			name:         "pending with a token",
+			lifecycle:    models.LifecyclePending,
+			token:        token,
+			wantEnrolled: true,
+			want:         models.LifecycleEnrolled,
+			wantUses:     1,
+		},
+		{
+			name:      "suspended",
+			lifecycle: models.LifecycleSuspended,
+			token:     token,
+			wantErr:   storage.ErrDeviceSuspended,
+			want:      models.LifecycleSuspended,
+		},
+		{
+			name:      "retired",
+			lifecycle: models.LifecycleRetired,
+			token:     token,
+			wantErr:   storage.ErrDeviceDecommissioned,
+			want:      models.LifecycleRetired,
+		},
+		{
+			name:      "wiped",
+			lifecycle: models.LifecycleWiped,
+			wantErr:   storage.ErrDeviceDecommissioned,
+			want:      models.LifecycleWiped,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := newStorage(t)
+
+			_, err := s.CreateEnrollmentToken(ctx, models.EnrollmentToken{MaxUses: 10, ExpiresAt: time.Now().Add(time.Hour)}, token)
+			if err != nil {
+				t.Fatalf("CreateEnrollmentToken() error = %v", err)
+			}
+
+			device := uuid.New()
+			if !tt.new {
+				device = deviceIn(t, s, tt.lifecycle)
+			}
+
+			enrolled, err := s.RegisterDevice(ctx, device, models.Ios, nil, tt.token, tt.authenticated)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("RegisterDevice() error = %v, want %v", err, tt.wantErr)
+			}
+
+			if enrolled != tt.wantEnrolled {
+				t.Errorf("RegisterDevice() enrolled = %t, want %t", enrolled, tt.wantEnrolled)
+			}
+
+			if got := lifecycleOf(t, s, device); got != tt.want {
+				t.Errorf("lifecycle = %s, want %s", got, tt.want)
+			}
+
+			// отказ в регистрации не тратит token
+			tokens, err := s.EnrollmentTokenList(ctx)
+			if err != nil {
+				t.Fatalf("EnrollmentTokenList() error = %v", err)
+			}
+
+			if tokens[0].Uses != tt.wantUses {
+				t.Errorf("token uses = %d, want %d", tokens[0].Uses, tt.wantUses)
+			}
+		})
+	}
+}
+
+func TestSetDeviceLifecycle(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name    string
+		from    models.Lifecycle
+		to      models.Lifecycle
+		wantErr error
+		revokes bool
+	}{
+		{name: "suspend", from: models.LifecycleEnrolled, to: models.LifecycleSuspended},
+		{name: "resume", from: models.LifecycleSuspended, to: models.LifecycleEnrolled},
+		{name: "re-enrollment", from: models.LifecycleEnrolled, to: models.LifecyclePending, revokes: true},
+		{name: "retire", from: models.LifecycleSuspended, to: models.LifecycleRetired, revokes: true},
+		{name: "wipe retired", from: models.LifecycleRetired, to: models.LifecycleWiped, revokes: true},
+		{name: "re-enroll wiped", from: models.LifecycleWiped, to: models.LifecyclePending, revokes: true},
+		{name: "same state", from: models.LifecycleEnrolled, to: models.LifecycleEnrolled},
+		{name: "enroll pending", from: models.LifecyclePending, to: models.LifecycleEnrolled, wantErr: storage.ErrLifecycleChange},
+		{name: "suspend pending", from: models.LifecyclePending, to: models.LifecycleSuspended, wantErr: storage.ErrLifecycleChange},
+		{name: "restore retired", from: models.LifecycleRetired, to: models.LifecycleEnrolled, wantErr: storage.ErrLifecycleChange},
+		{name: "suspend wiped", from: models.LifecycleWiped, to: models.LifecycleSuspended, wantErr: storage.ErrLifecycleChange},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := newStorage(t)
+
+			// устройство переводится в from через enrolled, поэтому команда
+			// создается до перевода и может быть уже закрыта
+			device := registerDevices(t, s, models.Ios)[0]
+			command, err := s.CreateCommand(ctx, models.Command{
+				DeviceUuid: device,
+				Type:       models.CommandReboot,
+				ExpiresAt:  time.Now().Add(time.Hour),
+			})
+			if err != nil {
+				t.Fatalf("CreateCommand() error = %v", err)
+			}
+
+			if tt.from != models.LifecycleEnrolled {
+				if _, err = s.SetDeviceLifecycle(ctx, device, tt.from); err != nil {
+					t.Fatalf("SetDeviceLifecycle() error = %v", err)
+				}
+			}
+			before, err := s.Command(ctx, command)
+			if err != nil {
+				t.Fatalf("Command() error = %v", err)
+			}
+
+			change, err := s.SetDeviceLifecycle(ctx, device, tt.to)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("SetDeviceLifecycle() error = %v, want %v", err, tt.wantErr)
+			}
+
+			want := tt.to
+			if tt.wantErr != nil {
+				want = tt.from
+			} else if change.Previous != tt.from || change.Current != tt.to {
+				t.Errorf("SetDeviceLifecycle() = %+v, want %s -> %s", change, tt.from, tt.to)
+			}
+
+			if got := lifecycleOf(t, s, device); got != want {
+				t.Errorf("lifecycle = %s, want %s", got, want)
+			}
+
+			after, err := s.Command(ctx, command)
+			if err != nil {
+				t.Fatalf("Command() error = %v", err)
+			}
+
+			wantStatus := before.Status
+			if tt.revokes && wantStatus < models.CommandSucceeded {
+				wantStatus = models.CommandExpired
+			}
+
+			if after.Status != wantStatus {
+				t.Errorf("command status = %s, want %s", after.Status, wantStatus)
+			}
+		})
+	}
+
+	if _, err := newStorage(t).SetDeviceLifecycle(ctx, uuid.New(), models.LifecycleRetired); !errors.Is(err, storage.ErrDeviceNotFound) {
+		t.Errorf("SetDeviceLifecycle() of an unknown device error = %v, want %v", err, storage.ErrDeviceNotFound)
+	}
+}
@@ -0,0 +1,697 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/dvaxert/mdm/internal/domain/models"
+	"github.com/dvaxert/mdm/internal/server/storage"
+	"github.com/google/uuid"
+)
+
+// CreateOperator stores the operator. Its roles must be built-in roles or
+// roles stored with CreateRole.
+func (s *Storage) CreateOperator(ctx context.Context, operator models.Operator) error {
+	const op = "storage.sqlite.CreateOperator"
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	tx, err := s.db.Begin()
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	defer tx.Rollback()
+
+	var exists bool
+	err = tx.QueryRowContext(ctx, "SELECT EXISTS (SELECT 1 FROM operators WHERE name = ?);", operator.Name).Scan(&exists)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if exists {
+		return fmt.Errorf("%s: %w", op, storage.ErrOperatorExists)
+	}
+
+	if err = insertOperator(ctx, tx, operator, false); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err = tx.Commit(); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+// DeleteOperator removes the operator.
+func (s *Storage) DeleteOperator(ctx context.Context, name string) error {
+	const op = "storage.sqlite.DeleteOperator"
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	tx, err := s.db.Begin()
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	defer tx.Rollback()
+
+	id, err := operatorId(ctx, tx, name)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err = deleteOperator(ctx, tx, id); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err = tx.Commit(); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+func (s *Storage) Operator(ctx context.Context, name string) (models.Operator, error) {
+	const op = "storage.sqlite.Operator"
+
+	return s.operator(ctx, op, "WHERE o.name = ?", name)
+}
+
+// OperatorByToken returns the operator with the API token.
+func (s *Storage) OperatorByToken(ctx context.Context, token_hash string) (models.Operator, error) {
+	const op = "storage.sqlite.OperatorByToken"
+
+	return s.operator(ctx, op, "WHERE o.token_hash = ?", token_hash)
+}
+
+// OperatorList returns all operators ordered by name.
+func (s *Storage) OperatorList(ctx context.Context) ([]models.Operator, error) {
+	const op = "storage.sqlite.OperatorList"
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	tx, err := s.db.Begin()
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer tx.Rollback()
+
+	result, err := operators(ctx, tx, "ORDER BY o.name")
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return result, nil
+}
+
+// SetOperatorRoles replaces the roles of the operator.
+func (s *Storage) SetOperatorRoles(ctx context.Context, name string, roles []string) error {
+	const op = "storage.sqlite.SetOperatorRoles"
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	tx, err := s.db.Begin()
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	defer tx.Rollback()
+
+	id, err := operatorId(ctx, tx, name)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if _, err = tx.ExecContext(ctx, "DELETE FROM operator_roles WHERE operator_id = ?;", id); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err = insertOperatorRoles(ctx, tx, id, roles); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err = tx.Commit(); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+// SetOperatorToken replaces the API token of the operator.
+func (s *Storage) SetOperatorToken(ctx context.Context, name string, token_hash string) error {
+	const op = "storage.sqlite.SetOperatorToken"
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	res, err := s.db.ExecContext(ctx, "UPDATE operators SET token_hash = ? WHERE name = ?;", token_hash, name)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	n, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if n == 0 {
+		return fmt.Errorf("%s: %w", op, storage.ErrOperatorNotFound)
+	}
+
+	return nil
+}
+
+// SyncConfigOperators makes the operators defined in the server config the
+// only operators marked as such. Operators created through the API with the
+// same names are replaced.
+func (s *Storage) SyncConfigOperators(ctx context.Context, list []models.Operator) error {
+	const op = "storage.sqlite.SyncConfigOperators"
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	tx, err := s.db.Begin()
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	defer tx.Rollback()
+
+	names := make([]any, 0, len(list))
+	for _, o := range list {
+		names = append(names, o.Name)
+	}
+
+	rows, err := tx.QueryContext(
+		ctx,
+		`SELECT id FROM operators
+		 WHERE from_config = 1 OR name IN (`+placeholders(len(names))+`);`,
+		names...,
+	)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	var stale []int64
+	for rows.Next() {
+		var id int64
+		if err = rows.Scan(&id); err != nil {
+			rows.Close()
+			return fmt.Errorf("%s: %w", op, err)
+		}
+
+		stale = append(stale, id)
+	}
+	rows.Close()
+
+	if err = rows.Err(); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	for _, id := range stale {
+		if err = deleteOperator(ctx, tx, id); err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+	}
+
+	for _, o := range list {
+		if err = insertOperator(ctx, tx, o, true); err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+// CreateRole stores the custom role.
+func (s *Storage) CreateRole(ctx context.Context, role models.Role) error {
+	const op = "storage.sqlite.CreateRole"
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	tx, err := s.db.Begin()
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	defer tx.Rollback()
+
+	var exists bool
+	err = tx.QueryRowContext(ctx, "SELECT EXISTS (SELECT 1 FROM roles WHERE name = ?);", role.Name).Scan(&exists)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if exists {
+		return fmt.Errorf("%s: %w", op, storage.ErrRoleExists)
+	}
+
+	for _, group := range role.Groups {
+		if _, err = groupId(ctx, tx, group); err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+	}
+
+	var id int64
+	err = tx.QueryRowContext(
+		ctx,
+		"INSERT INTO roles(name, description, selector) VALUES(?, ?, ?) RETURNING id;",
+		role.Name, role.Description, role.Selector,
+	).Scan(&id)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	for _, p := range role.Permissions {
+		_, err = tx.ExecContext(ctx, "INSERT OR IGNORE INTO role_permissions(role_id, permission) VALUES(?, ?);", id, p)
+		if err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+	}
+
+	for _, group := range role.Groups {
+		_, err = tx.ExecContext(ctx, "INSERT OR IGNORE INTO role_groups(role_id, group_name) VALUES(?, ?);", id, group)
+		if err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+// DeleteRole removes the custom role, a role granted to an operator can not
+// be removed.
+func (s *Storage) DeleteRole(ctx context.Context, name string) error {
+	const op = "storage.sqlite.DeleteRole"
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	tx, err := s.db.Begin()
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	defer tx.Rollback()
+
+	var id int64
+	err = tx.QueryRowContext(ctx, "SELECT id FROM roles WHERE name = ?;", name).Scan(&id)
+	if errors.Is(err, sql.ErrNoRows) {
+		return fmt.Errorf("%s: %w", op, storage.ErrRoleNotFound)
+	}
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	var used bool
+	err = tx.QueryRowContext(ctx, "SELECT EXISTS (SELECT 1 FROM operator_roles WHERE role = ?);", name).Scan(&used)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if used {
+		return fmt.Errorf("%s: %w", op, storage.ErrRoleInUse)
+	}
+
+	for _, query := range []string{
+		"DELETE FROM role_permissions WHERE role_id = ?;",
+		"DELETE FROM role_groups WHERE role_id = ?;",
+		"DELETE FROM roles WHERE id = ?;",
+	} {
+		if _, err = tx.ExecContext(ctx, query, id); err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+// Roles returns the custom roles with the names, all of them if names is
+// empty, ordered by name. Names of unknown roles are skipped.
+func (s *Storage) Roles(ctx context.Context, names []string) ([]models.Role, error) {
+	const op = "storage.sqlite.Roles"
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	tx, err := s.db.Begin()
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer tx.Rollback()
+
+	where, args := "", []any{}
+	if len(names) != 0 {
+		where = "WHERE name IN (" + placeholders(len(names)) + ")"
+		for _, name := range names {
+			args = append(args, name)
+		}
+	}
+
+	result, err := roles(ctx, tx, where, args...)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return result, nil
+}
+
+// DeviceInScope reports whether the device is a member of one of the groups,
+// subgroups included, or matches the selector. An empty selector matches no
+// devices here.
+func (s *Storage) DeviceInScope(
+	ctx context.Context,
+	device_uuid uuid.UUID,
+	groups []string,
+	selector models.Selector,
+) (bool, error) {
+	const op = "storage.sqlite.DeviceInScope"
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	tx, err := s.db.Begin()
+	if err != nil {
+		return false, fmt.Errorf("%s: %w", op, err)
+	}
+	defer tx.Rollback()
+
+	device_id, err := deviceId(ctx, tx, device_uuid)
+	if err != nil {
+		return false, fmt.Errorf("%s: %w", op, err)
+	}
+
+	for _, group := range groups {
+		group_id, err := groupId(ctx, tx, group)
+		if errors.Is(err, storage.ErrGroupNotFound) {
+			continue
+		}
+		if err != nil {
+			return false, fmt.Errorf("%s: %w", op, err)
+		}
+
+		members, err := groupMembers(ctx, tx, group_id)
+		if err != nil {
+			return false, fmt.Errorf("%s: %w", op, err)
+		}
+
+		if _, ok := members[device_id]; ok {
+			return true, nil
+		}
+	}
+
+	if len(selector) == 0 {
+		return false, nil
+	}
+
+	// область роли не зависит от состояния устройства
+	filter := models.DeviceFilter{Selector: selector}
+	for l := models.Lifecycle(0); l < models.LifecycleCount; l++ {
+		filter.Lifecycles = append(filter.Lifecycles, l)
+	}
+
+	condition, args := deviceFilter(filter)
+
+	var matches bool
+	err = tx.QueryRowContext(
+		ctx,
+		"SELECT EXISTS (SELECT 1 FROM devices AS d WHERE d.id = ? AND "+condition+");",
+		append([]any{device_id}, args...)...,
+	).Scan(&matches)
+	if err != nil {
+		return false, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return matches, nil
+}
+
+// GroupInScope reports whether the group is one of the groups or their
+// subgroup.
+func (s *Storage) GroupInScope(ctx context.Context, group string, groups []string) (bool, error) {
+	const op = "storage.sqlite.GroupInScope"
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if len(groups) == 0 {
+		return false, nil
+	}
+
+	args := []any{group}
+	for _, g := range groups {
+		args = append(args, g)
+	}
+
+	var result bool
+	err := s.db.QueryRowContext(
+		ctx,
+		`WITH RECURSIVE ancestors(id, parent_id, name) AS (
+			SELECT id, parent_id, name FROM device_groups WHERE name = ?
+			UNION
+			SELECT g.id, g.parent_id, g.name FROM device_groups AS g JOIN ancestors AS a ON g.id = a.parent_id
+		 )
+		 SELECT EXISTS (SELECT 1 FROM ancestors WHERE name IN (`+placeholders(len(groups))+`));`,
+		args...,
+	).Scan(&result)
+	if err != nil {
+		return false, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return result, nil
+}
+
+func (s *Storage) operator(ctx context.Context, op string, where string, args ...any) (models.Operator, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	tx, err := s.db.Begin()
+	if err != nil {
+		return models.Operator{}, fmt.Errorf("%s: %w", op, err)
+	}
+	defer tx.Rollback()
+
+	list, err := operators(ctx, tx, where, args...)
+	if err != nil {
+		return models.Operator{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if len(list) == 0 {
+		return models.Operator{}, fmt.Errorf("%s: %w", op, storage.ErrOperatorNotFound)
+	}
+
+	return list[0], nil
+}
+
+func insertOperator(ctx context.Context, tx *sql.Tx, operator models.Operator, from_config bool) error {
+	var token_hash sql.NullString
+	if operator.TokenHash != "" {
+		token_hash = sql.NullString{String: operator.TokenHash, Valid: true}
+	}
+
+	var id int64
+	err := tx.QueryRowContext(
+		ctx,
+		"INSERT INTO operators(name, token_hash, from_config, created_at) VALUES(?, ?, ?, unixepoch()) RETURNING id;",
+		operator.Name, token_hash, from_config,
+	).Scan(&id)
+	if err != nil {
+		return err
+	}
+
+	return insertOperatorRoles(ctx, tx, id, operator.Roles)
+}
+
+// insertOperatorRoles grants the roles to the operator, the roles must
+// exist.
+func insertOperatorRoles(ctx context.Context, tx *sql.Tx, operator_id int64, names []string) error {
+	for _, name := range names {
+		if _, ok := models.BuiltinRole(name); !ok {
+			var exists bool
+			err := tx.QueryRowContext(ctx, "SELECT EXISTS (SELECT 1 FROM roles WHERE name = ?);", name).Scan(&exists)
+			if err != nil {
+				return err
+			}
+
+			if !exists {
+				return fmt.Errorf("%w: %s", storage.ErrRoleNotFound, name)
+			}
+		}
+
+		_, err := tx.ExecContext(ctx, "INSERT OR IGNORE INTO operator_roles(operator_id, role) VALUES(?, ?);", operator_id, name)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func deleteOperator(ctx context.Context, tx *sql.Tx, id int64) error {
+	if _, err := tx.ExecContext(ctx, "DELETE FROM operator_roles WHERE operator_id = ?;", id); err != nil {
+		return err
+	}
+
+	_, err := tx.ExecContext(ctx, "DELETE FROM operators WHERE id = ?;", id)
+
+	return err
+}
+
+func operatorId(ctx context.Context, tx *sql.Tx, name string) (int64, error) {
+	var id int64
+	err := tx.QueryRowContext(ctx, "SELECT id FROM operators WHERE name = ?;", name).Scan(&id)
+	if errors.Is(err, sql.ErrNoRows) {
+		return 0, storage.ErrOperatorNotFound
+	}
+
+	return id, err
+}
+
+func operators(ctx context.Context, tx *sql.Tx, where string, args ...any) ([]models.Operator, error) {
+	rows, err := tx.QueryContext(
+		ctx,
+		`SELECT o.id, o.name, COALESCE(o.token_hash, ''), o.from_config, o.created_at
+		 FROM operators AS o
+		 `+where+`;`,
+		args...,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var (
+		result []models.Operator
+		ids    []int64
+	)
+	for rows.Next() {
+		var (
+			operator models.Operator
+			id       int64
+			created  int64
+		)
+		err = rows.Scan(&id, &operator.Name, &operator.TokenHash, &operator.FromConfig, &created)
+		if err != nil {
+			return nil, err
+		}
+
+		operator.CreatedAt = time.Unix(created, 0)
+
+		result = append(result, operator)
+		ids = append(ids, id)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	for i, id := range ids {
+		names, err := tx.QueryContext(ctx, "SELECT role FROM operator_roles WHERE operator_id = ? ORDER BY role;", id)
+		if err != nil {
+			return nil, err
+		}
+
+		for names.Next() {
+			var name string
+			if err = names.Scan(&name); err != nil {
+				names.Close()
+				return nil, err
+			}
+
+			result[i].Roles = append(result[i].Roles, name)
+		}
+		names.Close()
+
+		if err = names.Err(); err != nil {
+			return nil, err
+		}
+	}
+
+	return result, nil
+}
+
+func roles(ctx context.Context, tx *sql.Tx, where string, args ...any) ([]models.Role, error) {
+	rows, err := tx.QueryContext(ctx, "SELECT id, name, description, selector FROM roles "+where+" ORDER BY name;", args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var (
+		result []models.Role
+		ids    []int64
+	)
+	for rows.Next() {
+		var (
+			role models.Role
+			id   int64
+		)
+		if err = rows.Scan(&id, &role.Name, &role.Description, &role.Selector); err != nil {
+			return nil, err
+		}
+
+		result = append(result, role)
+		ids = append(ids, id)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	for i, id := range ids {
+		permissions, err := tx.QueryContext(ctx, "SELECT permission FROM role_permissions WHERE role_id = ? ORDER BY permission;", id)
+		if err != nil {
+			return nil, err
+		}
+
+		for permissions.Next() {
+			var p models.Permission
+			if err = permissions.Scan(&p); err != nil {
+				permissions.Close()
+				return nil, err
+			}
+
+			result[i].Permissions = append(result[i].Permissions, p)
+		}
+		permissions.Close()
+
+		if err = permissions.Err(); err != nil {
+			return nil, err
+		}
+
+		groups, err := tx.QueryContext(ctx, "SELECT group_name FROM role_groups WHERE role_id = ? ORDER BY group_name;", id)
+		if err != nil {
+			return nil, err
+		}
+
+		for groups.Next() {
+			var name string
+			if err = groups.Scan(&name); err != nil {
+				groups.Close()
+				return nil, err
+			}
+
+			result[i].Groups = append(result[i].Groups, name)
+		}
+		groups.Close()
+
+		if err = groups.Err(); err != nil {
+			return nil, err
+		}
+	}
+
+	return result, nil
+}
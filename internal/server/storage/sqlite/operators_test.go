@@ -0,0 +1,123 @@
+package sqlite
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/dvaxert/mdm/internal/domain/models"
+	"github.com/dvaxert/mdm/internal/server/storage"
+	"github.com/google/uuid"
+)
+
+// newScopeFleet creates the groups office > floor > room and registers a
+// device in office, a device in room, a device labeled site=oslo that is
+// retired and a device out of every group.
+func newScopeFleet(t *testing.T) (*Storage, []uuid.UUID) {
+	t.Helper()
+
+	ctx := context.Background()
+
+	s := newStorage(t)
+	devices := registerDevices(t, s, models.Ios, models.Ios, models.Ios, models.Ios)
+
+	for _, g := range [][2]string{{"office", ""}, {"floor", "office"}, {"room", "floor"}, {"lab", ""}} {
+		if err := s.CreateGroup(ctx, g[0], g[1]); err != nil {
+			t.Fatalf("CreateGroup() error = %v", err)
+		}
+	}
+
+	if _, err := s.AddGroupDevice(ctx, "office", devices[0]); err != nil {
+		t.Fatalf("AddGroupDevice() error = %v", err)
+	}
+	if _, err := s.AddGroupDevice(ctx, "room", devices[1]); err != nil {
+		t.Fatalf("AddGroupDevice() error = %v", err)
+	}
+	if err := s.UpdateDeviceLabels(ctx, devices[2], map[string]string{"site": "oslo"}, nil); err != nil {
+		t.Fatalf("UpdateDeviceLabels() error = %v", err)
+	}
+	if _, err := s.SetDeviceLifecycle(ctx, devices[2], models.LifecycleRetired); err != nil {
+		t.Fatalf("SetDeviceLifecycle() error = %v", err)
+	}
+
+	return s, devices
+}
+
+func TestDeviceInScope(t *testing.T) {
+	s, devices := newScopeFleet(t)
+
+	oslo, err := models.ParseSelector("site=oslo")
+	if err != nil {
+		t.Fatalf("ParseSelector() error = %v", err)
+	}
+
+	tests := []struct {
+		name     string
+		device   uuid.UUID
+		groups   []string
+		selector models.Selector
+		want     bool
+	}{
+		{name: "member of the group", device: devices[0], groups: []string{"office"}, want: true},
+		{name: "member of a nested subgroup", device: devices[1], groups: []string{"office"}, want: true},
+		{name: "member of the parent group", device: devices[0], groups: []string{"floor"}, want: false},
+		{name: "member of another group", device: devices[1], groups: []string{"lab"}, want: false},
+		{name: "one of the groups", device: devices[1], groups: []string{"lab", "floor"}, want: true},
+		{name: "unknown group", device: devices[0], groups: []string{"missing", "office"}, want: true},
+		{name: "retired device matching the selector", device: devices[2], selector: oslo, want: true},
+		{name: "does not match the selector", device: devices[3], selector: oslo, want: false},
+		{name: "group or selector", device: devices[2], groups: []string{"office"}, selector: oslo, want: true},
+		{name: "neither group nor selector", device: devices[3], groups: []string{"office"}, selector: oslo, want: false},
+		{name: "empty scope", device: devices[3], want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := s.DeviceInScope(context.Background(), tt.device, tt.groups, tt.selector)
+			if err != nil {
+				t.Fatalf("DeviceInScope() error = %v", err)
+			}
+
+			if got != tt.want {
+				t.Errorf("DeviceInScope() = %t, want %t", got, tt.want)
+			}
+		})
+	}
+
+	_, err = s.DeviceInScope(context.Background(), uuid.New(), []string{"office"}, oslo)
+	if !errors.Is(err, storage.ErrDeviceNotFound) {
+		t.Errorf("DeviceInScope() of an unknown device error = %v, want %v", err, storage.ErrDeviceNotFound)
+	}
+}
+
+func TestGroupInScope(t *testing.T) {
+	s, _ := newScopeFleet(t)
+
+	tests := []struct {
+		name   string
+		group  string
+		groups []string
+		want   bool
+	}{
+		{name: "same group", group: "office", groups: []string{"office"}, want: true},
+		{name: "nested subgroup", group: "room", groups: []string{"office"}, want: true},
+		{name: "parent group", group: "office", groups: []string{"floor"}, want: false},
+		{name: "other group", group: "lab", groups: []string{"office"}, want: false},
+		{name: "one of the groups", group: "room", groups: []string{"lab", "floor"}, want: true},
+		{name: "role without groups", group: "office", want: false},
+		{name: "unknown group", group: "missing", groups: []string{"office"}, want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := s.GroupInScope(context.Background(), tt.group, tt.groups)
+			if err != nil {
+				t.Fatalf("GroupInScope() error = %v", err)
+			}
+
+			if got != tt.want {
+				t.Errorf("GroupInScope() = %t, want %t", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,154 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"encoding/base64"
+	"encoding/json"
+
+	"github.com/dvaxert/mdm/internal/domain/models"
+	"github.com/dvaxert/mdm/internal/server/storage"
+)
+
+// cursor указывает на последнее устройство страницы, следующая страница
+// начинается сразу после него
+type cursor struct {
+	Order string `json:"o"`
+	Id    int64  `json:"i"`
+	Uuid  string `json:"u,omitempty"`
+	Type  int    `json:"t,omitempty"`
+}
+
+func encodeCursor(c cursor) string {
+	data, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+func decodeCursor(token string, order models.DeviceOrder) (cursor, error) {
+	var c cursor
+
+	data, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return cursor{}, storage.ErrInvalidPageToken
+	}
+
+	if err = json.Unmarshal(data, &c); err != nil || c.Order != order.String() {
+		return cursor{}, storage.ErrInvalidPageToken
+	}
+
+	return c, nil
+}
+
+// devicePage returns one page of the devices matching the SQL condition on
+// devices aliased as d, and the token of the next page. The token is empty
+// for the last page.
+func (s *Storage) devicePage(
+	ctx context.Context,
+	where string,
+	args []any,
+	page models.Page,
+) ([]models.Device, string, error) {
+	size := page.Size
+	if size <= 0 || size > models.MaxPageSize {
+		size = models.DefaultPageSize
+	}
+
+	dir, cmp := "ASC", ">"
+	if page.Order.Desc {
+		dir, cmp = "DESC", "<"
+	}
+
+	var column string
+	switch page.Order.Field {
+	case models.OrderByUuid:
+		column = "d.uuid"
+	case models.OrderByType:
+		column = "d.type"
+	}
+
+	args = append([]any(nil), args...)
+
+	if page.Token != "" {
+		c, err := decodeCursor(page.Token, page.Order)
+		if err != nil {
+			return nil, "", err
+		}
+
+		switch page.Order.Field {
+		case models.OrderByUuid:
+			where += " AND (d.uuid, d.id) " + cmp + " (?, ?)"
+			args = append(args, c.Uuid, c.Id)
+		case models.OrderByType:
+			where += " AND (d.type, d.id) " + cmp + " (?, ?)"
+			args = append(args, c.Type, c.Id)
+		default:
+			where += " AND d.id " + cmp + " ?"
+			args = append(args, c.Id)
+		}
+	}
+
+	orderBy := "d.id " + dir
+	if column != "" {
+		orderBy = column + " " + dir + ", " + orderBy
+	}
+
+	// лишняя строка показывает, что за страницей есть еще устройства
+	rows, err := s.db.QueryContext(
+		ctx,
+		`SELECT d.id, d.uuid, d.type, d.last_seen, d.lifecycle, d.lifecycle_changed_at
+		 FROM devices AS d
+		 WHERE `+where+`
+		 ORDER BY `+orderBy+`
+		 LIMIT ?;`,
+		append(args, size+1)...,
+	)
+	if err != nil {
+		return nil, "", err
+	}
+	defer rows.Close()
+
+	var result []models.Device
+	for rows.Next() {
+		var (
+			d             models.Device
+			seen, changed sql.NullInt64
+		)
+		if err = rows.Scan(&d.Id, &d.Uuid, &d.Type, &seen, &d.Lifecycle, &changed); err != nil {
+			return nil, "", err
+		}
+		d.LastSeen = unixTime(seen)
+		d.LifecycleChangedAt = unixTime(changed)
+
+		result = append(result, d)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, "", err
+	}
+
+	if len(result) <= size {
+		return result, "", nil
+	}
+
+	result = result[:size]
+	last := result[size-1]
+
+	next := encodeCursor(cursor{
+		Order: page.Order.String(),
+		Id:    last.Id,
+		Uuid:  last.Uuid.String(),
+		Type:  int(last.Type),
+	})
+
+	return result, next, nil
+}
+
+// deviceIds returns the ids of the devices and the placeholders for them.
+func deviceIds(devices []models.Device) (string, []any) {
+	ids := make([]any, 0, len(devices))
+	for _, d := range devices {
+		ids = append(ids, d.Id)
+	}
+
+	return placeholders(len(ids)), ids
+}
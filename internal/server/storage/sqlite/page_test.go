@@ -0,0 +1,241 @@
+package sqlite
+
+import (
+	"context"
+	"errors"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/dvaxert/mdm/internal/domain/models"
+	"github.com/dvaxert/mdm/internal/server/storage"
+	"github.com/google/uuid"
+)
+
+func TestCursor(t *testing.T) {
+	c := cursor{Order: "type desc", Id: 42, Uuid: uuid.NewString(), Type: int(models.Windows)}
+
+	token := encodeCursor(c)
+
+	got, err := decodeCursor(token, models.DeviceOrder{Field: models.OrderByType, Desc: true})
+	if err != nil {
+		t.Fatalf("decodeCursor() error = %v", err)
+	}
+
+	if got != c {
+		t.Errorf("decodeCursor() = %+v, want %+v", got, c)
+	}
+
+	tests := []struct {
+		name  string
+		token string
+		order models.DeviceOrder
+	}{
+		{name: "other field", token: token, order: models.DeviceOrder{Field: models.OrderByUuid, Desc: true}},
+		{name: "other direction", token: token, order: models.DeviceOrder{Field: models.OrderByType}},
+		{name: "registration order", token: token, order: models.DeviceOrder{}},
+		{name: "not base64", token: "not a token!", order: models.DeviceOrder{Field: models.OrderByType, Desc: true}},
+		{name: "not json", token: "bm90IGpzb24", order: models.DeviceOrder{Field: models.OrderByType, Desc: true}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := decodeCursor(tt.token, tt.order); !errors.Is(err, storage.ErrInvalidPageToken) {
+				t.Errorf("decodeCursor() error = %v, want %v", err, storage.ErrInvalidPageToken)
+			}
+		})
+	}
+}
+
+func newStorage(t *testing.T) *Storage {
+	t.Helper()
+
+	s, err := New(filepath.Join(t.TempDir(), "storage.db"))
+	if err != nil {
+		t.Fatalf("open storage: %v", err)
+	}
+	t.Cleanup(func() { s.Close() })
+
+	return s
+}
+
+// registerDevices registers devices of the types in that order and returns
+// their uuids.
+func registerDevices(t *testing.T, s *Storage, types ...models.DeviceType) []uuid.UUID {
+	t.Helper()
+
+	var result []uuid.UUID
+	for _, device_type := range types {
+		device_uuid := uuid.New()
+		if _, err := s.RegisterDevice(context.Background(), device_uuid, device_type, nil, "", false); err != nil {
+			t.Fatalf("register device: %v", err)
+		}
+
+		result = append(result, device_uuid)
+	}
+
+	return result
+}
+
+// listAll walks the device list page by page.
+func listAll(t *testing.T, s *Storage, order models.DeviceOrder, size int) []models.Device {
+	t.Helper()
+
+	var (
+		result []models.Device
+		token  string
+	)
+	for {
+		devices, next, err := s.DeviceList(context.Background(), models.DeviceFilter{}, models.Page{Size: size, Token: token, Order: order})
+		if err != nil {
+			t.Fatalf("DeviceList() error = %v", err)
+		}
+
+		if len(devices) > size {
+			t.Fatalf("DeviceList() returned %d devices, page size is %d", len(devices), size)
+		}
+
+		result = append(result, devices...)
+
+		if next == "" {
+			return result
+		}
+
+		if len(devices) != size {
+			t.Fatalf("DeviceList() returned a short page of %d devices with a next token", len(devices))
+		}
+
+		token = next
+	}
+}
+
+func TestDevicePage(t *testing.T) {
+	s := newStorage(t)
+
+	// много устройств одного типа, чтобы границы страниц попадали
+	// внутрь групп с равным типом
+	registered := registerDevices(t, s,
+		models.Ios, models.Android, models.Ios, models.Windows, models.Ios,
+		models.Android, models.Ios, models.Ios, models.Windows, models.Android,
+	)
+
+	byType := func(desc bool) func(a, b models.Device) int {
+		return func(a, b models.Device) int {
+			if a.Type != b.Type {
+				if desc {
+					return int(b.Type) - int(a.Type)
+				}
+				return int(a.Type) - int(b.Type)
+			}
+
+			// равные значения идут в порядке регистрации в том же направлении
+			if desc {
+				return int(b.Id - a.Id)
+			}
+			return int(a.Id - b.Id)
+		}
+	}
+
+	tests := []struct {
+		order   string
+		compare func(a, b models.Device) int
+	}{
+		{order: "", compare: func(a, b models.Device) int { return int(a.Id - b.Id) }},
+		{order: "registered desc", compare: func(a, b models.Device) int { return int(b.Id - a.Id) }},
+		{order: "uuid", compare: func(a, b models.Device) int { return strings.Compare(a.Uuid.String(), b.Uuid.String()) }},
+		{order: "uuid desc", compare: func(a, b models.Device) int { return strings.Compare(b.Uuid.String(), a.Uuid.String()) }},
+		{order: "type", compare: byType(false)},
+		{order: "type desc", compare: byType(true)},
+	}
+
+	for _, tt := range tests {
+		order, err := models.ParseDeviceOrder(tt.order)
+		if err != nil {
+			t.Fatalf("ParseDeviceOrder() error = %v", err)
+		}
+
+		for _, size := range []int{1, 2, 3, 4, 10, 11} {
+			devices := listAll(t, s, order, size)
+
+			if len(devices) != len(registered) {
+				t.Fatalf("order %q, size %d: listed %d devices, want %d", tt.order, size, len(devices), len(registered))
+			}
+
+			seen := make(map[uuid.UUID]bool)
+			for _, d := range devices {
+				if seen[d.Uuid] {
+					t.Errorf("order %q, size %d: device %s listed twice", tt.order, size, d.Uuid)
+				}
+				seen[d.Uuid] = true
+			}
+
+			if !slices.IsSortedFunc(devices, tt.compare) {
+				t.Errorf("order %q, size %d: devices are not in order", tt.order, size)
+			}
+		}
+	}
+}
+
+func TestDevicePageConcurrentRegistration(t *testing.T) {
+	s := newStorage(t)
+	registerDevices(t, s, models.Ios, models.Ios, models.Ios, models.Ios)
+
+	order := models.DeviceOrder{Field: models.OrderByType}
+
+	first, next, err := s.DeviceList(context.Background(), models.DeviceFilter{}, models.Page{Size: 2, Order: order})
+	if err != nil {
+		t.Fatalf("DeviceList() error = %v", err)
+	}
+
+	// устройство, зарегистрированное между страницами, идет после
+	// уже показанных и не сдвигает следующую страницу
+	late := registerDevices(t, s, models.Ios)[0]
+
+	second, _, err := s.DeviceList(context.Background(), models.DeviceFilter{}, models.Page{Size: 10, Token: next, Order: order})
+	if err != nil {
+		t.Fatalf("DeviceList() error = %v", err)
+	}
+
+	if len(first) != 2 || len(second) != 3 {
+		t.Fatalf("pages of %d and %d devices, want 2 and 3", len(first), len(second))
+	}
+
+	for _, d := range second {
+		if d.Uuid == first[0].Uuid || d.Uuid == first[1].Uuid {
+			t.Errorf("device %s listed on both pages", d.Uuid)
+		}
+	}
+
+	if second[len(second)-1].Uuid != late {
+		t.Errorf("device registered between pages is not the last one")
+	}
+}
+
+func TestDevicePageTokenOrder(t *testing.T) {
+	s := newStorage(t)
+	registerDevices(t, s, models.Ios, models.Android, models.Windows)
+
+	_, next, err := s.DeviceList(context.Background(), models.DeviceFilter{}, models.Page{
+		Size:  1,
+		Order: models.DeviceOrder{Field: models.OrderByType},
+	})
+	if err != nil {
+		t.Fatalf("DeviceList() error = %v", err)
+	}
+
+	if next == "" {
+		t.Fatalf("DeviceList() returned no next token")
+	}
+
+	for _, order := range []models.DeviceOrder{
+		{},
+		{Field: models.OrderByType, Desc: true},
+		{Field: models.OrderByUuid},
+	} {
+		_, _, err = s.DeviceList(context.Background(), models.DeviceFilter{}, models.Page{Size: 1, Token: next, Order: order})
+		if !errors.Is(err, storage.ErrInvalidPageToken) {
+			t.Errorf("order %q: DeviceList() error = %v, want %v", order, err, storage.ErrInvalidPageToken)
+		}
+	}
+}
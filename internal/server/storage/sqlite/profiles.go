@@ -0,0 +1,520 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/dvaxert/mdm/internal/domain/models"
+	"github.com/dvaxert/mdm/internal/server/storage"
+	"github.com/google/uuid"
+)
+
+// CreateProfile adds an unassigned profile with version 1.
+func (s *Storage) CreateProfile(ctx context.Context, profile models.Profile) error {
+	const op = "storage.sqlite.CreateProfile"
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	tx, err := s.db.Begin()
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	defer tx.Rollback()
+
+	var exists bool
+	err = tx.QueryRowContext(ctx, "SELECT EXISTS(SELECT 1 FROM profiles WHERE name = ?);", profile.Name).Scan(&exists)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if exists {
+		return fmt.Errorf("%s: %w", op, storage.ErrProfileExists)
+	}
+
+	res, err := tx.ExecContext(
+		ctx,
+		"INSERT INTO profiles(name, description) VALUES(?,?);",
+		profile.Name, profile.Description,
+	)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	id, err := res.LastInsertId()
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err = writeProfileFeatures(ctx, tx, id, profile.Features); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err = tx.Commit(); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+// UpdateProfile replaces the description and the feature values of the
+// profile and increments its version. The uuids of the devices whose desired
+// state changed are returned.
+func (s *Storage) UpdateProfile(ctx context.Context, profile models.Profile) ([]uuid.UUID, error) {
+	const op = "storage.sqlite.UpdateProfile"
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	tx, err := s.db.Begin()
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer tx.Rollback()
+
+	id, err := profileId(ctx, tx, profile.Name)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	_, err = tx.ExecContext(
+		ctx,
+		"UPDATE profiles SET description = ?, version = version + 1 WHERE id = ?;",
+		profile.Description, id,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if _, err = tx.ExecContext(ctx, "DELETE FROM profile_features WHERE profile_id = ?;", id); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err = writeProfileFeatures(ctx, tx, id, profile.Features); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	devices, err := profileDevices(ctx, tx, id)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	changed, err := resolveDevices(ctx, tx, devices)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return changed, nil
+}
+
+// DeleteProfile removes the profile together with its assignments. The uuids
+// of the devices whose desired state changed are returned.
+func (s *Storage) DeleteProfile(ctx context.Context, name string) ([]uuid.UUID, error) {
+	const op = "storage.sqlite.DeleteProfile"
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	tx, err := s.db.Begin()
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer tx.Rollback()
+
+	id, err := profileId(ctx, tx, name)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	devices, err := profileDevices(ctx, tx, id)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	for _, query := range []string{
+		"DELETE FROM device_profiles WHERE profile_id = ?;",
+		"DELETE FROM device_group_profiles WHERE profile_id = ?;",
+		"DELETE FROM profile_features WHERE profile_id = ?;",
+		"DELETE FROM profile_apps WHERE profile_id = ?;",
+		"DELETE FROM profiles WHERE id = ?;",
+	} {
+		if _, err = tx.ExecContext(ctx, query, id); err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+	}
+
+	changed, err := resolveDevices(ctx, tx, devices)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return changed, nil
+}
+
+func (s *Storage) Profile(ctx context.Context, name string) (models.Profile, error) {
+	const op = "storage.sqlite.Profile"
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	profiles, err := s.profiles(ctx, "WHERE p.name = ?", name)
+	if err != nil {
+		return models.Profile{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if len(profiles) == 0 {
+		return models.Profile{}, fmt.Errorf("%s: %w", op, storage.ErrProfileNotFound)
+	}
+
+	return profiles[0], nil
+}
+
+func (s *Storage) ProfileList(ctx context.Context) ([]models.Profile, error) {
+	const op = "storage.sqlite.ProfileList"
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	profiles, err := s.profiles(ctx, "")
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return profiles, nil
+}
+
+// AssignDeviceProfile assigns the profile to the device. The device uuid is
+// returned if its desired state changed.
+func (s *Storage) AssignDeviceProfile(ctx context.Context, profile string, device_uuid uuid.UUID) ([]uuid.UUID, error) {
+	const op = "storage.sqlite.AssignDeviceProfile"
+
+	changed, err := s.updateDeviceProfile(
+		ctx, profile, device_uuid,
+		"INSERT OR IGNORE INTO device_profiles(profile_id, device_id) VALUES(?,?);",
+	)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return changed, nil
+}
+
+// UnassignDeviceProfile removes the profile from the device. The device uuid
+// is returned if its desired state changed.
+func (s *Storage) UnassignDeviceProfile(ctx context.Context, profile string, device_uuid uuid.UUID) ([]uuid.UUID, error) {
+	const op = "storage.sqlite.UnassignDeviceProfile"
+
+	changed, err := s.updateDeviceProfile(
+		ctx, profile, device_uuid,
+		"DELETE FROM device_profiles WHERE profile_id = ? AND device_id = ?;",
+	)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return changed, nil
+}
+
+// AssignGroupProfile assigns the profile to the group. The uuids of the
+// members whose desired state changed are returned.
+func (s *Storage) AssignGroupProfile(ctx context.Context, profile string, group string) ([]uuid.UUID, error) {
+	const op = "storage.sqlite.AssignGroupProfile"
+
+	changed, err := s.updateGroupProfile(
+		ctx, profile, group,
+		"INSERT OR IGNORE INTO device_group_profiles(profile_id, group_id) VALUES(?,?);",
+	)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return changed, nil
+}
+
+// UnassignGroupProfile removes the profile from the group. The uuids of the
+// members whose desired state changed are returned.
+func (s *Storage) UnassignGroupProfile(ctx context.Context, profile string, group string) ([]uuid.UUID, error) {
+	const op = "storage.sqlite.UnassignGroupProfile"
+
+	changed, err := s.updateGroupProfile(
+		ctx, profile, group,
+		"DELETE FROM device_group_profiles WHERE profile_id = ? AND group_id = ?;",
+	)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return changed, nil
+}
+
+// updateDeviceProfile runs a query changing the profiles of the device, the
+// query takes the profile id and the device id.
+func (s *Storage) updateDeviceProfile(ctx context.Context, profile string, device_uuid uuid.UUID, query string) ([]uuid.UUID, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	tx, err := s.db.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	profile_id, err := profileId(ctx, tx, profile)
+	if err != nil {
+		return nil, err
+	}
+
+	device_id, err := deviceId(ctx, tx, device_uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err = tx.ExecContext(ctx, query, profile_id, device_id); err != nil {
+		return nil, err
+	}
+
+	changed, err := resolveDevices(ctx, tx, map[int64]uuid.UUID{device_id: device_uuid})
+	if err != nil {
+		return nil, err
+	}
+
+	return changed, tx.Commit()
+}
+
+// updateGroupProfile runs a query changing the profiles of the group, the
+// query takes the profile id and the group id.
+func (s *Storage) updateGroupProfile(ctx context.Context, profile string, group string, query string) ([]uuid.UUID, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	tx, err := s.db.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	profile_id, err := profileId(ctx, tx, profile)
+	if err != nil {
+		return nil, err
+	}
+
+	group_id, err := groupId(ctx, tx, group)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err = tx.ExecContext(ctx, query, profile_id, group_id); err != nil {
+		return nil, err
+	}
+
+	devices, err := groupMembers(ctx, tx, group_id)
+	if err != nil {
+		return nil, err
+	}
+
+	changed, err := resolveDevices(ctx, tx, devices)
+	if err != nil {
+		return nil, err
+	}
+
+	return changed, tx.Commit()
+}
+
+// profiles returns the profiles selected by the where clause on profiles
+// aliased as p, with their feature values and assignments.
+func (s *Storage) profiles(ctx context.Context, where string, args ...any) ([]models.Profile, error) {
+	rows, err := s.db.QueryContext(
+		ctx,
+		"SELECT p.id, p.name, p.description, p.version FROM profiles AS p "+where+" ORDER BY p.name;",
+		args...,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var result []models.Profile
+	index := make(map[int64]int)
+	for rows.Next() {
+		p := models.Profile{Features: make(map[string]models.FeatureValue)}
+		if err = rows.Scan(&p.Id, &p.Name, &p.Description, &p.Version); err != nil {
+			return nil, err
+		}
+
+		index[p.Id] = len(result)
+		result = append(result, p)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	features, err := s.db.QueryContext(
+		ctx,
+		`SELECT p.id, f.name, f.value_type, pf.value
+		 FROM profile_features AS pf
+			JOIN profiles AS p
+			ON pf.profile_id = p.id
+			JOIN features AS f
+			ON pf.feature_id = f.id
+		 `+where+`;`,
+		args...,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer features.Close()
+
+	for features.Next() {
+		var (
+			id         int64
+			name       string
+			value_type models.FeatureType
+			value      string
+		)
+		if err = features.Scan(&id, &name, &value_type, &value); err != nil {
+			return nil, err
+		}
+
+		v, err := decodeValue(value_type, value)
+		if err != nil {
+			return nil, err
+		}
+
+		result[index[id]].Features[name] = v
+	}
+
+	if err = features.Err(); err != nil {
+		return nil, err
+	}
+
+	devices, err := s.db.QueryContext(
+		ctx,
+		`SELECT p.id, d.uuid
+		 FROM device_profiles AS dp
+			JOIN profiles AS p
+			ON dp.profile_id = p.id
+			JOIN devices AS d
+			ON dp.device_id = d.id
+		 `+where+`
+		 ORDER BY d.uuid;`,
+		args...,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer devices.Close()
+
+	for devices.Next() {
+		var (
+			id          int64
+			device_uuid uuid.UUID
+		)
+		if err = devices.Scan(&id, &device_uuid); err != nil {
+			return nil, err
+		}
+
+		p := &result[index[id]]
+		p.Devices = append(p.Devices, device_uuid)
+	}
+
+	if err = devices.Err(); err != nil {
+		return nil, err
+	}
+
+	groups, err := s.db.QueryContext(
+		ctx,
+		`SELECT p.id, g.name
+		 FROM device_group_profiles AS gp
+			JOIN profiles AS p
+			ON gp.profile_id = p.id
+			JOIN device_groups AS g
+			ON gp.group_id = g.id
+		 `+where+`
+		 ORDER BY g.name;`,
+		args...,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer groups.Close()
+
+	for groups.Next() {
+		var (
+			id   int64
+			name string
+		)
+		if err = groups.Scan(&id, &name); err != nil {
+			return nil, err
+		}
+
+		p := &result[index[id]]
+		p.Groups = append(p.Groups, name)
+	}
+
+	return result, groups.Err()
+}
+
+// writeProfileFeatures stores the feature values of the profile.
+func writeProfileFeatures(ctx context.Context, tx *sql.Tx, profile_id int64, features map[string]models.FeatureValue) error {
+	for name, value := range features {
+		feature_id, err := featureId(ctx, tx, name)
+		if err != nil {
+			return err
+		}
+
+		encoded, err := encodeValue(value)
+		if err != nil {
+			return err
+		}
+
+		_, err = tx.ExecContext(
+			ctx,
+			"INSERT INTO profile_features(profile_id, feature_id, value) VALUES(?,?,?);",
+			profile_id, feature_id, encoded,
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// profileDevices returns the devices the profile is assigned to directly or
+// through a group or its ancestors.
+func profileDevices(ctx context.Context, tx *sql.Tx, profile_id int64) (map[int64]uuid.UUID, error) {
+	return queryDevices(
+		ctx, tx,
+		`WITH RECURSIVE subgroups(id) AS (
+			SELECT group_id FROM device_group_profiles WHERE profile_id = ?1
+			UNION
+			SELECT g.id FROM device_groups AS g JOIN subgroups AS s ON g.parent_id = s.id
+		 )
+		 SELECT d.id, d.uuid
+		 FROM devices AS d
+		 WHERE d.id IN (SELECT device_id FROM device_profiles WHERE profile_id = ?1)
+			OR d.id IN (SELECT device_id FROM device_group_members WHERE group_id IN (SELECT id FROM subgroups));`,
+		profile_id,
+	)
+}
+
+func profileId(ctx context.Context, tx *sql.Tx, name string) (int64, error) {
+	var id int64
+	err := tx.QueryRowContext(ctx, "SELECT id FROM profiles WHERE name = ?;", name).Scan(&id)
+	if errors.Is(err, sql.ErrNoRows) {
+		return 0, storage.ErrProfileNotFound
+	}
+
+	return id, err
+}
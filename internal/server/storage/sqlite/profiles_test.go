@@ -0,0 +1,106 @@
+package sqlite
+
+import (
+	"context"
+	"testing"
+
+	"github.com/dvaxert/mdm/internal/domain/models"
+	"github.com/google/uuid"
+)
+
+func TestResolveProfilePrecedence(t *testing.T) {
+	ctx := context.Background()
+	s, device := newWallpaperStorage(t)
+
+	if err := s.CreateGroup(ctx, "office", ""); err != nil {
+		t.Fatalf("CreateGroup() error = %v", err)
+	}
+	if _, err := s.AddGroupDevice(ctx, "office", device); err != nil {
+		t.Fatalf("AddGroupDevice() error = %v", err)
+	}
+
+	for _, name := range []string{"group a", "group b", "device a", "device b"} {
+		profile := models.Profile{Name: name, Features: map[string]models.FeatureValue{"wallpaper": stringValue(name)}}
+		if err := s.CreateProfile(ctx, profile); err != nil {
+			t.Fatalf("CreateProfile() error = %v", err)
+		}
+	}
+
+	toGroup := func(profile string) func() ([]uuid.UUID, error) {
+		return func() ([]uuid.UUID, error) { return s.AssignGroupProfile(ctx, profile, "office") }
+	}
+	toDevice := func(profile string) func() ([]uuid.UUID, error) {
+		return func() ([]uuid.UUID, error) { return s.AssignDeviceProfile(ctx, profile, device) }
+	}
+	update := func(profile, value string) func() ([]uuid.UUID, error) {
+		return func() ([]uuid.UUID, error) {
+			return s.UpdateProfile(ctx, models.Profile{
+				Name:     profile,
+				Features: map[string]models.FeatureValue{"wallpaper": stringValue(value)},
+			})
+		}
+	}
+
+	runResolveSteps(t, s, device, []resolveStep{
+		{name: "group profile", change: toGroup("group b"), want: "group b", changed: true},
+		{name: "group profiles by name", change: toGroup("group a"), want: "group a", changed: true},
+		{
+			name: "group value over group profiles",
+			change: func() ([]uuid.UUID, error) {
+				return s.SetGroupFeature(ctx, "office", "wallpaper", stringValue("office"))
+			},
+			want:    "office",
+			changed: true,
+		},
+		{name: "device profile over group", change: toDevice("device b"), want: "device b", changed: true},
+		{name: "device profiles by name", change: toDevice("device a"), want: "device a", changed: true},
+		{name: "shadowed profile updated", change: update("device b", "device b 2"), want: "device a", changed: false},
+		{name: "applied profile updated", change: update("device a", "device a 2"), want: "device a 2", changed: true},
+		{
+			name: "value set on the device over profiles",
+			change: func() ([]uuid.UUID, error) {
+				_, err := s.UpdateDeviceFeature(ctx, device, "wallpaper", stringValue("device"))
+				return []uuid.UUID{device}, err
+			},
+			want:    "device",
+			changed: true,
+		},
+		{name: "profile under a device value", change: update("device a", "device a 3"), want: "device", changed: false},
+		{
+			name:    "device value reset",
+			change:  func() ([]uuid.UUID, error) { return s.ResetDeviceFeature(ctx, device, "wallpaper") },
+			want:    "device a 3",
+			changed: true,
+		},
+		{
+			name:    "device profile unassigned",
+			change:  func() ([]uuid.UUID, error) { return s.UnassignDeviceProfile(ctx, "device a", device) },
+			want:    "device b 2",
+			changed: true,
+		},
+		{
+			name:    "device profile deleted",
+			change:  func() ([]uuid.UUID, error) { return s.DeleteProfile(ctx, "device b") },
+			want:    "office",
+			changed: true,
+		},
+		{
+			name:    "group value cleared",
+			change:  func() ([]uuid.UUID, error) { return s.ClearGroupFeature(ctx, "office", "wallpaper") },
+			want:    "group a",
+			changed: true,
+		},
+		{
+			name:    "group profile unassigned",
+			change:  func() ([]uuid.UUID, error) { return s.UnassignGroupProfile(ctx, "group a", "office") },
+			want:    "group b",
+			changed: true,
+		},
+		{
+			name:    "device left the group",
+			change:  func() ([]uuid.UUID, error) { return s.RemoveGroupDevice(ctx, "office", device) },
+			want:    "default",
+			changed: true,
+		},
+	})
+}
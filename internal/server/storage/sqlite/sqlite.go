@@ -3,11 +3,14 @@ package sqlite
 import (
 	"context"
 	"database/sql"
+	"errors"
 	"fmt"
-	"slices"
+	"strings"
 	"sync"
+	"time"
 
 	"github.com/dvaxert/mdm/internal/domain/models"
+	"github.com/dvaxert/mdm/internal/server/storage"
 	"github.com/google/uuid"
 	_ "modernc.org/sqlite"
 )
@@ -39,7 +42,29 @@ func (s *Storage) Close() error {
 	return s.db.Close()
 }
 
-func (s *Storage) RegisterDevice(ctx context.Context, device_uuid uuid.UUID, device_type models.DeviceType) (int64, error) {
+// RegisterDevice stores the device and seeds its features. The labels
+// reported by the device replace the labels it reported before, labels set
+// through the Control API are kept. It reports whether the device has
+// enrolled: registered for the first time or again after it was moved to
+// pending.
+//
+// A non-empty token_hash spends one use of the enrollment token (see
+// useEnrollmentToken for the errors), an enrolling device is put into the
+// scope of the token. A pending device enrolls only with a token and is
+// reported with storage.ErrDevicePending otherwise. An enrolled device
+// registers with a token only if it is authenticated by its certificate,
+// otherwise it is refused with storage.ErrDeviceEnrolled and the token is
+// not spent. Suspended and
+// decommissioned devices are refused with storage.ErrDeviceSuspended and
+// storage.ErrDeviceDecommissioned.
+func (s *Storage) RegisterDevice(
+	ctx context.Context,
+	device_uuid uuid.UUID,
+	device_type models.DeviceType,
+	labels map[string]string,
+	token_hash string,
+	authenticated bool,
+) (bool, error) {
 	const op = "storage.sqlite.Register"
 
 	s.mu.Lock()
@@ -47,46 +72,127 @@ func (s *Storage) RegisterDevice(ctx context.Context, device_uuid uuid.UUID, dev
 
 	tx, err := s.db.Begin()
 	if err != nil {
-		return 0, fmt.Errorf("%s: %w", op, err)
+		return false, fmt.Errorf("%s: %w", op, err)
 	}
 	defer tx.Rollback()
 
-	stmt, err := tx.Prepare("INSERT OR IGNORE INTO devices(uuid, type) VALUES(?,?);")
+	var token models.EnrollmentToken
+	if token_hash != "" {
+		if token, err = useEnrollmentToken(ctx, tx, token_hash, device_type); err != nil {
+			return false, fmt.Errorf("%s: %w", op, err)
+		}
+	}
+
+	stmt, err := tx.Prepare("INSERT OR IGNORE INTO devices(uuid, type, registered_at) VALUES(?, ?, unixepoch());")
 	if err != nil {
-		return 0, fmt.Errorf("%s: %w", op, err)
+		return false, fmt.Errorf("%s: %w", op, err)
 	}
 
 	res, err := stmt.ExecContext(ctx, device_uuid.String(), device_type)
 	if err != nil {
-		return 0, fmt.Errorf("%s: %w", op, err)
+		return false, fmt.Errorf("%s: %w", op, err)
 	}
 
-	id, err := res.LastInsertId()
+	inserted, err := res.RowsAffected()
 	if err != nil {
-		return 0, fmt.Errorf("%s: %w", op, err)
+		return false, fmt.Errorf("%s: %w", op, err)
 	}
 
-	stmt, err = tx.Prepare(
-		`INSERT OR IGNORE INTO device_features(device_id, feature_id, state) 
-		 VALUES(?,(SELECT id FROM features WHERE name = ?),?);`,
+	// при повторной регистрации INSERT игнорируется, поэтому id берем из таблицы
+	var (
+		id        int64
+		lifecycle models.Lifecycle
 	)
+	err = tx.QueryRowContext(
+		ctx,
+		"SELECT id, lifecycle FROM devices WHERE uuid = ?;",
+		device_uuid.String(),
+	).Scan(&id, &lifecycle)
 	if err != nil {
-		return 0, fmt.Errorf("%s: %w", op, err)
+		return false, fmt.Errorf("%s: %w", op, err)
+	}
+
+	switch {
+	case lifecycle == models.LifecycleSuspended:
+		return false, fmt.Errorf("%s: %w", op, storage.ErrDeviceSuspended)
+	case lifecycle.Decommissioned():
+		return false, fmt.Errorf("%s: %w", op, storage.ErrDeviceDecommissioned)
+	case lifecycle == models.LifecycleEnrolled && inserted == 0 && token_hash != "" && !authenticated:
+		// иначе любой token позволил бы выдать себя за зарегистрированное
+		// устройство, откат транзакции возвращает использование token
+		return false, fmt.Errorf("%s: %w", op, storage.ErrDeviceEnrolled)
+	case lifecycle == models.LifecyclePending:
+		if token_hash == "" {
+			return false, fmt.Errorf("%s: %w", op, storage.ErrDevicePending)
+		}
+
+		_, err = tx.ExecContext(
+			ctx,
+			"UPDATE devices SET lifecycle = ?, lifecycle_changed_at = unixepoch() WHERE id = ?;",
+			models.LifecycleEnrolled, id,
+		)
+		if err != nil {
+			return false, fmt.Errorf("%s: %w", op, err)
+		}
+	}
+	enrolled := inserted != 0 || lifecycle == models.LifecyclePending
+
+	_, err = tx.ExecContext(ctx, "UPDATE devices SET last_seen = unixepoch() WHERE id = ?;", id)
+	if err != nil {
+		return false, fmt.Errorf("%s: %w", op, err)
+	}
+
+	_, err = tx.ExecContext(ctx, "INSERT OR IGNORE INTO device_revisions(device_id) VALUES(?);", id)
+	if err != nil {
+		return false, fmt.Errorf("%s: %w", op, err)
+	}
+
+	// устройство получает значения по умолчанию всех применимых к нему функций каталога
+	_, err = tx.ExecContext(
+		ctx,
+		`INSERT OR IGNORE INTO device_features(device_id, feature_id, state, value, desired_at, drift_since) 
+		 SELECT ?, f.id, f.default_state, f.default_value, unixepoch(), unixepoch()
+		 FROM features AS f
+		 WHERE f.deprecated = 0 AND `+featureAppliesTo("f.id", "?")+`;`,
+		id, device_type,
+	)
+	if err != nil {
+		return false, fmt.Errorf("%s: %w", op, err)
+	}
+
+	_, err = tx.ExecContext(ctx, "DELETE FROM device_labels WHERE device_id = ? AND reported = 1;", id)
+	if err != nil {
+		return false, fmt.Errorf("%s: %w", op, err)
 	}
 
-	for k, v := range models.DefaultFeatures {
-		_, err = stmt.ExecContext(ctx, id, k, v)
+	for key, value := range labels {
+		_, err = tx.ExecContext(
+			ctx,
+			"INSERT OR IGNORE INTO device_labels(device_id, key, value, reported) VALUES(?, ?, ?, 1);",
+			id, key, value,
+		)
 		if err != nil {
-			return 0, fmt.Errorf("%s: %w", op, err)
+			return false, fmt.Errorf("%s: %w", op, err)
+		}
+	}
+
+	// область действия token определяет только начальное положение устройства
+	if enrolled && token_hash != "" {
+		if err = enrollDevice(ctx, tx, id, token); err != nil {
+			return false, fmt.Errorf("%s: %w", op, err)
+		}
+
+		if _, err = resolveDevices(ctx, tx, map[int64]uuid.UUID{id: device_uuid}); err != nil {
+			return false, fmt.Errorf("%s: %w", op, err)
 		}
 	}
 
 	err = tx.Commit()
 	if err != nil {
-		return 0, fmt.Errorf("%s: %w", op, err)
+		return false, fmt.Errorf("%s: %w", op, err)
 	}
 
-	return id, nil
+	return enrolled, nil
 }
 
 func (s *Storage) Device(ctx context.Context, device_uuid uuid.UUID) (models.Device, error) {
@@ -95,98 +201,392 @@ func (s *Storage) Device(ctx context.Context, device_uuid uuid.UUID) (models.Dev
 	s.mu.Lock()
 	defer s.mu.Unlock()
 
-	stmt, err := s.db.Prepare("SELECT id, uuid, type FROM devices WHERE uuid = ?;")
+	stmt, err := s.db.Prepare(
+		"SELECT id, uuid, type, last_seen, lifecycle, lifecycle_changed_at FROM devices WHERE uuid = ?;",
+	)
 	if err != nil {
 		return models.Device{}, fmt.Errorf("%s: %w", op, err)
 	}
 
 	row := stmt.QueryRowContext(ctx, device_uuid)
 
-	var device models.Device
-	err = row.Scan(&device.Id, &device.Uuid, &device.Type)
+	var (
+		device        models.Device
+		seen, changed sql.NullInt64
+	)
+	err = row.Scan(&device.Id, &device.Uuid, &device.Type, &seen, &device.Lifecycle, &changed)
+	if errors.Is(err, sql.ErrNoRows) {
+		return models.Device{}, fmt.Errorf("%s: %w", op, storage.ErrDeviceNotFound)
+	}
+	if err != nil {
+		return models.Device{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	labels, err := s.deviceLabels(ctx, "WHERE device_id = ?", device.Id)
 	if err != nil {
 		return models.Device{}, fmt.Errorf("%s: %w", op, err)
 	}
 
+	device.Labels = labels[device.Id]
+	device.LastSeen = unixTime(seen)
+	device.LifecycleChangedAt = unixTime(changed)
+
 	return device, nil
 }
 
+// UpdateDeviceStatus stores the status reported by the device, appends it to
+// the status history and marks the device as seen.
 func (s *Storage) UpdateDeviceStatus(ctx context.Context, device_uuid uuid.UUID, location string, battery int) error {
-	const op = "storage.sqlite.Register"
+	const op = "storage.sqlite.UpdateDeviceStatus"
 
 	s.mu.Lock()
 	defer s.mu.Unlock()
 
-	stmt, err := s.db.Prepare(
-		`INSERT OR REPLACE INTO device_statuses(device_id, location, battery) 
-		 VALUES((SELECT id FROM devices WHERE uuid = ?),?,?);`,
+	tx, err := s.db.Begin()
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	defer tx.Rollback()
+
+	device_id, err := deviceId(ctx, tx, device_uuid)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	_, err = tx.ExecContext(
+		ctx,
+		"INSERT OR REPLACE INTO device_statuses(device_id, location, battery) VALUES(?, ?, ?);",
+		device_id, location, battery,
 	)
 	if err != nil {
 		return fmt.Errorf("%s: %w", op, err)
 	}
 
-	_, err = stmt.ExecContext(ctx, device_uuid, location, battery)
+	_, err = tx.ExecContext(
+		ctx,
+		"INSERT INTO device_status_history(device_id, location, battery, reported_at) VALUES(?, ?, ?, unixepoch());",
+		device_id, location, battery,
+	)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	_, err = tx.ExecContext(ctx, "UPDATE devices SET last_seen = unixepoch() WHERE id = ?;", device_id)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err = tx.Commit(); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+// UpdateDeviceStateFetch records that the device has fetched its desired
+// state, which also marks it as seen.
+func (s *Storage) UpdateDeviceStateFetch(ctx context.Context, device_uuid uuid.UUID) error {
+	const op = "storage.sqlite.UpdateDeviceStateFetch"
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	res, err := s.db.ExecContext(
+		ctx,
+		"UPDATE devices SET last_state_fetch = unixepoch(), last_seen = unixepoch() WHERE uuid = ?;",
+		device_uuid,
+	)
 	if err != nil {
 		return fmt.Errorf("%s: %w", op, err)
 	}
 
+	if n, err := res.RowsAffected(); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	} else if n == 0 {
+		return fmt.Errorf("%s: %w", op, storage.ErrDeviceNotFound)
+	}
+
 	return nil
 }
 
+// DeviceStatus returns the status of the device, location and battery are
+// empty if the device has not pinged yet.
 func (s *Storage) DeviceStatus(ctx context.Context, device_uuid uuid.UUID) (models.DeviceStatus, error) {
 	const op = "storage.sqlite.DeviceStatus"
 
 	s.mu.Lock()
 	defer s.mu.Unlock()
 
-	stmt, err := s.db.Prepare(
-		`SELECT d.device_id, d.uuid, s.location, s.battery
+	row := s.db.QueryRowContext(
+		ctx,
+		`SELECT d.id, d.uuid, s.location, s.battery, d.registered_at, d.last_seen, d.last_state_fetch
 		 FROM devices AS d
-			JOIN device_statuses AS s
+			LEFT JOIN device_statuses AS s
 			ON d.id = s.device_id
-		 WHERE d.uuid = ?`,
+		 WHERE d.uuid = ?;`,
+		device_uuid,
 	)
+
+	info, err := scanDeviceStatus(row)
+	if errors.Is(err, sql.ErrNoRows) {
+		return models.DeviceStatus{}, fmt.Errorf("%s: %w", op, storage.ErrDeviceNotFound)
+	}
 	if err != nil {
 		return models.DeviceStatus{}, fmt.Errorf("%s: %w", op, err)
 	}
 
-	row := stmt.QueryRowContext(ctx, device_uuid)
+	return info, nil
+}
+
+// UpdateDeviceFeature changes the feature value and bumps the desired state
+// revision of the device. The new revision is returned.
+func (s *Storage) UpdateDeviceFeature(
+	ctx context.Context,
+	device_uuid uuid.UUID,
+	feature string,
+	value models.FeatureValue,
+) (int64, error) {
+	const op = "storage.sqlite.UpdateDeviceFeature"
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
 
-	var info models.DeviceStatus
-	err = row.Scan(&info.DeviceId, &info.DeviceUuid, &info.Location, &info.Location)
+	tx, err := s.db.Begin()
 	if err != nil {
-		return models.DeviceStatus{}, fmt.Errorf("%s: %w", op, err)
+		return 0, fmt.Errorf("%s: %w", op, err)
 	}
+	defer tx.Rollback()
 
-	return info, nil
+	var device_id int64
+	err = tx.QueryRowContext(ctx, "SELECT id FROM devices WHERE uuid = ?;", device_uuid).Scan(&device_id)
+	if errors.Is(err, sql.ErrNoRows) {
+		return 0, fmt.Errorf("%s: %w", op, storage.ErrDeviceNotFound)
+	}
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	var feature_id int64
+	err = tx.QueryRowContext(ctx, "SELECT id FROM features WHERE name = ?;", feature).Scan(&feature_id)
+	if errors.Is(err, sql.ErrNoRows) {
+		return 0, fmt.Errorf("%s: %w", op, storage.ErrFeatureNotFound)
+	}
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	encoded, err := encodeValue(value)
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err = setDeviceFeature(ctx, tx, device_id, feature_id, value, encoded); err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	revision, err := bumpRevision(ctx, tx, device_id)
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err = tx.Commit(); err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return revision, nil
 }
 
-func (s *Storage) UpdateDeviceFeature(ctx context.Context, device_uuid uuid.UUID, feature string, state bool) error {
-	const op = "storage.sqlite.UpdateDeviceFeature"
+// ReportAppliedRevision stores the state revision the device has applied and
+// returns both the desired and the applied revisions.
+func (s *Storage) ReportAppliedRevision(ctx context.Context, device_uuid uuid.UUID, revision int64) (models.DeviceRevision, error) {
+	const op = "storage.sqlite.ReportAppliedRevision"
 
 	s.mu.Lock()
 	defer s.mu.Unlock()
 
-	stmt, err := s.db.Prepare(
-		`INSERT OR REPLACE INTO device_features(device_id, feature_id, state) 
-		 VALUES(
-		 	(SELECT id FROM devices WHERE uuid = ?), 
-			(SELECT id FROM features WHERE name = ?), 
-			?
-		);`,
-	)
+	tx, err := s.db.Begin()
+	if err != nil {
+		return models.DeviceRevision{}, fmt.Errorf("%s: %w", op, err)
+	}
+	defer tx.Rollback()
+
+	var rev models.DeviceRevision
+	err = tx.QueryRowContext(
+		ctx,
+		"SELECT applied FROM device_revisions WHERE device_id = (SELECT id FROM devices WHERE uuid = ?);",
+		device_uuid,
+	).Scan(&rev.Previous)
+	if err != nil {
+		return models.DeviceRevision{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	err = tx.QueryRowContext(
+		ctx,
+		`UPDATE device_revisions SET applied = ?
+		 WHERE device_id = (SELECT id FROM devices WHERE uuid = ?)
+		 RETURNING device_id, desired, applied;`,
+		revision, device_uuid,
+	).Scan(&rev.DeviceId, &rev.Desired, &rev.Applied)
+	if err != nil {
+		return models.DeviceRevision{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err = tx.Commit(); err != nil {
+		return models.DeviceRevision{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return rev, nil
+}
+
+// ReportDeviceFeatures stores the feature values applied by the device.
+// Features unknown to the server and values of a wrong type are ignored.
+func (s *Storage) ReportDeviceFeatures(
+	ctx context.Context,
+	device_uuid uuid.UUID,
+	values map[string]models.FeatureValue,
+) error {
+	const op = "storage.sqlite.ReportDeviceFeatures"
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	tx, err := s.db.Begin()
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	defer tx.Rollback()
+
+	var device_id int64
+	err = tx.QueryRowContext(ctx, "SELECT id FROM devices WHERE uuid = ?;", device_uuid).Scan(&device_id)
+	if errors.Is(err, sql.ErrNoRows) {
+		return fmt.Errorf("%s: %w", op, storage.ErrDeviceNotFound)
+	}
 	if err != nil {
 		return fmt.Errorf("%s: %w", op, err)
 	}
 
-	_, err = stmt.ExecContext(ctx, device_uuid, feature, state)
+	stmt, err := tx.Prepare(
+		`UPDATE device_features SET
+			reported_state = ?1,
+			reported_value = ?2,
+			reported_at = unixepoch(),
+			drift_since = CASE
+				WHEN value = ?2 THEN NULL
+				ELSE COALESCE(drift_since, unixepoch())
+			END
+		 WHERE device_id = ?3 AND feature_id = (
+			SELECT id FROM features WHERE name = ?4 AND value_type = ?5
+		 );`,
+	)
 	if err != nil {
 		return fmt.Errorf("%s: %w", op, err)
 	}
 
+	for name, value := range values {
+		encoded, err := encodeValue(value)
+		if err != nil {
+			continue
+		}
+
+		_, err = stmt.ExecContext(ctx, legacyState(value), encoded, device_id, name, value.Type)
+		if err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
 	return nil
 }
 
+// DeviceDriftList returns a page of the devices matching the filter whose
+// reported features differ from the desired ones.
+func (s *Storage) DeviceDriftList(
+	ctx context.Context,
+	filter models.DeviceFilter,
+	page models.Page,
+) ([]models.DeviceDrift, string, error) {
+	const op = "storage.sqlite.DeviceDriftList"
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	where, args := deviceFilter(filter)
+	where += " AND EXISTS (SELECT 1 FROM device_features WHERE device_id = d.id AND drift_since IS NOT NULL)"
+
+	devices, next, err := s.devicePage(ctx, where, args, page)
+	if err != nil {
+		return nil, "", fmt.Errorf("%s: %w", op, err)
+	}
+
+	result := make([]models.DeviceDrift, 0, len(devices))
+	index := make(map[int64]int, len(devices))
+	for i, d := range devices {
+		result = append(result, models.DeviceDrift{DeviceId: d.Id, DeviceUuid: d.Uuid})
+		index[d.Id] = i
+	}
+
+	if len(devices) == 0 {
+		return result, next, nil
+	}
+
+	in, ids := deviceIds(devices)
+
+	rows, err := s.db.QueryContext(
+		ctx,
+		`SELECT df.device_id, f.name, f.value_type, df.value, df.reported_value, df.drift_since
+		 FROM device_features AS df
+			JOIN features AS f
+			ON df.feature_id = f.id
+		 WHERE df.drift_since IS NOT NULL AND df.device_id IN (`+in+`)
+		 ORDER BY f.name;`,
+		ids...,
+	)
+	if err != nil {
+		return nil, "", fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var (
+			drift      models.FeatureDrift
+			id         int64
+			value_type models.FeatureType
+			desired    string
+			reported   sql.NullString
+			since      int64
+		)
+		err = rows.Scan(&id, &drift.Feature, &value_type, &desired, &reported, &since)
+		if err != nil {
+			return nil, "", fmt.Errorf("%s: %w", op, err)
+		}
+
+		if drift.Desired, err = decodeValue(value_type, desired); err != nil {
+			return nil, "", fmt.Errorf("%s: %w", op, err)
+		}
+
+		if drift.Reported, err = decodeNullValue(value_type, reported); err != nil {
+			return nil, "", fmt.Errorf("%s: %w", op, err)
+		}
+
+		drift.Since = time.Unix(since, 0)
+
+		device := &result[index[id]]
+		device.Features = append(device.Features, drift)
+		if device.Since.IsZero() || drift.Since.Before(device.Since) {
+			device.Since = drift.Since
+		}
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, "", fmt.Errorf("%s: %w", op, err)
+	}
+
+	return result, next, nil
+}
+
 func (s *Storage) DeviceFeatures(ctx context.Context, device_uuid uuid.UUID) (models.DeviceFeatures, error) {
 	const op = "storage.sqlite.DeviceFeatures"
 
@@ -200,13 +600,16 @@ func (s *Storage) DeviceFeatures(ctx context.Context, device_uuid uuid.UUID) (mo
 
 	var device_id int64
 	row := stmt.QueryRowContext(ctx, device_uuid)
-	row.Scan(&device_id)
+	err = row.Scan(&device_id)
+	if errors.Is(err, sql.ErrNoRows) {
+		return models.DeviceFeatures{}, fmt.Errorf("%s: %w", op, storage.ErrDeviceNotFound)
+	}
 	if err != nil {
 		return models.DeviceFeatures{}, fmt.Errorf("%s: %w", op, err)
 	}
 
 	stmt, err = s.db.Prepare(
-		`SELECT f.name, d.state 
+		`SELECT f.name, f.value_type, d.value, d.reported_value 
 		 FROM device_features AS d
 			JOIN features AS f
 			ON d.feature_id = f.id
@@ -220,178 +623,228 @@ func (s *Storage) DeviceFeatures(ctx context.Context, device_uuid uuid.UUID) (mo
 	if err != nil {
 		return models.DeviceFeatures{}, fmt.Errorf("%s: %w", op, err)
 	}
+	defer rows.Close()
 
 	features := models.DeviceFeatures{
-		DeviceId: device_id,
-		Features: make(map[string]bool),
+		DeviceId:   device_id,
+		DeviceUuid: device_uuid,
+		Features:   make(map[string]models.FeatureValue),
+		Reported:   make(map[string]models.FeatureValue),
+	}
+
+	err = s.db.QueryRowContext(
+		ctx,
+		"SELECT desired FROM device_revisions WHERE device_id = ?;",
+		device_id,
+	).Scan(&features.Revision)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return models.DeviceFeatures{}, fmt.Errorf("%s: %w", op, err)
 	}
 
 	for rows.Next() {
 		var (
-			name  string
-			state bool
+			name       string
+			value_type models.FeatureType
+			value      string
+			reported   sql.NullString
 		)
-		err = rows.Scan(&name, &state)
+		err = rows.Scan(&name, &value_type, &value, &reported)
 		if err != nil {
 			return models.DeviceFeatures{}, fmt.Errorf("%s: %w", op, err)
 		}
 
-		features.Features[name] = state
+		if err = setFeature(&features, name, value_type, value, reported); err != nil {
+			return models.DeviceFeatures{}, fmt.Errorf("%s: %w", op, err)
+		}
+	}
+
+	if err = rows.Err(); err != nil {
+		return models.DeviceFeatures{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	policies, err := s.devicePolicies(ctx, placeholders(1), []any{device_id})
+	if err != nil {
+		return models.DeviceFeatures{}, fmt.Errorf("%s: %w", op, err)
 	}
+	features.Apps = policies[device_id]
 
 	return features, nil
 }
 
-func (s *Storage) DeviceList(ctx context.Context) ([]models.Device, error) {
+// DeviceList returns a page of the devices matching the filter together with
+// their labels.
+func (s *Storage) DeviceList(
+	ctx context.Context,
+	filter models.DeviceFilter,
+	page models.Page,
+) ([]models.Device, string, error) {
 	const op = "storage.sqlite.DeviceList"
 
 	s.mu.Lock()
 	defer s.mu.Unlock()
 
-	stmt, err := s.db.Prepare("SELECT COUNT(*) FROM devices;")
+	where, args := deviceFilter(filter)
+
+	result, next, err := s.devicePage(ctx, where, args, page)
 	if err != nil {
-		return nil, fmt.Errorf("%s: %w", op, err)
+		return nil, "", fmt.Errorf("%s: %w", op, err)
 	}
 
-	row := stmt.QueryRowContext(ctx)
-
-	var count int
-	if err = row.Scan(&count); err != nil {
-		return nil, fmt.Errorf("%s: %w", op, err)
+	if len(result) == 0 {
+		return result, next, nil
 	}
 
-	stmt, err = s.db.Prepare("SELECT id, uuid, type FROM devices;")
-	if err != nil {
-		return nil, fmt.Errorf("%s: %w", op, err)
-	}
+	in, ids := deviceIds(result)
 
-	rows, err := stmt.QueryContext(ctx)
+	labels, err := s.deviceLabels(ctx, "WHERE device_id IN ("+in+")", ids...)
 	if err != nil {
-		return nil, fmt.Errorf("%s: %w", op, err)
+		return nil, "", fmt.Errorf("%s: %w", op, err)
 	}
 
-	result := make([]models.Device, 0, count)
-	for rows.Next() {
-		var d models.Device
-		rows.Scan(&d.Id, &d.Uuid, &d.Type)
-
-		result = append(result, d)
+	for i := range result {
+		result[i].Labels = labels[result[i].Id]
 	}
 
-	return result, nil
+	return result, next, nil
 }
 
-func (s *Storage) DeviceStatusList(ctx context.Context) ([]models.DeviceStatus, error) {
+// DeviceStatusList returns the statuses of a page of the devices matching
+// the filter.
+func (s *Storage) DeviceStatusList(
+	ctx context.Context,
+	filter models.DeviceFilter,
+	page models.Page,
+) ([]models.DeviceStatus, string, error) {
 	const op = "storage.sqlite.DeviceStatusList"
 
 	s.mu.Lock()
 	defer s.mu.Unlock()
 
-	stmt, err := s.db.Prepare("SELECT COUNT(*) FROM devices;")
+	where, args := deviceFilter(filter)
+
+	devices, next, err := s.devicePage(ctx, where, args, page)
 	if err != nil {
-		return nil, fmt.Errorf("%s: %w", op, err)
+		return nil, "", fmt.Errorf("%s: %w", op, err)
 	}
 
-	row := stmt.QueryRowContext(ctx)
-
-	var count int
-	if err = row.Scan(&count); err != nil {
-		return nil, fmt.Errorf("%s: %w", op, err)
+	if len(devices) == 0 {
+		return nil, next, nil
 	}
 
-	stmt, err = s.db.Prepare(
-		`SELECT s.device_id, d.uuid, s.location, s.battery
-		 FROM device_statuses AS s
-			JOIN devices AS d
-			ON d.id == s.device_id;`,
+	in, ids := deviceIds(devices)
+
+	rows, err := s.db.QueryContext(
+		ctx,
+		`SELECT d.id, d.uuid, s.location, s.battery, d.registered_at, d.last_seen, d.last_state_fetch
+		 FROM devices AS d
+			LEFT JOIN device_statuses AS s
+			ON d.id = s.device_id
+		 WHERE d.id IN (`+in+`);`,
+		ids...,
 	)
 	if err != nil {
-		return nil, fmt.Errorf("%s: %w", op, err)
+		return nil, "", fmt.Errorf("%s: %w", op, err)
 	}
+	defer rows.Close()
 
-	rows, err := stmt.QueryContext(ctx)
-	if err != nil {
-		return nil, fmt.Errorf("%s: %w", op, err)
+	statuses := make(map[int64]models.DeviceStatus, len(devices))
+	for rows.Next() {
+		ds, err := scanDeviceStatus(rows)
+		if err != nil {
+			return nil, "", fmt.Errorf("%s: %w", op, err)
+		}
+
+		statuses[ds.DeviceId] = ds
 	}
 
-	result := make([]models.DeviceStatus, 0, count)
-	for rows.Next() {
-		var ds models.DeviceStatus
-		rows.Scan(&ds.DeviceId, &ds.DeviceUuid, &ds.Location, &ds.Battery)
+	if err = rows.Err(); err != nil {
+		return nil, "", fmt.Errorf("%s: %w", op, err)
+	}
 
-		result = append(result, ds)
+	result := make([]models.DeviceStatus, 0, len(devices))
+	for _, d := range devices {
+		result = append(result, statuses[d.Id])
 	}
 
-	return result, nil
+	return result, next, nil
 }
 
-func (s *Storage) DeviceFeaturesList(ctx context.Context) ([]models.DeviceFeatures, error) {
+// DeviceFeaturesList returns the features of a page of the devices matching
+// the filter.
+func (s *Storage) DeviceFeaturesList(
+	ctx context.Context,
+	filter models.DeviceFilter,
+	page models.Page,
+) ([]models.DeviceFeatures, string, error) {
 	const op = "storage.sqlite.DeviceFeaturesList"
 
 	s.mu.Lock()
 	defer s.mu.Unlock()
 
-	stmt, err := s.db.Prepare("SELECT COUNT(*) FROM devices;")
+	where, args := deviceFilter(filter)
+	where += " AND EXISTS (SELECT 1 FROM device_features WHERE device_id = d.id)"
+
+	devices, next, err := s.devicePage(ctx, where, args, page)
 	if err != nil {
-		return nil, fmt.Errorf("%s: %w", op, err)
+		return nil, "", fmt.Errorf("%s: %w", op, err)
 	}
 
-	row := stmt.QueryRowContext(ctx)
+	result := make([]models.DeviceFeatures, 0, len(devices))
+	index := make(map[int64]int, len(devices))
+	for i, d := range devices {
+		result = append(result, models.DeviceFeatures{
+			DeviceId:   d.Id,
+			DeviceUuid: d.Uuid,
+			Features:   make(map[string]models.FeatureValue),
+			Reported:   make(map[string]models.FeatureValue),
+		})
+		index[d.Id] = i
+	}
 
-	var count int
-	if err = row.Scan(&count); err != nil {
-		return nil, fmt.Errorf("%s: %w", op, err)
+	if len(devices) == 0 {
+		return result, next, nil
 	}
 
-	stmt, err = s.db.Prepare(
-		`SELECT d.id, d.uuid, f.name, df.state 
+	in, ids := deviceIds(devices)
+
+	rows, err := s.db.QueryContext(
+		ctx,
+		`SELECT df.device_id, f.name, f.value_type, df.value, df.reported_value
 		 FROM device_features AS df
 			JOIN features AS f
 			ON df.feature_id = f.id
-			JOIN devices AS d
-			ON df.device_id = d.id
-		ORDER BY d.id;`,
+		 WHERE df.device_id IN (`+in+`);`,
+		ids...,
 	)
 	if err != nil {
-		return nil, fmt.Errorf("%s: %w", op, err)
-	}
-
-	rows, err := stmt.QueryContext(ctx)
-	if err != nil {
-		return nil, fmt.Errorf("%s: %w", op, err)
+		return nil, "", fmt.Errorf("%s: %w", op, err)
 	}
+	defer rows.Close()
 
-	result := make([]models.DeviceFeatures, 0, count)
 	for rows.Next() {
 		var (
-			id            int64
-			uuidStr       string
-			feature_name  string
-			feature_state bool
+			id               int64
+			feature_name     string
+			feature_type     models.FeatureType
+			feature_value    string
+			feature_reported sql.NullString
 		)
-		rows.Scan(&id, &uuidStr, &feature_name, &feature_state)
-
-		i := slices.IndexFunc(result, func(df models.DeviceFeatures) bool { return df.DeviceId == id })
-		if i == -1 {
-			uuid, err := uuid.Parse(uuidStr)
-			if err != nil {
-				return nil, fmt.Errorf("%s: %w", op, err)
-			}
+		err = rows.Scan(&id, &feature_name, &feature_type, &feature_value, &feature_reported)
+		if err != nil {
+			return nil, "", fmt.Errorf("%s: %w", op, err)
+		}
 
-			df := models.DeviceFeatures{
-				DeviceId:   id,
-				DeviceUuid: uuid,
-				Features:   make(map[string]bool),
-			}
-			df.Features[feature_name] = feature_state
-
-			result = append(result, df)
-		} else {
-			result[i].Features[feature_name] = feature_state
+		err = setFeature(&result[index[id]], feature_name, feature_type, feature_value, feature_reported)
+		if err != nil {
+			return nil, "", fmt.Errorf("%s: %w", op, err)
 		}
 	}
 
-	return result, nil
+	if err = rows.Err(); err != nil {
+		return nil, "", fmt.Errorf("%s: %w", op, err)
+	}
+
+	return result, next, nil
 }
 
 func initDb(db *sql.DB) error {
@@ -401,24 +854,98 @@ func initDb(db *sql.DB) error {
 		`CREATE TABLE IF NOT EXISTS devices (
 			id INTEGER PRIMARY KEY AUTOINCREMENT, 
 			uuid TEXT NOT NULL UNIQUE,
-			type INTEGER NOT NULL
+			type INTEGER NOT NULL,
+			registered_at INTEGER,
+			last_seen INTEGER,
+			last_state_fetch INTEGER,
+			lifecycle INTEGER NOT NULL DEFAULT 1,
+			lifecycle_changed_at INTEGER
 		);`,
 	)
 	if err != nil {
 		return fmt.Errorf("%s: %w", op, err)
 	}
 
+	// время регистрации устройств, добавленных до появления колонок, неизвестно,
+	// а сами устройства считаются зарегистрированными (lifecycle enrolled)
+	err = addColumns(db, "devices", map[string]string{
+		"registered_at":        "INTEGER",
+		"last_seen":            "INTEGER",
+		"last_state_fetch":     "INTEGER",
+		"lifecycle":            "INTEGER NOT NULL DEFAULT 1",
+		"lifecycle_changed_at": "INTEGER",
+	})
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	// порядок списков устройств по типу
+	_, err = db.Exec("CREATE INDEX IF NOT EXISTS devices_type_id_idx ON devices(type, id);")
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
 	_, err = db.Exec(
 		`CREATE TABLE IF NOT EXISTS features (
 			id INTEGER PRIMARY KEY AUTOINCREMENT,
-			name TEXT NOT NULL UNIQUE
+			name TEXT NOT NULL UNIQUE,
+			description TEXT NOT NULL DEFAULT '',
+			default_state INTEGER NOT NULL DEFAULT 0 CHECK (default_state IN(0, 1)),
+			deprecated INTEGER NOT NULL DEFAULT 0 CHECK (deprecated IN(0, 1)),
+			value_type INTEGER NOT NULL DEFAULT 0,
+			default_value TEXT NOT NULL DEFAULT 'false',
+			int_min INTEGER,
+			int_max INTEGER,
+			enum_values TEXT
 		);`,
 	)
 	if err != nil {
 		return fmt.Errorf("%s: %w", op, err)
 	}
 
-	_, err = db.Exec(`INSERT OR IGNORE INTO features(name) VALUES('camera'),('storage');`)
+	err = addColumns(db, "features", map[string]string{
+		"description":   "TEXT NOT NULL DEFAULT ''",
+		"default_state": "INTEGER NOT NULL DEFAULT 0 CHECK (default_state IN(0, 1))",
+		"deprecated":    "INTEGER NOT NULL DEFAULT 0 CHECK (deprecated IN(0, 1))",
+		"value_type":    "INTEGER NOT NULL DEFAULT 0",
+		"default_value": "TEXT NOT NULL DEFAULT 'false'",
+		"int_min":       "INTEGER",
+		"int_max":       "INTEGER",
+		"enum_values":   "TEXT",
+	})
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	// функции, созданные до появления типов, являются bool
+	_, err = db.Exec(
+		`UPDATE features SET default_value = CASE default_state WHEN 1 THEN 'true' ELSE 'false' END
+		 WHERE value_type = 0;`,
+	)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	// начальное содержимое каталога, дальше он управляется через Control API
+	_, err = db.Exec(
+		`INSERT OR IGNORE INTO features(name, description) 
+		 VALUES('camera', 'Access to the device camera'),('storage', 'Access to the external storage');`,
+	)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	// пустой список типов означает, что функция применима ко всем устройствам
+	_, err = db.Exec(
+		`CREATE TABLE IF NOT EXISTS feature_device_types (
+			feature_id INTEGER NOT NULL,
+			device_type INTEGER NOT NULL,
+			CONSTRAINT feature_device_types_features_id_fk
+				FOREIGN KEY(feature_id)
+				REFERENCES features(id),
+			UNIQUE(feature_id, device_type)
+		);`,
+	)
 	if err != nil {
 		return fmt.Errorf("%s: %w", op, err)
 	}
@@ -429,6 +956,13 @@ func initDb(db *sql.DB) error {
 			device_id INTEGER NOT NULL,
 			feature_id INTEGER NOT NULL,
 			state INTEGER NOT NULL CHECK (state IN(0, 1)),
+			reported_state INTEGER CHECK (reported_state IN(0, 1)),
+			value TEXT,
+			reported_value TEXT,
+			desired_at INTEGER NOT NULL DEFAULT 0,
+			reported_at INTEGER,
+			drift_since INTEGER,
+			overridden INTEGER NOT NULL DEFAULT 0 CHECK (overridden IN(0, 1)),
 			CONSTRAINT device_features_devices_id_fk
 				FOREIGN KEY(device_id)
 				REFERENCES devices(id),
@@ -442,6 +976,69 @@ func initDb(db *sql.DB) error {
 		return fmt.Errorf("%s: %w", op, err)
 	}
 
+	columns, err := tableColumns(db, "device_features")
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	err = addColumns(db, "device_features", map[string]string{
+		"reported_state": "INTEGER CHECK (reported_state IN(0, 1))",
+		"desired_at":     "INTEGER NOT NULL DEFAULT 0",
+		"reported_at":    "INTEGER",
+		"drift_since":    "INTEGER",
+		"value":          "TEXT",
+		"reported_value": "TEXT",
+		"overridden":     "INTEGER NOT NULL DEFAULT 0 CHECK (overridden IN(0, 1))",
+	})
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	_, err = db.Exec(
+		`UPDATE device_features SET value = CASE state WHEN 1 THEN 'true' ELSE 'false' END
+		 WHERE value IS NULL;`,
+	)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	_, err = db.Exec(
+		`UPDATE device_features SET reported_value = CASE reported_state WHEN 1 THEN 'true' ELSE 'false' END
+		 WHERE reported_value IS NULL AND reported_state IS NOT NULL;`,
+	)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	// до появления групп значения, отличные от значений по умолчанию,
+	// могли быть заданы только самому устройству
+	if !columns["overridden"] {
+		_, err = db.Exec(
+			`UPDATE device_features SET overridden = 1
+			 WHERE value <> (SELECT default_value FROM features WHERE id = feature_id);`,
+		)
+		if err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+	}
+
+	// reported отличает метки, которые сообщило само устройство
+	_, err = db.Exec(
+		`CREATE TABLE IF NOT EXISTS device_labels (
+			device_id INTEGER NOT NULL,
+			key TEXT NOT NULL,
+			value TEXT NOT NULL DEFAULT '',
+			reported INTEGER NOT NULL DEFAULT 0 CHECK (reported IN(0, 1)),
+			CONSTRAINT device_labels_devices_id_fk
+				FOREIGN KEY(device_id)
+				REFERENCES devices(id),
+			UNIQUE(device_id, key)
+		);`,
+	)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
 	_, err = db.Exec(
 		`CREATE TABLE IF NOT EXISTS device_statuses (
 			id INTEGER PRIMARY KEY AUTOINCREMENT,
@@ -457,5 +1054,846 @@ func initDb(db *sql.DB) error {
 		return fmt.Errorf("%s: %w", op, err)
 	}
 
+	// device_statuses хранит последний статус, история нужна для графиков
+	// и прореживается по политике хранения
+	_, err = db.Exec(
+		`CREATE TABLE IF NOT EXISTS device_status_history (
+			id INTEGER PRIMARY KEY AUTOINCREMENT,
+			device_id INTEGER NOT NULL,
+			location TEXT NOT NULL,
+			battery INT NOT NULL,
+			reported_at INTEGER NOT NULL,
+			CONSTRAINT device_status_history_devices_id_fk
+				FOREIGN KEY(device_id)
+				REFERENCES devices(id)
+		);`,
+	)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	_, err = db.Exec(
+		`CREATE INDEX IF NOT EXISTS device_status_history_device_id_reported_at_idx
+		 ON device_status_history(device_id, reported_at);`,
+	)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	// desired увеличивается при каждом изменении состояния устройства,
+	// applied сообщает само устройство после применения состояния
+	_, err = db.Exec(
+		`CREATE TABLE IF NOT EXISTS device_revisions (
+			device_id INTEGER PRIMARY KEY,
+			desired INTEGER NOT NULL DEFAULT 0,
+			applied INTEGER NOT NULL DEFAULT 0,
+			CONSTRAINT device_revisions_devices_id_fk
+				FOREIGN KEY(device_id)
+				REFERENCES devices(id)
+		);`,
+	)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	_, err = db.Exec(`INSERT OR IGNORE INTO device_revisions(device_id) SELECT id FROM devices;`)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	_, err = db.Exec(
+		`CREATE TABLE IF NOT EXISTS device_groups (
+			id INTEGER PRIMARY KEY AUTOINCREMENT,
+			name TEXT NOT NULL UNIQUE,
+			parent_id INTEGER,
+			CONSTRAINT device_groups_device_groups_id_fk
+				FOREIGN KEY(parent_id)
+				REFERENCES device_groups(id)
+		);`,
+	)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	_, err = db.Exec(
+		`CREATE TABLE IF NOT EXISTS device_group_members (
+			group_id INTEGER NOT NULL,
+			device_id INTEGER NOT NULL,
+			CONSTRAINT device_group_members_device_groups_id_fk
+				FOREIGN KEY(group_id)
+				REFERENCES device_groups(id),
+			CONSTRAINT device_group_members_devices_id_fk
+				FOREIGN KEY(device_id)
+				REFERENCES devices(id),
+			UNIQUE(group_id, device_id)
+		);`,
+	)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	_, err = db.Exec(
+		`CREATE TABLE IF NOT EXISTS device_group_features (
+			group_id INTEGER NOT NULL,
+			feature_id INTEGER NOT NULL,
+			value TEXT NOT NULL,
+			CONSTRAINT device_group_features_device_groups_id_fk
+				FOREIGN KEY(group_id)
+				REFERENCES device_groups(id),
+			CONSTRAINT device_group_features_features_id_fk
+				FOREIGN KEY(feature_id)
+				REFERENCES features(id),
+			UNIQUE(group_id, feature_id)
+		);`,
+	)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	_, err = db.Exec(
+		`CREATE TABLE IF NOT EXISTS profiles (
+			id INTEGER PRIMARY KEY AUTOINCREMENT,
+			name TEXT NOT NULL UNIQUE,
+			description TEXT NOT NULL DEFAULT '',
+			version INTEGER NOT NULL DEFAULT 1
+		);`,
+	)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	_, err = db.Exec(
+		`CREATE TABLE IF NOT EXISTS profile_features (
+			profile_id INTEGER NOT NULL,
+			feature_id INTEGER NOT NULL,
+			value TEXT NOT NULL,
+			CONSTRAINT profile_features_profiles_id_fk
+				FOREIGN KEY(profile_id)
+				REFERENCES profiles(id),
+			CONSTRAINT profile_features_features_id_fk
+				FOREIGN KEY(feature_id)
+				REFERENCES features(id),
+			UNIQUE(profile_id, feature_id)
+		);`,
+	)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	_, err = db.Exec(
+		`CREATE TABLE IF NOT EXISTS device_profiles (
+			device_id INTEGER NOT NULL,
+			profile_id INTEGER NOT NULL,
+			CONSTRAINT device_profiles_devices_id_fk
+				FOREIGN KEY(device_id)
+				REFERENCES devices(id),
+			CONSTRAINT device_profiles_profiles_id_fk
+				FOREIGN KEY(profile_id)
+				REFERENCES profiles(id),
+			UNIQUE(device_id, profile_id)
+		);`,
+	)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	_, err = db.Exec(
+		`CREATE TABLE IF NOT EXISTS device_group_profiles (
+			group_id INTEGER NOT NULL,
+			profile_id INTEGER NOT NULL,
+			CONSTRAINT device_group_profiles_device_groups_id_fk
+				FOREIGN KEY(group_id)
+				REFERENCES device_groups(id),
+			CONSTRAINT device_group_profiles_profiles_id_fk
+				FOREIGN KEY(profile_id)
+				REFERENCES profiles(id),
+			UNIQUE(group_id, profile_id)
+		);`,
+	)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	// duration хранится в секундах
+	_, err = db.Exec(
+		`CREATE TABLE IF NOT EXISTS alert_rules (
+			id INTEGER PRIMARY KEY AUTOINCREMENT,
+			name TEXT NOT NULL UNIQUE,
+			condition INTEGER NOT NULL,
+			threshold INTEGER NOT NULL DEFAULT 0,
+			duration INTEGER NOT NULL DEFAULT 0,
+			feature TEXT NOT NULL DEFAULT '',
+			from_config INTEGER NOT NULL DEFAULT 0 CHECK (from_config IN(0, 1))
+		);`,
+	)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	_, err = db.Exec(
+		`CREATE TABLE IF NOT EXISTS alerts (
+			id INTEGER PRIMARY KEY AUTOINCREMENT,
+			rule_id INTEGER NOT NULL,
+			device_id INTEGER NOT NULL,
+			message TEXT NOT NULL,
+			count INTEGER NOT NULL DEFAULT 1,
+			fired_at INTEGER NOT NULL,
+			last_fired_at INTEGER NOT NULL,
+			resolved_at INTEGER,
+			acknowledged_at INTEGER,
+			CONSTRAINT alerts_alert_rules_id_fk
+				FOREIGN KEY(rule_id)
+				REFERENCES alert_rules(id),
+			CONSTRAINT alerts_devices_id_fk
+				FOREIGN KEY(device_id)
+				REFERENCES devices(id)
+		);`,
+	)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	// у правила не больше одного активного оповещения на устройство
+	_, err = db.Exec(
+		`CREATE UNIQUE INDEX IF NOT EXISTS alerts_firing_idx
+		 ON alerts(rule_id, device_id) WHERE resolved_at IS NULL;`,
+	)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	// events - типы событий через запятую, пустая строка означает все типы
+	_, err = db.Exec(
+		`CREATE TABLE IF NOT EXISTS webhooks (
+			id INTEGER PRIMARY KEY AUTOINCREMENT,
+			name TEXT NOT NULL UNIQUE,
+			url TEXT NOT NULL,
+			secret TEXT NOT NULL,
+			events TEXT NOT NULL DEFAULT '',
+			from_config INTEGER NOT NULL DEFAULT 0 CHECK (from_config IN(0, 1))
+		);`,
+	)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	// доставка завершена, когда задано delivered_at или failed_at
+	_, err = db.Exec(
+		`CREATE TABLE IF NOT EXISTS webhook_deliveries (
+			id INTEGER PRIMARY KEY AUTOINCREMENT,
+			webhook_id INTEGER NOT NULL,
+			event_id TEXT NOT NULL,
+			event_type TEXT NOT NULL,
+			payload BLOB NOT NULL,
+			attempts INTEGER NOT NULL DEFAULT 0,
+			created_at INTEGER NOT NULL,
+			next_attempt_at INTEGER NOT NULL,
+			delivered_at INTEGER,
+			failed_at INTEGER,
+			last_error TEXT NOT NULL DEFAULT '',
+			CONSTRAINT webhook_deliveries_webhooks_id_fk
+				FOREIGN KEY(webhook_id)
+				REFERENCES webhooks(id)
+		);`,
+	)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	_, err = db.Exec(
+		`CREATE INDEX IF NOT EXISTS webhook_deliveries_pending_idx
+		 ON webhook_deliveries(next_attempt_at) WHERE delivered_at IS NULL AND failed_at IS NULL;`,
+	)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	// status - models.CommandStatus, completed_at задан для завершенных команд
+	_, err = db.Exec(
+		`CREATE TABLE IF NOT EXISTS commands (
+			id INTEGER PRIMARY KEY AUTOINCREMENT,
+			device_id INTEGER NOT NULL,
+			type INTEGER NOT NULL,
+			payload TEXT NOT NULL DEFAULT '',
+			status INTEGER NOT NULL DEFAULT 0,
+			result TEXT NOT NULL DEFAULT '',
+			created_at INTEGER NOT NULL,
+			delivered_at INTEGER,
+			acknowledged_at INTEGER,
+			completed_at INTEGER,
+			expires_at INTEGER NOT NULL,
+			CONSTRAINT commands_devices_id_fk
+				FOREIGN KEY(device_id)
+				REFERENCES devices(id)
+		);`,
+	)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	_, err = db.Exec("CREATE INDEX IF NOT EXISTS commands_device_id_status_idx ON commands(device_id, status);")
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	// хранится только хеш токена, used_at - время последнего использования.
+	// Группа и профиль хранятся по имени и проверяются при регистрации
+	_, err = db.Exec(
+		`CREATE TABLE IF NOT EXISTS enrollment_tokens (
+			id INTEGER PRIMARY KEY AUTOINCREMENT,
+			token_hash TEXT NOT NULL UNIQUE,
+			description TEXT NOT NULL DEFAULT '',
+			max_uses INTEGER NOT NULL DEFAULT 1,
+			uses INTEGER NOT NULL DEFAULT 0,
+			group_name TEXT NOT NULL DEFAULT '',
+			profile_name TEXT NOT NULL DEFAULT '',
+			created_at INTEGER NOT NULL,
+			expires_at INTEGER NOT NULL,
+			used_at INTEGER,
+			revoked_at INTEGER
+		);`,
+	)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	err = addColumns(db, "enrollment_tokens", map[string]string{
+		"description":  "TEXT NOT NULL DEFAULT ''",
+		"max_uses":     "INTEGER NOT NULL DEFAULT 1",
+		"uses":         "INTEGER NOT NULL DEFAULT 0",
+		"group_name":   "TEXT NOT NULL DEFAULT ''",
+		"profile_name": "TEXT NOT NULL DEFAULT ''",
+		"revoked_at":   "INTEGER",
+	})
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	// одноразовые токены, использованные до появления счетчика
+	_, err = db.Exec("UPDATE enrollment_tokens SET uses = max_uses WHERE used_at IS NOT NULL AND uses = 0;")
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	_, err = db.Exec(
+		`CREATE TABLE IF NOT EXISTS enrollment_token_types (
+			token_id INTEGER NOT NULL,
+			device_type INTEGER NOT NULL,
+			CONSTRAINT enrollment_token_types_enrollment_tokens_id_fk
+				FOREIGN KEY(token_id)
+				REFERENCES enrollment_tokens(id),
+			UNIQUE(token_id, device_type)
+		);`,
+	)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	_, err = db.Exec(
+		`CREATE TABLE IF NOT EXISTS enrollment_token_labels (
+			token_id INTEGER NOT NULL,
+			key TEXT NOT NULL,
+			value TEXT NOT NULL,
+			CONSTRAINT enrollment_token_labels_enrollment_tokens_id_fk
+				FOREIGN KEY(token_id)
+				REFERENCES enrollment_tokens(id),
+			UNIQUE(token_id, key)
+		);`,
+	)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	// журнал аудита только дополняется, записи связаны цепочкой хешей,
+	// устройства хранятся по UUID, чтобы записи переживали устройства
+	_, err = db.Exec(
+		`CREATE TABLE IF NOT EXISTS audit_log (
+			id INTEGER PRIMARY KEY,
+			time INTEGER NOT NULL,
+			actor TEXT NOT NULL,
+			peer TEXT NOT NULL,
+			method TEXT NOT NULL,
+			group_name TEXT NOT NULL,
+			payload TEXT NOT NULL,
+			previous TEXT NOT NULL,
+			new TEXT NOT NULL,
+			result TEXT NOT NULL,
+			error TEXT NOT NULL,
+			prev_hash TEXT NOT NULL,
+			hash TEXT NOT NULL
+		);`,
+	)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	_, err = db.Exec("CREATE INDEX IF NOT EXISTS audit_log_time_idx ON audit_log(time);")
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	_, err = db.Exec("CREATE INDEX IF NOT EXISTS audit_log_actor_idx ON audit_log(actor);")
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	_, err = db.Exec(
+		`CREATE TABLE IF NOT EXISTS audit_devices (
+			record_id INTEGER NOT NULL,
+			device_uuid TEXT NOT NULL,
+			CONSTRAINT audit_devices_audit_log_id_fk
+				FOREIGN KEY(record_id)
+				REFERENCES audit_log(id),
+			UNIQUE(record_id, device_uuid)
+		);`,
+	)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	_, err = db.Exec("CREATE INDEX IF NOT EXISTS audit_devices_device_uuid_idx ON audit_devices(device_uuid);")
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	// триггеры запрещают изменять журнал через sql, подмену файла базы
+	// обнаруживает проверка цепочки
+	for _, table := range []string{"audit_log", "audit_devices"} {
+		for _, action := range []string{"UPDATE", "DELETE"} {
+			_, err = db.Exec(
+				`CREATE TRIGGER IF NOT EXISTS ` + table + `_no_` + strings.ToLower(action) + `
+				 BEFORE ` + action + ` ON ` + table + `
+				 BEGIN SELECT RAISE(ABORT, 'audit log is append-only'); END;`,
+			)
+			if err != nil {
+				return fmt.Errorf("%s: %w", op, err)
+			}
+		}
+	}
+
+	// роли операторов хранятся по имени, встроенные роли в базе не хранятся
+	_, err = db.Exec(
+		`CREATE TABLE IF NOT EXISTS operators (
+			id INTEGER PRIMARY KEY AUTOINCREMENT,
+			name TEXT NOT NULL UNIQUE,
+			token_hash TEXT UNIQUE,
+			from_config INTEGER NOT NULL DEFAULT 0,
+			created_at INTEGER NOT NULL
+		);`,
+	)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	_, err = db.Exec(
+		`CREATE TABLE IF NOT EXISTS roles (
+			id INTEGER PRIMARY KEY AUTOINCREMENT,
+			name TEXT NOT NULL UNIQUE,
+			description TEXT NOT NULL DEFAULT '',
+			selector TEXT NOT NULL DEFAULT ''
+		);`,
+	)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	_, err = db.Exec(
+		`CREATE TABLE IF NOT EXISTS role_permissions (
+			role_id INTEGER NOT NULL,
+			permission INTEGER NOT NULL,
+			CONSTRAINT role_permissions_roles_id_fk
+				FOREIGN KEY(role_id)
+				REFERENCES roles(id),
+			UNIQUE(role_id, permission)
+		);`,
+	)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	_, err = db.Exec(
+		`CREATE TABLE IF NOT EXISTS role_groups (
+			role_id INTEGER NOT NULL,
+			group_name TEXT NOT NULL,
+			CONSTRAINT role_groups_roles_id_fk
+				FOREIGN KEY(role_id)
+				REFERENCES roles(id),
+			UNIQUE(role_id, group_name)
+		);`,
+	)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	_, err = db.Exec(
+		`CREATE TABLE IF NOT EXISTS operator_roles (
+			operator_id INTEGER NOT NULL,
+			role TEXT NOT NULL,
+			CONSTRAINT operator_roles_operators_id_fk
+				FOREIGN KEY(operator_id)
+				REFERENCES operators(id),
+			UNIQUE(operator_id, role)
+		);`,
+	)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	// serial - серийный номер сертификата в шестнадцатеричной записи
+	_, err = db.Exec(
+		`CREATE TABLE IF NOT EXISTS certificates (
+			serial TEXT PRIMARY KEY,
+			device_id INTEGER NOT NULL,
+			not_before INTEGER NOT NULL,
+			not_after INTEGER NOT NULL,
+			issued_at INTEGER NOT NULL,
+			revoked_at INTEGER,
+			CONSTRAINT certificates_devices_id_fk
+				FOREIGN KEY(device_id)
+				REFERENCES devices(id)
+		);`,
+	)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	// data - инвентарь в JSON, новая версия записывается только при изменениях,
+	// confirmed_at - последний отчет устройства с тем же инвентарем
+	_, err = db.Exec(
+		`CREATE TABLE IF NOT EXISTS inventory_snapshots (
+			id INTEGER PRIMARY KEY AUTOINCREMENT,
+			device_id INTEGER NOT NULL,
+			version INTEGER NOT NULL,
+			data TEXT NOT NULL,
+			reported_at INTEGER NOT NULL,
+			confirmed_at INTEGER NOT NULL,
+			UNIQUE(device_id, version),
+			CONSTRAINT inventory_snapshots_devices_id_fk
+				FOREIGN KEY(device_id)
+				REFERENCES devices(id)
+		);`,
+	)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	// отличия версии от предыдущей, у первой версии их нет
+	_, err = db.Exec(
+		`CREATE TABLE IF NOT EXISTS inventory_changes (
+			snapshot_id INTEGER NOT NULL,
+			field TEXT NOT NULL,
+			previous TEXT NOT NULL DEFAULT '',
+			current TEXT NOT NULL DEFAULT '',
+			PRIMARY KEY(snapshot_id, field),
+			CONSTRAINT inventory_changes_snapshots_id_fk
+				FOREIGN KEY(snapshot_id)
+				REFERENCES inventory_snapshots(id)
+		);`,
+	)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	// приложения последней версии инвентаря для выборок по устройствам
+	_, err = db.Exec(
+		`CREATE TABLE IF NOT EXISTS device_apps (
+			device_id INTEGER NOT NULL,
+			app_id TEXT NOT NULL,
+			name TEXT NOT NULL DEFAULT '',
+			version TEXT NOT NULL DEFAULT '',
+			PRIMARY KEY(device_id, app_id),
+			CONSTRAINT device_apps_devices_id_fk
+				FOREIGN KEY(device_id)
+				REFERENCES devices(id)
+		);`,
+	)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	_, err = db.Exec("CREATE INDEX IF NOT EXISTS device_apps_app_id_idx ON device_apps(app_id);")
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	// каталог приложений, app_id - имя пакета или bundle id,
+	// version - версия, которую устанавливает устройство
+	_, err = db.Exec(
+		`CREATE TABLE IF NOT EXISTS apps (
+			id INTEGER PRIMARY KEY AUTOINCREMENT,
+			app_id TEXT NOT NULL UNIQUE,
+			name TEXT NOT NULL DEFAULT '',
+			version TEXT NOT NULL DEFAULT ''
+		);`,
+	)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	// правила приложений профилей, rule - models.AppRule
+	_, err = db.Exec(
+		`CREATE TABLE IF NOT EXISTS profile_apps (
+			profile_id INTEGER NOT NULL,
+			app_id INTEGER NOT NULL,
+			rule INTEGER NOT NULL,
+			PRIMARY KEY(profile_id, app_id),
+			CONSTRAINT profile_apps_profiles_id_fk
+				FOREIGN KEY(profile_id)
+				REFERENCES profiles(id),
+			CONSTRAINT profile_apps_apps_id_fk
+				FOREIGN KEY(app_id)
+				REFERENCES apps(id)
+		);`,
+	)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	_, err = db.Exec(
+		`CREATE TABLE IF NOT EXISTS device_group_apps (
+			group_id INTEGER NOT NULL,
+			app_id INTEGER NOT NULL,
+			rule INTEGER NOT NULL,
+			PRIMARY KEY(group_id, app_id),
+			CONSTRAINT device_group_apps_groups_id_fk
+				FOREIGN KEY(group_id)
+				REFERENCES device_groups(id),
+			CONSTRAINT device_group_apps_apps_id_fk
+				FOREIGN KEY(app_id)
+				REFERENCES apps(id)
+		);`,
+	)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	// политика приложений устройства, вычисленная по его профилям и группам
+	_, err = db.Exec(
+		`CREATE TABLE IF NOT EXISTS device_app_policy (
+			device_id INTEGER NOT NULL,
+			app_id INTEGER NOT NULL,
+			rule INTEGER NOT NULL,
+			PRIMARY KEY(device_id, app_id),
+			CONSTRAINT device_app_policy_devices_id_fk
+				FOREIGN KEY(device_id)
+				REFERENCES devices(id),
+			CONSTRAINT device_app_policy_apps_id_fk
+				FOREIGN KEY(app_id)
+				REFERENCES apps(id)
+		);`,
+	)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	// duration хранится в секундах
+	_, err = db.Exec(
+		`CREATE TABLE IF NOT EXISTS compliance_rules (
+			id INTEGER PRIMARY KEY AUTOINCREMENT,
+			name TEXT NOT NULL UNIQUE,
+			condition INTEGER NOT NULL,
+			os_name TEXT NOT NULL DEFAULT '',
+			version TEXT NOT NULL DEFAULT '',
+			feature TEXT NOT NULL DEFAULT '',
+			threshold INTEGER NOT NULL DEFAULT 0,
+			duration INTEGER NOT NULL DEFAULT 0,
+			selector TEXT NOT NULL DEFAULT ''
+		);`,
+	)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	// запись истории добавляется при изменении статуса или причин, причины
+	// текущего статуса - причины последней записи
+	_, err = db.Exec(
+		`CREATE TABLE IF NOT EXISTS compliance_history (
+			id INTEGER PRIMARY KEY AUTOINCREMENT,
+			device_id INTEGER NOT NULL,
+			status INTEGER NOT NULL,
+			recorded_at INTEGER NOT NULL,
+			CONSTRAINT compliance_history_devices_id_fk
+				FOREIGN KEY(device_id)
+				REFERENCES devices(id)
+		);`,
+	)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	_, err = db.Exec(
+		`CREATE INDEX IF NOT EXISTS compliance_history_device_idx
+		 ON compliance_history(device_id, recorded_at);`,
+	)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	// правила хранятся по имени, причины удаленного правила остаются в истории
+	_, err = db.Exec(
+		`CREATE TABLE IF NOT EXISTS compliance_reasons (
+			history_id INTEGER NOT NULL,
+			rule TEXT NOT NULL,
+			condition INTEGER NOT NULL,
+			message TEXT NOT NULL,
+			CONSTRAINT compliance_reasons_compliance_history_id_fk
+				FOREIGN KEY(history_id)
+				REFERENCES compliance_history(id)
+		);`,
+	)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	_, err = db.Exec(
+		`CREATE TABLE IF NOT EXISTS device_compliance (
+			device_id INTEGER PRIMARY KEY,
+			history_id INTEGER NOT NULL,
+			evaluated_at INTEGER NOT NULL,
+			CONSTRAINT device_compliance_devices_id_fk
+				FOREIGN KEY(device_id)
+				REFERENCES devices(id),
+			CONSTRAINT device_compliance_compliance_history_id_fk
+				FOREIGN KEY(history_id)
+				REFERENCES compliance_history(id)
+		);`,
+	)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
 	return nil
 }
+
+// addColumns adds columns missing from a table created by an earlier
+// version of the storage.
+func addColumns(db *sql.DB, table string, columns map[string]string) error {
+	existing, err := tableColumns(db, table)
+	if err != nil {
+		return err
+	}
+
+	for name, definition := range columns {
+		if existing[name] {
+			continue
+		}
+
+		_, err = db.Exec(fmt.Sprintf("ALTER TABLE %s ADD COLUMN %s %s;", table, name, definition))
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// tableColumns returns the names of the table columns.
+func tableColumns(db *sql.DB, table string) (map[string]bool, error) {
+	rows, err := db.Query("SELECT name FROM pragma_table_info(?);", table)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	result := make(map[string]bool)
+	for rows.Next() {
+		var name string
+		if err = rows.Scan(&name); err != nil {
+			return nil, err
+		}
+
+		result[name] = true
+	}
+
+	return result, rows.Err()
+}
+
+// setDeviceFeature sets the feature value on the device itself, encoded is
+// the value encoded with encodeValue. The revision is not bumped.
+func setDeviceFeature(
+	ctx context.Context,
+	tx *sql.Tx,
+	device_id int64,
+	feature_id int64,
+	value models.FeatureValue,
+	encoded string,
+) error {
+	// drift_since сохраняется, пока устройство не сообщит желаемое состояние,
+	// значение, заданное устройству, больше не наследуется от групп
+	_, err := tx.ExecContext(
+		ctx,
+		`INSERT INTO device_features(device_id, feature_id, state, value, overridden, desired_at, drift_since) 
+		 VALUES(?, ?, ?, ?, 1, unixepoch(), unixepoch())
+		 ON CONFLICT(device_id, feature_id) DO UPDATE SET
+			state = excluded.state,
+			value = excluded.value,
+			overridden = 1,
+			desired_at = excluded.desired_at,
+			drift_since = CASE
+				WHEN reported_value IS excluded.value THEN NULL
+				ELSE COALESCE(drift_since, excluded.drift_since)
+			END;`,
+		device_id, feature_id, legacyState(value), encoded,
+	)
+
+	return err
+}
+
+// bumpRevision increments the desired state revision of the device and
+// returns the new value.
+func bumpRevision(ctx context.Context, tx *sql.Tx, device_id int64) (int64, error) {
+	var revision int64
+	err := tx.QueryRowContext(
+		ctx,
+		`INSERT INTO device_revisions(device_id, desired) VALUES(?, 1)
+		 ON CONFLICT(device_id) DO UPDATE SET desired = desired + 1
+		 RETURNING desired;`,
+		device_id,
+	).Scan(&revision)
+
+	return revision, err
+}
+
+// featureAppliesTo returns an SQL condition that holds if the feature applies
+// to the device type.
+func featureAppliesTo(feature_id, device_type string) string {
+	return `(NOT EXISTS (SELECT 1 FROM feature_device_types WHERE feature_id = ` + feature_id + `)
+		OR EXISTS (SELECT 1 FROM feature_device_types WHERE feature_id = ` + feature_id + ` AND device_type = ` + device_type + `))`
+}
+
+type scanner interface {
+	Scan(dest ...any) error
+}
+
+// scanDeviceStatus scans device id, uuid, location, battery and the
+// registered_at, last_seen and last_state_fetch timestamps.
+func scanDeviceStatus(row scanner) (models.DeviceStatus, error) {
+	var (
+		ds                        models.DeviceStatus
+		location                  sql.NullString
+		battery                   sql.NullInt64
+		registered, seen, fetched sql.NullInt64
+	)
+	err := row.Scan(&ds.DeviceId, &ds.DeviceUuid, &location, &battery, &registered, &seen, &fetched)
+	if err != nil {
+		return models.DeviceStatus{}, err
+	}
+
+	ds.Location = location.String
+	ds.Battery = int(battery.Int64)
+	ds.Reported = battery.Valid
+	ds.RegisteredAt = unixTime(registered)
+	ds.LastSeen = unixTime(seen)
+	ds.LastStateFetch = unixTime(fetched)
+
+	return ds, nil
+}
+
+// unixTime converts a nullable unix timestamp, NULL becomes the zero time.
+func unixTime(t sql.NullInt64) time.Time {
+	if !t.Valid {
+		return time.Time{}
+	}
+
+	return time.Unix(t.Int64, 0)
+}
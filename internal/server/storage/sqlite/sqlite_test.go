@@ -0,0 +1,117 @@
+package sqlite
+
+import (
+	"context"
+	"errors"
+	"path/filepath"
+	"testing"
+
+	"github.com/dvaxert/mdm/internal/domain/models"
+	"github.com/dvaxert/mdm/internal/server/storage"
+	"github.com/google/uuid"
+)
+
+func TestDeviceRevision(t *testing.T) {
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "storage.db")
+
+	s, err := New(path)
+	if err != nil {
+		t.Fatalf("open storage: %v", err)
+	}
+	t.Cleanup(func() { s.Close() })
+
+	device := registerDevices(t, s, models.Ios)[0]
+
+	// шаги выполняются по порядку на одном устройстве, reopen проверяет,
+	// что ревизии переживают перезапуск сервера
+	steps := []struct {
+		name    string
+		reopen  bool
+		feature string
+		value   models.FeatureValue
+		report  int64
+		want    models.DeviceRevision
+	}{
+		{name: "registered", report: 0, want: models.DeviceRevision{Desired: 0, Applied: 0}},
+		{name: "feature changed", feature: "camera", value: models.BoolValue(true), want: models.DeviceRevision{Desired: 1}},
+		{name: "another feature changed", feature: "storage", value: models.BoolValue(true), want: models.DeviceRevision{Desired: 2}},
+		{name: "older revision applied", report: 1, want: models.DeviceRevision{Desired: 2, Applied: 1, Previous: 0}},
+		{name: "restart", reopen: true, report: 1, want: models.DeviceRevision{Desired: 2, Applied: 1, Previous: 1}},
+		{name: "desired revision applied", report: 2, want: models.DeviceRevision{Desired: 2, Applied: 2, Previous: 1}},
+		{name: "same value set again", feature: "camera", value: models.BoolValue(true), want: models.DeviceRevision{Desired: 3}},
+		{name: "change kept over restart", reopen: true, report: 2, want: models.DeviceRevision{Desired: 3, Applied: 2, Previous: 2}},
+	}
+
+	for _, step := range steps {
+		if step.reopen {
+			s.Close()
+
+			if s, err = New(path); err != nil {
+				t.Fatalf("%s: reopen storage: %v", step.name, err)
+			}
+		}
+
+		if step.feature != "" {
+			revision, err := s.UpdateDeviceFeature(ctx, device, step.feature, step.value)
+			if err != nil {
+				t.Fatalf("%s: UpdateDeviceFeature() error = %v", step.name, err)
+			}
+
+			if revision != step.want.Desired {
+				t.Errorf("%s: UpdateDeviceFeature() = %d, want %d", step.name, revision, step.want.Desired)
+			}
+
+			continue
+		}
+
+		got, err := s.ReportAppliedRevision(ctx, device, step.report)
+		if err != nil {
+			t.Fatalf("%s: ReportAppliedRevision() error = %v", step.name, err)
+		}
+
+		got.DeviceId = 0
+		if got != step.want {
+			t.Errorf("%s: ReportAppliedRevision() = %+v, want %+v", step.name, got, step.want)
+		}
+
+		if changed := step.want.Desired != step.want.Applied; got.StateChanged() != changed {
+			t.Errorf("%s: StateChanged() = %t, want %t", step.name, got.StateChanged(), changed)
+		}
+	}
+}
+
+func TestUpdateDeviceFeatureNotFound(t *testing.T) {
+	ctx := context.Background()
+
+	s := newStorage(t)
+	device := registerDevices(t, s, models.Ios)[0]
+
+	tests := []struct {
+		name    string
+		device  uuid.UUID
+		feature string
+		wantErr error
+	}{
+		{name: "unknown device", device: uuid.New(), feature: "camera", wantErr: storage.ErrDeviceNotFound},
+		{name: "unknown feature", device: device, feature: "missing", wantErr: storage.ErrFeatureNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := s.UpdateDeviceFeature(ctx, tt.device, tt.feature, models.BoolValue(true))
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("UpdateDeviceFeature() error = %v, want %v", err, tt.wantErr)
+			}
+
+			features, err := s.DeviceFeatures(ctx, device)
+			if err != nil {
+				t.Fatalf("DeviceFeatures() error = %v", err)
+			}
+
+			if features.Revision != 0 {
+				t.Errorf("failed change bumped the revision to %d", features.Revision)
+			}
+		})
+	}
+}
@@ -0,0 +1,111 @@
+package sqlite
+
+import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+
+	"github.com/dvaxert/mdm/internal/domain/models"
+)
+
+// Значения функций хранятся в колонках value в виде JSON, тип значения
+// определяется каталогом. Колонки state дублируют значения bool функций
+// для совместимости со старыми версиями хранилища.
+
+func encodeValue(v models.FeatureValue) (string, error) {
+	var raw any
+
+	switch v.Type {
+	case models.FeatureBool:
+		raw = v.Bool
+	case models.FeatureInt:
+		raw = v.Int
+	case models.FeatureString, models.FeatureEnum:
+		raw = v.Str
+	case models.FeatureStringList:
+		list := v.List
+		if list == nil {
+			list = []string{}
+		}
+		raw = list
+	default:
+		return "", fmt.Errorf("unknown feature type %d", v.Type)
+	}
+
+	b, err := json.Marshal(raw)
+	if err != nil {
+		return "", err
+	}
+
+	return string(b), nil
+}
+
+func decodeValue(t models.FeatureType, s string) (models.FeatureValue, error) {
+	v := models.FeatureValue{Type: t}
+
+	var err error
+	switch t {
+	case models.FeatureBool:
+		err = json.Unmarshal([]byte(s), &v.Bool)
+	case models.FeatureInt:
+		err = json.Unmarshal([]byte(s), &v.Int)
+	case models.FeatureString, models.FeatureEnum:
+		err = json.Unmarshal([]byte(s), &v.Str)
+	case models.FeatureStringList:
+		err = json.Unmarshal([]byte(s), &v.List)
+	default:
+		err = fmt.Errorf("unknown feature type %d", t)
+	}
+	if err != nil {
+		return models.FeatureValue{}, err
+	}
+
+	return v, nil
+}
+
+// decodeNullValue decodes a value column that may be NULL.
+func decodeNullValue(t models.FeatureType, s sql.NullString) (*models.FeatureValue, error) {
+	if !s.Valid {
+		return nil, nil
+	}
+
+	v, err := decodeValue(t, s.String)
+	if err != nil {
+		return nil, err
+	}
+
+	return &v, nil
+}
+
+// setFeature decodes the desired and reported values of the feature into
+// the device features.
+func setFeature(
+	features *models.DeviceFeatures,
+	name string,
+	t models.FeatureType,
+	value string,
+	reported sql.NullString,
+) error {
+	v, err := decodeValue(t, value)
+	if err != nil {
+		return err
+	}
+
+	features.Features[name] = v
+
+	r, err := decodeNullValue(t, reported)
+	if err != nil {
+		return err
+	}
+
+	if r != nil {
+		features.Reported[name] = *r
+	}
+
+	return nil
+}
+
+// legacyState is the value written to the state columns.
+func legacyState(v models.FeatureValue) bool {
+	return v.Type == models.FeatureBool && v.Bool
+}
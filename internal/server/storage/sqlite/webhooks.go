@@ -0,0 +1,388 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/dvaxert/mdm/internal/domain/models"
+	"github.com/dvaxert/mdm/internal/server/storage"
+)
+
+func (s *Storage) CreateWebhook(ctx context.Context, webhook models.Webhook) error {
+	const op = "storage.sqlite.CreateWebhook"
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	res, err := s.db.ExecContext(
+		ctx,
+		`INSERT INTO webhooks(name, url, secret, events, from_config) VALUES(?, ?, ?, ?, ?)
+		 ON CONFLICT(name) DO NOTHING;`,
+		webhook.Name, webhook.Url, webhook.Secret, joinEvents(webhook.Events), webhook.FromConfig,
+	)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	n, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if n == 0 {
+		return fmt.Errorf("%s: %w", op, storage.ErrWebhookExists)
+	}
+
+	return nil
+}
+
+// DeleteWebhook removes the webhook together with its deliveries.
+func (s *Storage) DeleteWebhook(ctx context.Context, name string) error {
+	const op = "storage.sqlite.DeleteWebhook"
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	tx, err := s.db.Begin()
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	defer tx.Rollback()
+
+	var id int64
+	err = tx.QueryRowContext(ctx, "SELECT id FROM webhooks WHERE name = ?;", name).Scan(&id)
+	if errors.Is(err, sql.ErrNoRows) {
+		return fmt.Errorf("%s: %w", op, storage.ErrWebhookNotFound)
+	}
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err = deleteWebhook(ctx, tx, id); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err = tx.Commit(); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+func (s *Storage) Webhook(ctx context.Context, name string) (models.Webhook, error) {
+	const op = "storage.sqlite.Webhook"
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	webhooks, err := s.webhooks(ctx, "WHERE name = ?", name)
+	if err != nil {
+		return models.Webhook{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if len(webhooks) == 0 {
+		return models.Webhook{}, fmt.Errorf("%s: %w", op, storage.ErrWebhookNotFound)
+	}
+
+	return webhooks[0], nil
+}
+
+func (s *Storage) Webhooks(ctx context.Context) ([]models.Webhook, error) {
+	const op = "storage.sqlite.Webhooks"
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	webhooks, err := s.webhooks(ctx, "")
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return webhooks, nil
+}
+
+// SyncConfigWebhooks makes the webhooks from the server config match the
+// given ones. Webhooks created through the Control API are kept, unless a
+// config webhook takes their name.
+func (s *Storage) SyncConfigWebhooks(ctx context.Context, webhooks []models.Webhook) error {
+	const op = "storage.sqlite.SyncConfigWebhooks"
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	tx, err := s.db.Begin()
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	defer tx.Rollback()
+
+	names := make([]any, 0, len(webhooks))
+	for _, w := range webhooks {
+		names = append(names, w.Name)
+	}
+
+	rows, err := tx.QueryContext(
+		ctx,
+		`SELECT id FROM webhooks
+		 WHERE (from_config = 1 AND name NOT IN (`+placeholders(len(names))+`))
+			OR (from_config = 0 AND name IN (`+placeholders(len(names))+`));`,
+		append(names, names...)...,
+	)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	var stale []int64
+	for rows.Next() {
+		var id int64
+		if err = rows.Scan(&id); err != nil {
+			rows.Close()
+			return fmt.Errorf("%s: %w", op, err)
+		}
+
+		stale = append(stale, id)
+	}
+	rows.Close()
+
+	if err = rows.Err(); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	for _, id := range stale {
+		if err = deleteWebhook(ctx, tx, id); err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+	}
+
+	for _, w := range webhooks {
+		_, err = tx.ExecContext(
+			ctx,
+			`INSERT INTO webhooks(name, url, secret, events, from_config) VALUES(?, ?, ?, ?, 1)
+			 ON CONFLICT(name) DO UPDATE SET url = excluded.url, secret = excluded.secret, events = excluded.events;`,
+			w.Name, w.Url, w.Secret, joinEvents(w.Events),
+		)
+		if err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+// EnqueueEvent queues a delivery of the payload to every webhook subscribed
+// to the event type. It returns the number of queued deliveries.
+func (s *Storage) EnqueueEvent(ctx context.Context, event models.Event, payload []byte) (int, error) {
+	const op = "storage.sqlite.EnqueueEvent"
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	webhooks, err := s.webhooks(ctx, "")
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	tx, err := s.db.Begin()
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+	defer tx.Rollback()
+
+	queued := 0
+	for _, w := range webhooks {
+		if !w.Subscribed(event.Type) {
+			continue
+		}
+
+		_, err = tx.ExecContext(
+			ctx,
+			`INSERT INTO webhook_deliveries(webhook_id, event_id, event_type, payload, created_at, next_attempt_at)
+			 VALUES(?, ?, ?, ?, unixepoch(), unixepoch());`,
+			w.Id, event.Id.String(), event.Type, payload,
+		)
+		if err != nil {
+			return 0, fmt.Errorf("%s: %w", op, err)
+		}
+
+		queued++
+	}
+
+	if err = tx.Commit(); err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return queued, nil
+}
+
+// DueDeliveries returns up to limit pending deliveries whose next attempt is
+// due at now, oldest first.
+func (s *Storage) DueDeliveries(ctx context.Context, now time.Time, limit int) ([]models.WebhookDelivery, error) {
+	const op = "storage.sqlite.DueDeliveries"
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	rows, err := s.db.QueryContext(
+		ctx,
+		`SELECT d.id, d.event_id, d.event_type, d.payload, w.url, w.secret, d.attempts, d.created_at
+		 FROM webhook_deliveries AS d
+			JOIN webhooks AS w
+			ON d.webhook_id = w.id
+		 WHERE d.delivered_at IS NULL AND d.failed_at IS NULL AND d.next_attempt_at <= ?
+		 ORDER BY d.next_attempt_at, d.id
+		 LIMIT ?;`,
+		now.Unix(), limit,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	var result []models.WebhookDelivery
+	for rows.Next() {
+		var (
+			d       models.WebhookDelivery
+			created int64
+		)
+		err = rows.Scan(&d.Id, &d.EventId, &d.EventType, &d.Payload, &d.Url, &d.Secret, &d.Attempts, &created)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		d.CreatedAt = time.Unix(created, 0)
+
+		result = append(result, d)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return result, nil
+}
+
+// CompleteDelivery marks the delivery as delivered.
+func (s *Storage) CompleteDelivery(ctx context.Context, id int64) error {
+	const op = "storage.sqlite.CompleteDelivery"
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	_, err := s.db.ExecContext(
+		ctx,
+		"UPDATE webhook_deliveries SET delivered_at = unixepoch(), attempts = attempts + 1 WHERE id = ?;",
+		id,
+	)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+// RetryDelivery records a failed attempt of the delivery and schedules the
+// next one. The zero next time gives the delivery up.
+func (s *Storage) RetryDelivery(ctx context.Context, id int64, reason string, next time.Time) error {
+	const op = "storage.sqlite.RetryDelivery"
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	query := "UPDATE webhook_deliveries SET attempts = attempts + 1, last_error = ?, next_attempt_at = ? WHERE id = ?;"
+	args := []any{reason, next.Unix(), id}
+	if next.IsZero() {
+		query = "UPDATE webhook_deliveries SET attempts = attempts + 1, last_error = ?, failed_at = unixepoch() WHERE id = ?;"
+		args = []any{reason, id}
+	}
+
+	if _, err := s.db.ExecContext(ctx, query, args...); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+// PruneDeliveries removes the finished deliveries created before the given
+// time and returns their number.
+func (s *Storage) PruneDeliveries(ctx context.Context, before time.Time) (int64, error) {
+	const op = "storage.sqlite.PruneDeliveries"
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	res, err := s.db.ExecContext(
+		ctx,
+		`DELETE FROM webhook_deliveries
+		 WHERE created_at < ? AND (delivered_at IS NOT NULL OR failed_at IS NOT NULL);`,
+		before.Unix(),
+	)
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	n, err := res.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return n, nil
+}
+
+func (s *Storage) webhooks(ctx context.Context, where string, args ...any) ([]models.Webhook, error) {
+	rows, err := s.db.QueryContext(
+		ctx,
+		"SELECT id, name, url, secret, events, from_config FROM webhooks "+where+" ORDER BY name;",
+		args...,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var result []models.Webhook
+	for rows.Next() {
+		var (
+			w      models.Webhook
+			events string
+		)
+		if err = rows.Scan(&w.Id, &w.Name, &w.Url, &w.Secret, &events, &w.FromConfig); err != nil {
+			return nil, err
+		}
+
+		if events != "" {
+			for _, t := range strings.Split(events, ",") {
+				w.Events = append(w.Events, models.EventType(t))
+			}
+		}
+
+		result = append(result, w)
+	}
+
+	return result, rows.Err()
+}
+
+func deleteWebhook(ctx context.Context, tx *sql.Tx, id int64) error {
+	if _, err := tx.ExecContext(ctx, "DELETE FROM webhook_deliveries WHERE webhook_id = ?;", id); err != nil {
+		return err
+	}
+
+	_, err := tx.ExecContext(ctx, "DELETE FROM webhooks WHERE id = ?;", id)
+
+	return err
+}
+
+func joinEvents(events []models.EventType) string {
+	result := make([]string, 0, len(events))
+	for _, t := range events {
+		result = append(result, string(t))
+	}
+
+	return strings.Join(result, ",")
+}
@@ -0,0 +1,42 @@
+package storage
+
+import "errors"
+
+var (
+	ErrDeviceNotFound          = errors.New("device not found")
+	ErrDevicePending           = errors.New("device is pending enrollment")
+	ErrDeviceSuspended         = errors.New("device is suspended")
+	ErrDeviceEnrolled          = errors.New("device is already enrolled")
+	ErrDeviceDecommissioned    = errors.New("device is retired or wiped")
+	ErrLifecycleChange         = errors.New("device cannot move to the lifecycle state")
+	ErrFeatureNotFound         = errors.New("feature not found")
+	ErrFeatureExists           = errors.New("feature already exists")
+	ErrGroupNotFound           = errors.New("group not found")
+	ErrGroupExists             = errors.New("group already exists")
+	ErrGroupHasSubgroups       = errors.New("group has subgroups")
+	ErrProfileNotFound         = errors.New("profile not found")
+	ErrProfileExists           = errors.New("profile already exists")
+	ErrInvalidPageToken        = errors.New("invalid page token")
+	ErrAlertRuleNotFound       = errors.New("alert rule not found")
+	ErrAlertRuleExists         = errors.New("alert rule already exists")
+	ErrAlertNotFound           = errors.New("alert not found")
+	ErrComplianceRuleNotFound  = errors.New("compliance rule not found")
+	ErrComplianceRuleExists    = errors.New("compliance rule already exists")
+	ErrWebhookNotFound         = errors.New("webhook not found")
+	ErrWebhookExists           = errors.New("webhook already exists")
+	ErrCommandNotFound         = errors.New("command not found")
+	ErrCommandCompleted        = errors.New("command already completed")
+	ErrEnrollmentTokenInvalid  = errors.New("enrollment token is invalid, expired or used")
+	ErrEnrollmentTokenScope    = errors.New("enrollment token does not allow the device type")
+	ErrEnrollmentTokenNotFound = errors.New("enrollment token not found")
+	ErrCertificateNotFound     = errors.New("certificate not found")
+	ErrInventoryNotFound       = errors.New("inventory not found")
+	ErrAppNotFound             = errors.New("app not found")
+	ErrAppExists               = errors.New("app already exists")
+	ErrAppRuleNotFound         = errors.New("app rule not found")
+	ErrOperatorNotFound        = errors.New("operator not found")
+	ErrOperatorExists          = errors.New("operator already exists")
+	ErrRoleNotFound            = errors.New("role not found")
+	ErrRoleExists              = errors.New("role already exists")
+	ErrRoleInUse               = errors.New("role is granted to operators")
+)
@@ -0,0 +1,120 @@
+package tlsconfig
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+// Server returns the TLS config of a server with the certificate and key
+// files. If clientCA is set, client certificates are verified against it:
+// requireClientCert rejects clients without a certificate, otherwise they
+// are accepted and only the presented certificates are verified.
+func Server(cert string, key string, clientCA string, requireClientCert bool) (*tls.Config, error) {
+	pair, err := tls.LoadX509KeyPair(cert, key)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load the server certificate: %w", err)
+	}
+
+	result := &tls.Config{
+		Certificates: []tls.Certificate{pair},
+		MinVersion:   tls.VersionTLS12,
+	}
+
+	if clientCA == "" {
+		if requireClientCert {
+			return nil, fmt.Errorf("client ca is required to verify client certificates")
+		}
+
+		return result, nil
+	}
+
+	pool, err := certPool(clientCA)
+	if err != nil {
+		return nil, err
+	}
+
+	result.ClientCAs = pool
+	result.ClientAuth = tls.VerifyClientCertIfGiven
+	if requireClientCert {
+		result.ClientAuth = tls.RequireAndVerifyClientCert
+	}
+
+	return result, nil
+}
+
+// ClientCredentials returns the transport credentials of a client. Without
+// TLS the connection is not encrypted. The server certificate is verified
+// against ca, or against the system roots if ca is empty. The client
+// certificate is presented if cert and key are set. It is read on every
+// handshake, so a renewed certificate is used by new connections, and is
+// not presented until both files exist.
+func ClientCredentials(enabled bool, ca string, cert string, key string, serverName string) (credentials.TransportCredentials, error) {
+	if !enabled {
+		return insecure.NewCredentials(), nil
+	}
+
+	result := &tls.Config{
+		ServerName: serverName,
+		MinVersion: tls.VersionTLS12,
+	}
+
+	if ca != "" {
+		pool, err := certPool(ca)
+		if err != nil {
+			return nil, err
+		}
+
+		result.RootCAs = pool
+	}
+
+	if cert != "" || key != "" {
+		if _, err := loadKeyPair(cert, key); err != nil {
+			return nil, err
+		}
+
+		result.GetClientCertificate = func(*tls.CertificateRequestInfo) (*tls.Certificate, error) {
+			pair, err := loadKeyPair(cert, key)
+			if err != nil || pair == nil {
+				return &tls.Certificate{}, err
+			}
+
+			return pair, nil
+		}
+	}
+
+	return credentials.NewTLS(result), nil
+}
+
+func certPool(path string) (*x509.CertPool, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the ca certificate: %w", err)
+	}
+
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(data) {
+		return nil, fmt.Errorf("no certificates found in %s", path)
+	}
+
+	return pool, nil
+}
+
+// loadKeyPair loads the client certificate, nil if the files do not exist.
+func loadKeyPair(cert string, key string) (*tls.Certificate, error) {
+	pair, err := tls.LoadX509KeyPair(cert, key)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to load the client certificate: %w", err)
+	}
+
+	return &pair, nil
+}